@@ -45,6 +45,7 @@ require (
 	github.com/prometheus/client_golang v1.19.0
 	github.com/prometheus/procfs v0.13.0
 	github.com/redis/go-redis/v9 v9.5.1
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/rs/zerolog v1.32.0
 	github.com/shirou/gopsutil/v4 v4.24.6
 	github.com/sirupsen/logrus v1.9.3
@@ -2,6 +2,7 @@ package abstractions
 
 import (
 	"context"
+	"slices"
 	"time"
 )
 
@@ -15,6 +16,7 @@ type Autoscaler[I IAutoscaledInstance, S AutoscalerSample] struct {
 	mostRecentSample S
 	sampleFunc       func(I) (S, error)
 	scaleFunc        func(I, S) *AutoscalerResult
+	stabilizer       *scaleStabilizer
 }
 
 type IAutoscaler interface {
@@ -22,9 +24,11 @@ type IAutoscaler interface {
 }
 
 const (
-	MaxReplicas uint          = 5                                      // Maximum number of desired replicas that can be returned
-	windowSize  int           = 60                                     // Number of samples in the sampling window
-	sampleRate  time.Duration = time.Duration(1000) * time.Millisecond // Time between samples
+	windowSize int           = 60                                     // Number of samples in the sampling window
+	sampleRate time.Duration = time.Duration(1000) * time.Millisecond // Time between samples
+
+	scaleUpWindow   int = windowSize / 12 // Number of samples that must all call for more replicas before scaling up
+	scaleDownWindow int = windowSize      // Number of samples that must all call for fewer replicas before scaling down
 )
 
 type AutoscalerSample interface{}
@@ -37,6 +41,14 @@ func NewAutoscaler[I IAutoscaledInstance, S AutoscalerSample](instance I, sample
 	}
 }
 
+// NewStabilizedAutoscaler creates an autoscaler whose results are smoothed over the sampling window, for
+// scale functions driven by noisy signals like request rates and latencies
+func NewStabilizedAutoscaler[I IAutoscaledInstance, S AutoscalerSample](instance I, sampleFunc func(I) (S, error), scaleFunc func(I, S) *AutoscalerResult) *Autoscaler[I, S] {
+	as := NewAutoscaler(instance, sampleFunc, scaleFunc)
+	as.stabilizer = newScaleStabilizer()
+	return as
+}
+
 // Start the autoscaler
 func (as *Autoscaler[I, S]) Start(ctx context.Context) {
 	ticker := time.NewTicker(sampleRate)
@@ -56,8 +68,47 @@ func (as *Autoscaler[I, S]) Start(ctx context.Context) {
 
 			scaleResult := as.scaleFunc(as.instance, sample)
 			if scaleResult != nil && scaleResult.ResultValid {
+				if as.stabilizer != nil {
+					scaleResult.DesiredContainers = as.stabilizer.stabilize(scaleResult.DesiredContainers)
+				}
+
 				as.instance.ConsumeScaleResult(scaleResult) // Send autoscaling result back to instance
 			}
 		}
 	}
 }
+
+// scaleStabilizer keeps the desired number of replicas from flapping. It only scales up once every sample in
+// the scale up window has called for more replicas, and only scales down to the most replicas called for over
+// the whole scale down window. Scaling up from zero isn't delayed, so requests aren't left waiting on a cold start.
+type scaleStabilizer struct {
+	history []int
+	current int
+}
+
+func newScaleStabilizer() *scaleStabilizer {
+	return &scaleStabilizer{
+		history: make([]int, 0, windowSize),
+	}
+}
+
+func (s *scaleStabilizer) stabilize(desired int) int {
+	if len(s.history) == windowSize {
+		s.history = s.history[1:]
+	}
+	s.history = append(s.history, desired)
+
+	switch {
+	case desired > s.current && s.current == 0:
+		s.current = desired
+	case desired > s.current:
+		upWindow := s.history[max(len(s.history)-scaleUpWindow, 0):]
+		if len(upWindow) == scaleUpWindow {
+			s.current = max(s.current, slices.Min(upWindow))
+		}
+	case desired < s.current:
+		s.current = min(s.current, slices.Max(s.history[max(len(s.history)-scaleDownWindow, 0):]))
+	}
+
+	return s.current
+}
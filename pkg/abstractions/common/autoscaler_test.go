@@ -0,0 +1,55 @@
+package abstractions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScaleStabilizerScalesUpFromZeroImmediately(t *testing.T) {
+	s := newScaleStabilizer()
+
+	assert.Equal(t, 0, s.stabilize(0))
+	assert.Equal(t, 3, s.stabilize(3))
+}
+
+func TestScaleStabilizerScaleUpWindow(t *testing.T) {
+	s := newScaleStabilizer()
+	assert.Equal(t, 1, s.stabilize(1))
+
+	// A single spike doesn't scale up
+	assert.Equal(t, 1, s.stabilize(4))
+	for i := 0; i < scaleUpWindow; i++ {
+		assert.Equal(t, 1, s.stabilize(1))
+	}
+
+	// Scale up once the whole window calls for more replicas, to the fewest any sample called for
+	for i := 0; i < scaleUpWindow-1; i++ {
+		assert.Equal(t, 1, s.stabilize(3))
+	}
+	assert.Equal(t, 3, s.stabilize(4))
+}
+
+func TestScaleStabilizerScaleDownWindow(t *testing.T) {
+	s := newScaleStabilizer()
+	assert.Equal(t, 3, s.stabilize(3))
+
+	// Stay scaled up until the spike leaves the window
+	for i := 0; i < scaleDownWindow-1; i++ {
+		assert.Equal(t, 3, s.stabilize(0))
+	}
+	assert.Equal(t, 0, s.stabilize(0))
+}
+
+func TestScaleStabilizerScalesDownToWindowMax(t *testing.T) {
+	s := newScaleStabilizer()
+	assert.Equal(t, 4, s.stabilize(4))
+
+	for i := 0; i < scaleDownWindow-2; i++ {
+		s.stabilize(2)
+	}
+	assert.Equal(t, 4, s.stabilize(1))
+
+	// The 4 has left the window, but a 2 is still in it
+	assert.Equal(t, 2, s.stabilize(1))
+}
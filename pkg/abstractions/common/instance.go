@@ -2,6 +2,7 @@ package abstractions
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
@@ -36,6 +37,7 @@ type AutoscaledInstanceConfig struct {
 	Scheduler           *scheduler.Scheduler
 	Rdb                 *common.RedisClient
 	InstanceLockKey     string
+	MaxReplicas         uint
 	ContainerRepo       repository.ContainerRepository
 	BackendRepo         repository.BackendRepository
 	TaskRepo            repository.TaskRepository
@@ -51,6 +53,7 @@ type AutoscaledInstance struct {
 	Lock                     *common.RedisLock
 	IsActive                 bool
 	FailedContainerThreshold int
+	MaxReplicas              uint // Most containers any stub can scale to, unless the workspace's concurrency limit sets its own
 
 	// DB objects
 	Workspace  *types.Workspace
@@ -111,6 +114,7 @@ func NewAutoscaledInstance(ctx context.Context, cfg *AutoscaledInstanceConfig) (
 		StartContainersFunc:      cfg.StartContainersFunc,
 		StopContainersFunc:       cfg.StopContainersFunc,
 		FailedContainerThreshold: failedContainerThreshold,
+		MaxReplicas:              cfg.MaxReplicas,
 	}
 
 	if instance.StubConfig.Autoscaler == nil {
@@ -123,6 +127,32 @@ func NewAutoscaledInstance(ctx context.Context, cfg *AutoscaledInstanceConfig) (
 	return instance, nil
 }
 
+// WarmDeployments creates instances for the active deployments of a stub type that keep a minimum number of
+// containers, so they're scaled up without waiting for traffic
+func WarmDeployments(ctx context.Context, backendRepo repository.BackendRepository, stubType string, createInstance func(stubId string) error) error {
+	deployments, err := backendRepo.ListActiveDeploymentsByStubType(ctx, stubType)
+	if err != nil {
+		return err
+	}
+
+	for _, deployment := range deployments {
+		var stubConfig types.StubConfigV1
+		if err := json.Unmarshal([]byte(deployment.Stub.Config), &stubConfig); err != nil {
+			continue
+		}
+
+		if stubConfig.Autoscaler == nil || stubConfig.Autoscaler.MinContainers == 0 {
+			continue
+		}
+
+		if err := createInstance(deployment.Stub.ExternalId); err != nil {
+			log.Printf("unable to warm deployment <%s>: %v\n", deployment.ExternalId, err)
+		}
+	}
+
+	return nil
+}
+
 // Reload updates state that should be changed on the instance.
 // If a stub has a deployment associated with it, we update the IsActive field.
 func (i *AutoscaledInstance) Reload() error {
@@ -194,6 +224,14 @@ func (i *AutoscaledInstance) Monitor() error {
 				delete(i.Containers, containerEvent.ContainerId)
 			}
 
+			// Containers the scheduler gave up on are removed without ever running, so say why
+			if containerEvent.Change == -1 {
+				failure, err := i.ContainerRepo.GetContainerSchedulingFailure(containerEvent.ContainerId)
+				if err == nil && failure != nil {
+					log.Printf("<%s> %v\n", i.Name, &types.ErrContainerSchedulingFailed{Failure: failure})
+				}
+			}
+
 			if initialContainerCount != len(i.Containers) {
 				log.Printf("<%s> scaled from %d->%d", i.Name, initialContainerCount, len(i.Containers))
 			}
@@ -229,6 +267,9 @@ func (i *AutoscaledInstance) HandleScalingEvent(desiredContainers int) error {
 		return err
 	}
 
+	minContainers, maxContainers := i.ReplicaBounds()
+	desiredContainers = min(max(desiredContainers, minContainers), maxContainers)
+
 	if state.FailedContainers >= i.FailedContainerThreshold {
 		log.Printf("<%s> reached failed container threshold, scaling to zero.\n", i.Name)
 		desiredContainers = 0
@@ -254,6 +295,35 @@ func (i *AutoscaledInstance) HandleScalingEvent(desiredContainers int) error {
 	return err
 }
 
+// ReplicaBounds returns the fewest and most containers the instance can scale to
+func (i *AutoscaledInstance) ReplicaBounds() (int, int) {
+	maxReplicas := i.MaxReplicas
+	if i.Scheduler != nil {
+		limit, err := i.Scheduler.GetConcurrencyLimit(i.Workspace.ExternalId)
+		if err == nil && limit != nil && limit.MaxReplicas > 0 {
+			maxReplicas = uint(limit.MaxReplicas)
+		}
+	}
+
+	return replicaBounds(i.StubConfig.Autoscaler, i.Stub.Type.IsDeployment(), maxReplicas)
+}
+
+// replicaBounds keeps a deployment's minimum containers running even without traffic, and limits any stub to
+// its autoscaler's max containers and the max replicas allowed, if there is a limit (maxReplicas > 0)
+func replicaBounds(autoscaler *types.Autoscaler, isDeployment bool, maxReplicas uint) (int, int) {
+	maxContainers := autoscaler.MaxContainers
+	if maxReplicas > 0 {
+		maxContainers = min(maxContainers, maxReplicas)
+	}
+
+	minContainers := uint(0)
+	if isDeployment {
+		minContainers = min(autoscaler.MinContainers, maxContainers)
+	}
+
+	return int(minContainers), int(maxContainers)
+}
+
 func (i *AutoscaledInstance) State() (*AutoscaledInstanceState, error) {
 	containers, err := i.ContainerRepo.GetActiveContainersByStubId(i.Stub.ExternalId)
 	if err != nil {
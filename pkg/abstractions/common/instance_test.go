@@ -0,0 +1,35 @@
+package abstractions
+
+import (
+	"testing"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaBounds(t *testing.T) {
+	autoscaler := &types.Autoscaler{
+		MinContainers: 2,
+		MaxContainers: 40,
+	}
+
+	// Deployments keep their minimum containers
+	minContainers, maxContainers := replicaBounds(autoscaler, true, 100)
+	assert.Equal(t, 2, minContainers)
+	assert.Equal(t, 40, maxContainers)
+
+	// Other stubs can scale to zero
+	minContainers, maxContainers = replicaBounds(autoscaler, false, 100)
+	assert.Equal(t, 0, minContainers)
+	assert.Equal(t, 40, maxContainers)
+
+	// The max replicas allowed limits both bounds
+	minContainers, maxContainers = replicaBounds(autoscaler, true, 1)
+	assert.Equal(t, 1, minContainers)
+	assert.Equal(t, 1, maxContainers)
+
+	// Without max replicas, only the autoscaler limits the max
+	minContainers, maxContainers = replicaBounds(autoscaler, true, 0)
+	assert.Equal(t, 2, minContainers)
+	assert.Equal(t, 40, maxContainers)
+}
@@ -0,0 +1,27 @@
+package abstractions
+
+import (
+	"context"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+// RecordTaskSchedulingFailure sets a task to an error state, recording why its container couldn't be scheduled
+func RecordTaskSchedulingFailure(ctx context.Context, backendRepo repository.BackendRepository, task *types.Task, reason string) error {
+	task.Status = types.TaskStatusError
+	_, err := backendRepo.UpdateTask(ctx, task.ExternalId, *task)
+	if err != nil {
+		return err
+	}
+
+	task.Cancellation = &types.TaskCancellation{
+		Reason:      types.TaskSchedulingFailed,
+		Outcome:     types.TaskCancellationNotRunning,
+		Error:       reason,
+		RequestedAt: time.Now(),
+	}
+
+	return backendRepo.SetTaskCancellation(ctx, task.ExternalId, *task.Cancellation)
+}
@@ -0,0 +1,30 @@
+package abstractions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordTaskSchedulingFailure(t *testing.T) {
+	backendRepo, mock := repository.NewBackendPostgresRepositoryForTest()
+	task := &types.Task{ExternalId: "task-1", Status: types.TaskStatusPending}
+	reason := "unable to schedule container function-1 (no_capacity): no suitable worker found for request"
+
+	mock.ExpectQuery("UPDATE task").
+		WithArgs("task-1", types.TaskStatusError, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"external_id", "status"}).AddRow("task-1", types.TaskStatusError))
+	mock.ExpectExec("UPDATE task SET cancellation").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := RecordTaskSchedulingFailure(context.Background(), backendRepo, task, reason)
+	assert.Nil(t, err)
+	assert.Equal(t, types.TaskStatusError, task.Status)
+	assert.Equal(t, types.TaskSchedulingFailed, task.Cancellation.Reason)
+	assert.Equal(t, reason, task.Cancellation.Error)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
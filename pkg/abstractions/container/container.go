@@ -162,11 +162,13 @@ func (cs *CmdContainerService) ExecuteCommand(in *pb.CommandExecutionRequest, st
 		Memory:      stubConfig.Runtime.Memory,
 		Gpu:         string(stubConfig.Runtime.Gpu),
 		GpuCount:    uint32(gpuCount),
+		GpuMemory:   stubConfig.Runtime.GpuMemory,
 		ImageId:     stubConfig.Runtime.ImageId,
 		StubId:      stub.ExternalId,
 		WorkspaceId: authInfo.Workspace.ExternalId,
 		EntryPoint:  []string{stubConfig.PythonVersion, "-m", "beta9.runner.container", base64.StdEncoding.EncodeToString(in.Command)},
 		Mounts:      mounts,
+		Priority:    stubConfig.Priority,
 	})
 	if err != nil {
 		return err
@@ -174,6 +176,11 @@ func (cs *CmdContainerService) ExecuteCommand(in *pb.CommandExecutionRequest, st
 
 	hostname, err := cs.containerRepo.GetWorkerAddress(task.ContainerId)
 	if err != nil {
+		if _, ok := err.(*types.ErrContainerSchedulingFailed); ok {
+			task.Status = types.TaskStatusError
+			cs.backendRepo.UpdateTask(ctx, task.ExternalId, *task)
+		}
+
 		return err
 	}
 
@@ -4,13 +4,21 @@ import (
 	"math"
 
 	abstractions "github.com/beam-cloud/beta9/pkg/abstractions/common"
+	"github.com/beam-cloud/beta9/pkg/types"
 )
 
 type endpointAutoscalerSample struct {
 	TotalRequests     int64
 	CurrentContainers int64
+	InFlightRequests  int64   // Requests being handled by containers or waiting in the request buffer
+	RequestsPerSecond float64 // Rate that requests arrived over the request sample window
+	P95LatencyMs      float64 // 95th percentile duration of requests that completed over the request sample window
+	QueuedRequests    int64   // Requests waiting in the request buffer for a container
 }
 
+// Latency under this fraction of the target is low enough to give up a container
+const latencyScaleDownThreshold = 0.5
+
 func endpointSampleFunc(i *endpointInstance) (*endpointAutoscalerSample, error) {
 	totalRequests, err := i.TaskRepo.TasksInFlight(i.Ctx, i.Workspace.Name, i.Stub.ExternalId)
 	if err != nil {
@@ -30,10 +38,33 @@ func endpointSampleFunc(i *endpointInstance) (*endpointAutoscalerSample, error)
 		CurrentContainers: int64(currentContainers),
 	}
 
+	// Request samples are only read for the autoscalers that use them
+	switch i.StubConfig.Autoscaler.Type {
+	case types.ConcurrencyAutoscaler:
+		sample.InFlightRequests = int64(i.buffer.InFlightRequests() + i.buffer.Length())
+	case types.RPSAutoscaler:
+		sample.RequestsPerSecond, err = i.buffer.RequestsPerSecond()
+		if err != nil {
+			return nil, err
+		}
+	case types.P95LatencyAutoscaler:
+		latency, err := i.buffer.RequestLatency(95)
+		if err != nil {
+			return nil, err
+		}
+		sample.P95LatencyMs = float64(latency.Milliseconds())
+		sample.QueuedRequests = int64(i.buffer.Length())
+	}
+
 	return sample, nil
 }
 
 func endpointDeploymentScaleFunc(i *endpointInstance, s *endpointAutoscalerSample) *abstractions.AutoscalerResult {
+	switch i.StubConfig.Autoscaler.Type {
+	case types.ConcurrencyAutoscaler, types.RPSAutoscaler, types.P95LatencyAutoscaler:
+		return endpointRequestScaleFunc(i, s)
+	}
+
 	desiredContainers := 0
 
 	if s.TotalRequests == 0 {
@@ -50,11 +81,74 @@ func endpointDeploymentScaleFunc(i *endpointInstance, s *endpointAutoscalerSampl
 			desiredContainers += 1
 		}
 
-		// Limit max replicas to either what was set in autoscaler config, or our default of MaxReplicas (whichever is lower)
-		maxReplicas := math.Min(float64(i.StubConfig.Autoscaler.MaxContainers), float64(abstractions.MaxReplicas))
-		desiredContainers = int(math.Min(maxReplicas, float64(desiredContainers)))
+		// Limit max replicas to what was set in autoscaler config
+		desiredContainers = min(desiredContainers, int(i.StubConfig.Autoscaler.MaxContainers))
+	}
+
+	return &abstractions.AutoscalerResult{
+		DesiredContainers: desiredContainers,
+		ResultValid:       true,
+	}
+}
+
+// endpointRequestScaleFunc scales a deployment on the samples its request buffer records, so that each container
+// stays near the autoscaler's target concurrency, request rate, or latency
+func endpointRequestScaleFunc(i *endpointInstance, s *endpointAutoscalerSample) *abstractions.AutoscalerResult {
+	autoscaler := i.StubConfig.Autoscaler
+
+	if s.TotalRequests == -1 {
+		return &abstractions.AutoscalerResult{
+			ResultValid: false,
+		}
+	}
+
+	if s.TotalRequests == 0 && s.InFlightRequests == 0 && s.RequestsPerSecond == 0 {
+		return &abstractions.AutoscalerResult{
+			DesiredContainers: 0,
+			ResultValid:       true,
+		}
+	}
+
+	desiredContainers := 0
+	switch autoscaler.Type {
+	case types.ConcurrencyAutoscaler:
+		targetConcurrency := autoscaler.TargetConcurrency
+		if targetConcurrency <= 0 {
+			targetConcurrency = float64(autoscaler.TasksPerContainer)
+		}
+		if targetConcurrency <= 0 {
+			return &abstractions.AutoscalerResult{ResultValid: false}
+		}
+
+		desiredContainers = int(math.Ceil(float64(s.InFlightRequests) / targetConcurrency))
+	case types.RPSAutoscaler:
+		if autoscaler.TargetRPS <= 0 {
+			return &abstractions.AutoscalerResult{ResultValid: false}
+		}
+
+		desiredContainers = int(math.Ceil(s.RequestsPerSecond / autoscaler.TargetRPS))
+	case types.P95LatencyAutoscaler:
+		if autoscaler.TargetLatencyMs <= 0 {
+			return &abstractions.AutoscalerResult{ResultValid: false}
+		}
+
+		// Step one container at a time around the target, since latency doesn't say how many containers are
+		// needed. Latency over the target only calls for another container while requests are waiting for one,
+		// otherwise the requests are just slow. Until a request completes the current containers are kept.
+		desiredContainers = int(s.CurrentContainers)
+		if s.P95LatencyMs > autoscaler.TargetLatencyMs && s.QueuedRequests > 0 {
+			desiredContainers++
+		} else if s.P95LatencyMs > 0 && s.P95LatencyMs < autoscaler.TargetLatencyMs*latencyScaleDownThreshold && s.QueuedRequests == 0 {
+			desiredContainers--
+		}
 	}
 
+	// There's traffic, so keep at least one container around to serve it
+	desiredContainers = max(desiredContainers, 1)
+
+	// Limit max replicas to what was set in autoscaler config
+	desiredContainers = min(desiredContainers, int(autoscaler.MaxContainers))
+
 	return &abstractions.AutoscalerResult{
 		DesiredContainers: desiredContainers,
 		ResultValid:       true,
@@ -2,6 +2,7 @@ package endpoint
 
 import (
 	"testing"
+	"time"
 
 	abstractions "github.com/beam-cloud/beta9/pkg/abstractions/common"
 	"github.com/beam-cloud/beta9/pkg/types"
@@ -110,3 +111,141 @@ func TestDeploymentScaleFuncWithMaxTasksPerContainer(t *testing.T) {
 	assert.Equal(t, true, result.ResultValid)
 	assert.Equal(t, 2, result.DesiredContainers)
 }
+
+func TestDeploymentScaleFuncWithConcurrencyAutoscaler(t *testing.T) {
+	autoscaledInstance := &abstractions.AutoscaledInstance{}
+	autoscaledInstance.StubConfig = &types.StubConfigV1{}
+	autoscaledInstance.StubConfig.Autoscaler = &types.Autoscaler{
+		Type:              types.ConcurrencyAutoscaler,
+		MaxContainers:     5,
+		TasksPerContainer: 1,
+		TargetConcurrency: 4,
+	}
+
+	instance := &endpointInstance{}
+	instance.AutoscaledInstance = autoscaledInstance
+
+	result := endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 10, InFlightRequests: 10})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 3, result.DesiredContainers)
+
+	// Ensure we don't exceed max containers
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 100, InFlightRequests: 100})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 5, result.DesiredContainers)
+
+	// Scale to zero without any traffic
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 0, result.DesiredContainers)
+
+	// Fall back to tasks per container without a target
+	autoscaledInstance.StubConfig.Autoscaler.TargetConcurrency = 0
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 2, InFlightRequests: 2})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 2, result.DesiredContainers)
+}
+
+func TestDeploymentScaleFuncWithRPSAutoscaler(t *testing.T) {
+	autoscaledInstance := &abstractions.AutoscaledInstance{}
+	autoscaledInstance.StubConfig = &types.StubConfigV1{}
+	autoscaledInstance.StubConfig.Autoscaler = &types.Autoscaler{
+		Type:          types.RPSAutoscaler,
+		MaxContainers: 5,
+		TargetRPS:     10,
+	}
+
+	instance := &endpointInstance{}
+	instance.AutoscaledInstance = autoscaledInstance
+
+	result := endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{RequestsPerSecond: 25})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 3, result.DesiredContainers)
+
+	// Keep a container while there are requests, even if they arrive slower than the target
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{RequestsPerSecond: 0.1})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 1, result.DesiredContainers)
+
+	// Check for an invalid target
+	autoscaledInstance.StubConfig.Autoscaler.TargetRPS = 0
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{RequestsPerSecond: 25})
+	assert.Equal(t, false, result.ResultValid)
+}
+
+func TestDeploymentScaleFuncWithP95LatencyAutoscaler(t *testing.T) {
+	autoscaledInstance := &abstractions.AutoscaledInstance{}
+	autoscaledInstance.StubConfig = &types.StubConfigV1{}
+	autoscaledInstance.StubConfig.Autoscaler = &types.Autoscaler{
+		Type:            types.P95LatencyAutoscaler,
+		MaxContainers:   5,
+		TargetLatencyMs: 500,
+	}
+
+	instance := &endpointInstance{}
+	instance.AutoscaledInstance = autoscaledInstance
+
+	// Latency is over the target with requests waiting, so add a container
+	result := endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 4, CurrentContainers: 2, P95LatencyMs: 1000, QueuedRequests: 2})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 3, result.DesiredContainers)
+
+	// Latency is well under the target, so give up a container
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 4, CurrentContainers: 4, P95LatencyMs: 200})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 3, result.DesiredContainers)
+
+	// Latency near the target keeps the current containers
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 4, CurrentContainers: 4, P95LatencyMs: 400})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 4, result.DesiredContainers)
+
+	// Keep the current containers until a request completes
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{TotalRequests: 4, CurrentContainers: 3})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 3, result.DesiredContainers)
+
+	// Scale to zero without any traffic
+	result = endpointDeploymentScaleFunc(instance, &endpointAutoscalerSample{CurrentContainers: 3, P95LatencyMs: 1000})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 0, result.DesiredContainers)
+}
+
+func TestDeploymentScaleFuncWithP95LatencyAboveTarget(t *testing.T) {
+	autoscaledInstance := &abstractions.AutoscaledInstance{}
+	autoscaledInstance.StubConfig = &types.StubConfigV1{}
+	autoscaledInstance.StubConfig.Autoscaler = &types.Autoscaler{
+		Type:            types.P95LatencyAutoscaler,
+		MaxContainers:   5,
+		TargetLatencyMs: 500,
+	}
+
+	instance := &endpointInstance{}
+	instance.AutoscaledInstance = autoscaledInstance
+
+	// Requests take longer than the target however many containers there are. Once nothing is waiting for
+	// a container, the deployment settles instead of growing to the max containers.
+	sample := &endpointAutoscalerSample{TotalRequests: 2, CurrentContainers: 1, P95LatencyMs: 2000, QueuedRequests: 1}
+	for _, queued := range []int64{1, 0, 0, 0} {
+		sample.QueuedRequests = queued
+		result := endpointDeploymentScaleFunc(instance, sample)
+		assert.Equal(t, true, result.ResultValid)
+		sample.CurrentContainers = int64(result.DesiredContainers)
+	}
+
+	assert.Equal(t, int64(2), sample.CurrentContainers)
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	assert.Equal(t, time.Duration(0), latencyPercentile(nil, 95))
+
+	durations := []time.Duration{}
+	for i := 100; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+
+	assert.Equal(t, 95*time.Millisecond, latencyPercentile(durations, 95))
+	assert.Equal(t, 50*time.Millisecond, latencyPercentile(durations, 50))
+	assert.Equal(t, 100*time.Millisecond, latencyPercentile(durations, 100))
+	assert.Equal(t, time.Millisecond, latencyPercentile(durations, 0))
+}
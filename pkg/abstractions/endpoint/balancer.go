@@ -0,0 +1,205 @@
+package endpoint
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+const (
+	maxRequestAttempts        int           = 3                // Containers a request is sent to before its upstream failure is returned
+	containerEjectionErrors   int           = 3                // Consecutive upstream failures before a container is ejected
+	containerEjectionDuration time.Duration = 30 * time.Second // How long an ejected container doesn't receive requests
+	hashRingReplicas          int           = 64               // Points each container has on the consistent hash ring
+)
+
+type containerHealth struct {
+	consecutiveErrors int
+	ejectedUntil      time.Time
+}
+
+// loadBalancer picks the container that handles a request, and ejects containers that keep failing
+type loadBalancer struct {
+	policy     types.LoadBalancerPolicy
+	hashHeader string
+	health     map[string]*containerHealth
+	healthLock sync.Mutex
+}
+
+func newLoadBalancer(config *types.LoadBalancer) *loadBalancer {
+	lb := &loadBalancer{
+		policy: types.LeastInFlightLoadBalancer,
+		health: map[string]*containerHealth{},
+	}
+
+	if config != nil {
+		lb.hashHeader = config.HashHeader
+
+		switch config.Policy {
+		case types.PowerOfTwoLoadBalancer, types.ConsistentHashLoadBalancer:
+			lb.policy = config.Policy
+		}
+	}
+
+	return lb
+}
+
+// choose picks a container that hasn't been tried yet for a request. Ejected containers are only picked if every
+// untried container is ejected, so requests aren't dropped while all containers are failing. inFlight returns the
+// current in-flight requests of the given containers; containers missing from it keep their last known count.
+func (lb *loadBalancer) choose(containers []container, tried map[string]bool, hashKey string, inFlight func([]container) map[string]int) (container, bool) {
+	candidates := lb.candidates(containers, tried, time.Now())
+	if len(candidates) == 0 {
+		return container{}, false
+	}
+
+	switch {
+	case lb.policy == types.ConsistentHashLoadBalancer && hashKey != "":
+		return hashRingLookup(candidates, hashKey), true
+	case lb.policy == types.PowerOfTwoLoadBalancer && len(candidates) > 2:
+		i := rand.Intn(len(candidates))
+		j := rand.Intn(len(candidates) - 1)
+		if j >= i {
+			j++
+		}
+		candidates = []container{candidates[i], candidates[j]}
+	}
+
+	return leastInFlight(candidates, inFlight(candidates)), true
+}
+
+func (lb *loadBalancer) candidates(containers []container, tried map[string]bool, now time.Time) []container {
+	lb.healthLock.Lock()
+	defer lb.healthLock.Unlock()
+
+	healthy := []container{}
+	ejected := []container{}
+	for _, c := range containers {
+		if tried[c.id] {
+			continue
+		}
+
+		if h, ok := lb.health[c.id]; ok && now.Before(h.ejectedUntil) {
+			ejected = append(ejected, c)
+			continue
+		}
+
+		healthy = append(healthy, c)
+	}
+
+	if len(healthy) == 0 {
+		return ejected
+	}
+
+	return healthy
+}
+
+// recordSuccess resets a container's consecutive failures
+func (lb *loadBalancer) recordSuccess(containerId string) {
+	lb.healthLock.Lock()
+	defer lb.healthLock.Unlock()
+
+	delete(lb.health, containerId)
+}
+
+// recordFailure counts an upstream failure against a container, and ejects it after too many in a row.
+// It returns whether the container was ejected.
+func (lb *loadBalancer) recordFailure(containerId string) bool {
+	lb.healthLock.Lock()
+	defer lb.healthLock.Unlock()
+
+	h, ok := lb.health[containerId]
+	if !ok {
+		h = &containerHealth{}
+		lb.health[containerId] = h
+	}
+
+	h.consecutiveErrors++
+	if h.consecutiveErrors < containerEjectionErrors {
+		return false
+	}
+
+	h.consecutiveErrors = 0
+	h.ejectedUntil = time.Now().Add(containerEjectionDuration)
+	return true
+}
+
+// retain forgets the health of containers that are no longer available
+func (lb *loadBalancer) retain(containers []container) {
+	lb.healthLock.Lock()
+	defer lb.healthLock.Unlock()
+
+	available := make(map[string]bool, len(containers))
+	for _, c := range containers {
+		available[c.id] = true
+	}
+
+	for containerId := range lb.health {
+		if !available[containerId] {
+			delete(lb.health, containerId)
+		}
+	}
+}
+
+// leastInFlight returns the container handling the fewest requests, picking randomly between ties
+func leastInFlight(containers []container, inFlight map[string]int) container {
+	least := []container{}
+	leastRequests := 0
+	for _, c := range containers {
+		requests, ok := inFlight[c.id]
+		if !ok {
+			requests = c.inFlightRequests
+		}
+
+		switch {
+		case len(least) == 0 || requests < leastRequests:
+			least = []container{c}
+			leastRequests = requests
+		case requests == leastRequests:
+			least = append(least, c)
+		}
+	}
+
+	return least[rand.Intn(len(least))]
+}
+
+// hashRingLookup returns the container that owns a key on a consistent hash ring of the containers, so a key
+// keeps going to the same container while it's available
+func hashRingLookup(containers []container, key string) container {
+	type point struct {
+		hash      uint32
+		container int
+	}
+
+	ring := make([]point, 0, len(containers)*hashRingReplicas)
+	for i, c := range containers {
+		for r := 0; r < hashRingReplicas; r++ {
+			ring = append(ring, point{hash: hashKey(c.id + "#" + strconv.Itoa(r)), container: i})
+		}
+	}
+
+	sort.Slice(ring, func(i, j int) bool {
+		return ring[i].hash < ring[j].hash
+	})
+
+	h := hashKey(key)
+	i := sort.Search(len(ring), func(i int) bool {
+		return ring[i].hash >= h
+	})
+	if i == len(ring) {
+		i = 0
+	}
+
+	return containers[ring[i].container]
+}
+
+func hashKey(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
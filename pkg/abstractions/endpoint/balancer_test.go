@@ -0,0 +1,129 @@
+package endpoint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func noInFlight(containers []container) map[string]int {
+	return map[string]int{}
+}
+
+func TestLoadBalancerLeastInFlight(t *testing.T) {
+	lb := newLoadBalancer(nil)
+	containers := []container{
+		{id: "a", inFlightRequests: 0},
+		{id: "b", inFlightRequests: 0},
+		{id: "c", inFlightRequests: 0},
+	}
+
+	// Current counts are used over the ones from the last discovery
+	inFlight := func(containers []container) map[string]int {
+		return map[string]int{"a": 4, "b": 1, "c": 2}
+	}
+
+	c, ok := lb.choose(containers, map[string]bool{}, "", inFlight)
+	assert.True(t, ok)
+	assert.Equal(t, "b", c.id)
+
+	// Tried containers are skipped
+	c, ok = lb.choose(containers, map[string]bool{"b": true}, "", inFlight)
+	assert.True(t, ok)
+	assert.Equal(t, "c", c.id)
+
+	_, ok = lb.choose(containers, map[string]bool{"a": true, "b": true, "c": true}, "", inFlight)
+	assert.False(t, ok)
+}
+
+func TestLoadBalancerPowerOfTwoChoices(t *testing.T) {
+	lb := newLoadBalancer(&types.LoadBalancer{Policy: types.PowerOfTwoLoadBalancer})
+	containers := []container{
+		{id: "a", inFlightRequests: 5},
+		{id: "b", inFlightRequests: 1},
+		{id: "c", inFlightRequests: 3},
+	}
+
+	// The busiest container is never the less busy of two choices
+	for i := 0; i < 100; i++ {
+		c, ok := lb.choose(containers, map[string]bool{}, "", noInFlight)
+		assert.True(t, ok)
+		assert.NotEqual(t, "a", c.id)
+	}
+}
+
+func TestLoadBalancerConsistentHash(t *testing.T) {
+	lb := newLoadBalancer(&types.LoadBalancer{Policy: types.ConsistentHashLoadBalancer, HashHeader: "X-Session-Id"})
+
+	containers := []container{}
+	for i := 0; i < 5; i++ {
+		containers = append(containers, container{id: fmt.Sprintf("container-%d", i)})
+	}
+
+	// A key keeps going to the same container
+	first, ok := lb.choose(containers, map[string]bool{}, "session-1", noInFlight)
+	assert.True(t, ok)
+	for i := 0; i < 10; i++ {
+		c, _ := lb.choose(containers, map[string]bool{}, "session-1", noInFlight)
+		assert.Equal(t, first.id, c.id)
+	}
+
+	// Removing another container doesn't move keys that weren't on it
+	moved := 0
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("session-%d", i)
+		before, _ := lb.choose(containers, map[string]bool{}, key, noInFlight)
+		if before.id == "container-4" {
+			continue
+		}
+
+		after, _ := lb.choose(containers[:4], map[string]bool{}, key, noInFlight)
+		if before.id != after.id {
+			moved++
+		}
+	}
+	assert.Equal(t, 0, moved)
+
+	// Requests without the header fall back to the least in-flight container
+	containers[2].inFlightRequests = -1
+	c, ok := lb.choose(containers, map[string]bool{}, "", noInFlight)
+	assert.True(t, ok)
+	assert.Equal(t, "container-2", c.id)
+}
+
+func TestLoadBalancerEjection(t *testing.T) {
+	lb := newLoadBalancer(nil)
+	containers := []container{
+		{id: "a", inFlightRequests: 0},
+		{id: "b", inFlightRequests: 1},
+	}
+
+	// Successes reset consecutive failures
+	for i := 0; i < containerEjectionErrors-1; i++ {
+		assert.False(t, lb.recordFailure("a"))
+	}
+	lb.recordSuccess("a")
+	assert.False(t, lb.recordFailure("a"))
+
+	for i := 0; i < containerEjectionErrors-2; i++ {
+		assert.False(t, lb.recordFailure("a"))
+	}
+	assert.True(t, lb.recordFailure("a"))
+
+	// An ejected container isn't picked while others are available
+	c, ok := lb.choose(containers, map[string]bool{}, "", noInFlight)
+	assert.True(t, ok)
+	assert.Equal(t, "b", c.id)
+
+	// But it's still picked if it's the only one left
+	c, ok = lb.choose(containers, map[string]bool{"b": true}, "", noInFlight)
+	assert.True(t, ok)
+	assert.Equal(t, "a", c.id)
+
+	// Containers that are gone are forgotten
+	lb.retain(containers[1:])
+	c, _ = lb.choose(containers, map[string]bool{}, "", noInFlight)
+	assert.Equal(t, "a", c.id)
+}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"log"
+	"math"
 	"net"
 	"net/http"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -23,6 +27,11 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+var errTaskAborted = errors.New("task was cancelled or timed out")
+
+// How far back request arrivals and durations are sampled from for autoscaling
+const requestSampleWindow time.Duration = 10 * time.Second
+
 type request struct {
 	ctx         echo.Context
 	payload     *types.TaskPayload
@@ -50,6 +59,8 @@ type RequestBuffer struct {
 	buffer                  *abstractions.RingBuffer[request]
 	availableContainers     []container
 	availableContainersLock sync.RWMutex
+	balancer                *loadBalancer
+	abortFuncs              *common.SafeMap[context.CancelCauseFunc]
 
 	length atomic.Int32
 }
@@ -73,6 +84,8 @@ func NewRequestBuffer(
 		stubConfig:          stubConfig,
 		buffer:              abstractions.NewRingBuffer[request](size),
 		availableContainers: []container{},
+		balancer:            newLoadBalancer(stubConfig.LoadBalancer),
+		abortFuncs:          common.NewSafeMap[context.CancelCauseFunc](),
 
 		availableContainersLock: sync.RWMutex{},
 		containerRepo:           containerRepo,
@@ -85,11 +98,14 @@ func NewRequestBuffer(
 
 	go b.discoverContainers()
 	go b.processRequests()
+	go b.abortOnCancel()
 
 	return b
 }
 
 func (rb *RequestBuffer) ForwardRequest(ctx echo.Context, payload *types.TaskPayload, taskMessage *types.TaskMessage) error {
+	rb.recordArrival(taskMessage.TaskId)
+
 	done := make(chan bool)
 	rb.buffer.Push(request{
 		ctx:         ctx,
@@ -140,6 +156,95 @@ func (rb *RequestBuffer) Length() int {
 	return int(rb.length.Load())
 }
 
+// InFlightRequests returns the number of requests being handled by the stub's containers, as of the last
+// time they were discovered
+func (rb *RequestBuffer) InFlightRequests() int {
+	rb.availableContainersLock.RLock()
+	defer rb.availableContainersLock.RUnlock()
+
+	inFlightRequests := 0
+	for _, c := range rb.availableContainers {
+		inFlightRequests += c.inFlightRequests
+	}
+
+	return inFlightRequests
+}
+
+// RequestsPerSecond returns the rate that requests arrived at the stub over the sample window, across all gateways
+func (rb *RequestBuffer) RequestsPerSecond() (float64, error) {
+	since := strconv.FormatInt(time.Now().Add(-requestSampleWindow).UnixMilli(), 10)
+	count, err := rb.rdb.ZCount(rb.ctx, Keys.endpointRequestArrivals(rb.workspace.Name, rb.stubId), since, "+inf").Result()
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(count) / requestSampleWindow.Seconds(), nil
+}
+
+// RequestLatency returns the given percentile of the durations of requests that completed over the sample window,
+// across all gateways. It returns 0 if no requests completed.
+func (rb *RequestBuffer) RequestLatency(percentile float64) (time.Duration, error) {
+	since := strconv.FormatInt(time.Now().Add(-requestSampleWindow).UnixMilli(), 10)
+	members, err := rb.rdb.ZRangeByScore(rb.ctx, Keys.endpointRequestDurations(rb.workspace.Name, rb.stubId), &redis.ZRangeBy{
+		Min: since,
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	durations := make([]time.Duration, 0, len(members))
+	for _, member := range members {
+		// Members are "<task id>:<duration in ms>", so requests that took as long as each other are kept apart
+		_, durationMs, ok := strings.Cut(member, ":")
+		if !ok {
+			continue
+		}
+
+		ms, err := strconv.ParseInt(durationMs, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		durations = append(durations, time.Duration(ms)*time.Millisecond)
+	}
+
+	return latencyPercentile(durations, percentile), nil
+}
+
+// latencyPercentile returns the nearest-rank percentile of a set of durations
+func latencyPercentile(durations []time.Duration, percentile float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	slices.Sort(durations)
+	rank := int(math.Ceil(percentile / 100 * float64(len(durations))))
+	rank = min(max(rank, 1), len(durations))
+
+	return durations[rank-1]
+}
+
+func (rb *RequestBuffer) recordArrival(taskId string) {
+	rb.recordSample(Keys.endpointRequestArrivals(rb.workspace.Name, rb.stubId), taskId)
+}
+
+func (rb *RequestBuffer) recordDuration(taskId string, startedAt time.Time) {
+	member := fmt.Sprintf("%s:%d", taskId, time.Since(startedAt).Milliseconds())
+	rb.recordSample(Keys.endpointRequestDurations(rb.workspace.Name, rb.stubId), member)
+}
+
+// recordSample adds a sample to a set scored by when it was recorded, and drops samples that fell out of the window
+func (rb *RequestBuffer) recordSample(key string, member string) {
+	now := time.Now()
+	rb.rdb.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(context.Background(), key, redis.Z{Score: float64(now.UnixMilli()), Member: member})
+		pipe.ZRemRangeByScore(context.Background(), key, "-inf", strconv.FormatInt(now.Add(-requestSampleWindow).UnixMilli(), 10))
+		pipe.Expire(context.Background(), key, requestSampleWindow)
+		return nil
+	})
+}
+
 func (rb *RequestBuffer) checkAddressIsReady(address string) bool {
 	httpClient, err := rb.getHttpClient(address)
 	if err != nil {
@@ -224,6 +329,7 @@ func (rb *RequestBuffer) discoverContainers() {
 			rb.availableContainersLock.Lock()
 			rb.availableContainers = availableContainers
 			rb.availableContainersLock.Unlock()
+			rb.balancer.retain(availableContainers)
 
 			time.Sleep(1 * time.Second)
 		}
@@ -241,6 +347,38 @@ func (rb *RequestBuffer) requestsInFlight(containerId string) (int, error) {
 	return val, nil
 }
 
+// requestsInFlightByContainer reads the current in-flight requests of containers, skipping any that can't be read
+func (rb *RequestBuffer) requestsInFlightByContainer(containers []container) map[string]int {
+	keys := make([]string, len(containers))
+	for i, c := range containers {
+		keys[i] = Keys.endpointRequestsInFlight(rb.workspace.Name, rb.stubId, c.id)
+	}
+
+	inFlight := make(map[string]int, len(containers))
+	values, err := rb.rdb.MGet(rb.ctx, keys...).Result()
+	if err != nil {
+		return inFlight
+	}
+
+	for i, value := range values {
+		if value == nil {
+			inFlight[containers[i].id] = 0
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		if requests, err := strconv.Atoi(str); err == nil {
+			inFlight[containers[i].id] = requests
+		}
+	}
+
+	return inFlight
+}
+
 func (rb *RequestBuffer) incrementRequestsInFlight(containerId string) error {
 	err := rb.rdb.Incr(rb.ctx, Keys.endpointRequestsInFlight(rb.workspace.Name, rb.stubId, containerId)).Err()
 	if err != nil {
@@ -297,111 +435,239 @@ func (rb *RequestBuffer) getHttpClient(address string) (*http.Client, error) {
 
 func (rb *RequestBuffer) handleHttpRequest(req request) {
 	rb.availableContainersLock.RLock()
-	if len(rb.availableContainers) == 0 {
-		rb.availableContainersLock.RUnlock()
+	containers := rb.availableContainers
+	rb.availableContainersLock.RUnlock()
+
+	if len(containers) == 0 {
 		rb.buffer.Push(req)
 		return
 	}
 
-	// Select an available container to forward the request to (whichever one has the lowest # of inflight requests)
-	// Basically least-connections load balancing
-	c := rb.availableContainers[0]
-	rb.availableContainersLock.RUnlock()
+	request := req.ctx.Request()
+	passthrough := rb.stubConfig.EndpointMode == types.EndpointModeASGI
 
-	err := rb.incrementRequestsInFlight(c.id)
-	if err != nil {
-		return
+	var requestBody []byte
+	if !passthrough {
+		body, err := json.Marshal(req.payload)
+		if err != nil {
+			return
+		}
+		requestBody = body
 	}
 
-	request := req.ctx.Request()
-	requestBody, err := json.Marshal(req.payload)
+	requestCtx, cancel := context.WithCancelCause(request.Context())
+	defer cancel(nil)
+
+	rb.abortFuncs.Set(req.taskMessage.TaskId, cancel)
+	defer rb.abortFuncs.Delete(req.taskMessage.TaskId)
+
+	hashKey := ""
+	if rb.balancer.hashHeader != "" {
+		hashKey = request.Header.Get(rb.balancer.hashHeader)
+	}
+
+	// Retry upstream failures on other containers, up to a limit. A streamed body can only be sent once, so
+	// passed through requests with a body aren't retried.
+	attempts := maxRequestAttempts
+	if passthrough && request.ContentLength != 0 && !isWebSocketUpgrade(request) {
+		attempts = 1
+	}
+
+	tried := map[string]bool{}
+	for attempt := 1; attempt <= attempts; attempt++ {
+		c, ok := rb.balancer.choose(containers, tried, hashKey, rb.requestsInFlightByContainer)
+		if !ok {
+			break
+		}
+		tried[c.id] = true
+
+		if passthrough && isWebSocketUpgrade(request) {
+			if rb.proxyWebSocket(requestCtx, req, c) {
+				return
+			}
+			continue
+		}
+
+		lastAttempt := attempt == attempts || len(tried) == len(containers)
+		if rb.forwardToContainer(requestCtx, req, c, requestBody, lastAttempt) {
+			return
+		}
+	}
+
+	req.ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+		"error": "Internal server error",
+	})
+	req.done <- true
+}
+
+// forwardToContainer sends a request to a container and streams its response back to the client. If the container
+// fails before responding and this isn't the last attempt, the client isn't responded to and false is returned, so
+// the request can be retried on another container.
+func (rb *RequestBuffer) forwardToContainer(ctx context.Context, req request, c container, requestBody []byte, lastAttempt bool) bool {
+	err := rb.incrementRequestsInFlight(c.id)
 	if err != nil {
-		return
+		return false
 	}
 
 	httpClient, err := rb.getHttpClient(c.address)
 	if err != nil {
-		return
+		rb.decrementRequestsInFlight(c.id)
+		rb.balancer.recordFailure(c.id)
+		return false
 	}
 
-	containerUrl := fmt.Sprintf("http://%s", c.address)
-	httpReq, err := http.NewRequestWithContext(request.Context(), request.Method, containerUrl, bytes.NewReader(requestBody))
+	attemptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	httpReq, err := rb.newContainerRequest(attemptCtx, req, c.address, requestBody)
 	if err != nil {
+		rb.decrementRequestsInFlight(c.id)
 		req.ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Internal server error",
 		})
 		req.done <- true
-		return
+		return true
 	}
 
 	httpReq.Header.Add("X-TASK-ID", req.taskMessage.TaskId) // Add task ID to header
-	go rb.heartBeat(req, c.id)                              // Send heartbeat via redis for duration of request
+	go rb.heartBeat(attemptCtx, req, c.id)                  // Send heartbeat via redis for duration of request
 
+	startedAt := time.Now()
 	resp, err := httpClient.Do(httpReq)
 	if err != nil {
+		rb.decrementRequestsInFlight(c.id)
+
+		if context.Cause(ctx) == errTaskAborted {
+			req.ctx.JSON(http.StatusGatewayTimeout, map[string]interface{}{
+				"error": errTaskAborted.Error(),
+			})
+			req.done <- true
+			return true
+		}
+
+		// Only failures of the container count against it, not the client going away
+		if ctx.Err() == nil {
+			if rb.balancer.recordFailure(c.id) {
+				log.Printf("<%s> ejected container %s after %d consecutive upstream failures\n", rb.stubId, c.id, containerEjectionErrors)
+			}
+
+			if !lastAttempt {
+				return false
+			}
+		}
+
 		req.ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Internal server error",
 		})
 		req.done <- true
-		return
+		return true
+	}
+
+	if isUpstreamFailure(resp.StatusCode) {
+		if rb.balancer.recordFailure(c.id) {
+			log.Printf("<%s> ejected container %s after %d consecutive upstream failures\n", rb.stubId, c.id, containerEjectionErrors)
+		}
+
+		if !lastAttempt {
+			resp.Body.Close()
+			rb.decrementRequestsInFlight(c.id)
+			return false
+		}
+	} else {
+		rb.balancer.recordSuccess(c.id)
 	}
 
 	defer resp.Body.Close()
 	defer rb.afterRequest(req, c.id)
 
-	// Write response headers
-	for key, values := range resp.Header {
-		for _, value := range values {
-			req.ctx.Response().Writer.Header().Add(key, value)
-		}
+	// A stream's latency is how long it took to start, not how long it stayed open
+	if isStreamingResponse(resp) {
+		rb.recordDuration(req.taskMessage.TaskId, startedAt)
+	} else {
+		defer rb.recordDuration(req.taskMessage.TaskId, startedAt)
 	}
 
-	// Write status code header
-	req.ctx.Response().Writer.WriteHeader(resp.StatusCode)
+	// Once the response has started, an error can't be sent to the client anymore
+	err = streamResponse(req, resp, cancel)
+	if err != nil && err != errClientDisconnected {
+		log.Printf("<%s> response to task %s failed after it was started: %v\n", rb.stubId, req.taskMessage.TaskId, err)
+		abortResponse(req.ctx.Response())
+	}
+
+	return true
+}
 
-	// Check if we can stream the response
-	streamingSupported := true
-	flusher, ok := req.ctx.Response().Writer.(http.Flusher)
-	if !ok {
-		streamingSupported = false
+// newContainerRequest builds the request sent to a container. Endpoints in the asgi mode get the client's request
+// passed through, and other endpoints get its payload.
+func (rb *RequestBuffer) newContainerRequest(ctx context.Context, req request, address string, requestBody []byte) (*http.Request, error) {
+	if rb.stubConfig.EndpointMode == types.EndpointModeASGI {
+		return newPassthroughRequest(ctx, req, address)
 	}
 
-	// Send response to client in chunks
-	buf := make([]byte, 4096)
+	containerUrl := fmt.Sprintf("http://%s", address)
+	return http.NewRequestWithContext(ctx, req.ctx.Request().Method, containerUrl, bytes.NewReader(requestBody))
+}
+
+// isUpstreamFailure reports whether a container's response means it couldn't handle the request, rather than that
+// the request's handler failed
+func isUpstreamFailure(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable
+}
+
+// abortOnCancel aborts forwarded requests when their task is cancelled, or timed out by the dispatcher.
+// Every task of the stub is watched with a single subscription, which is resubscribed if it fails.
+func (rb *RequestBuffer) abortOnCancel() {
+	pattern := common.RedisKeys.TaskCancel(rb.workspace.Name, rb.stubId, "*")
+
 	for {
-		n, err := resp.Body.Read(buf)
-		if n > 0 {
-			req.ctx.Response().Writer.Write(buf[:n])
+		rb.watchCancellations(pattern)
 
-			if streamingSupported {
-				flusher.Flush()
-			}
+		select {
+		case <-rb.ctx.Done():
+			return
+		case <-time.After(time.Second):
 		}
+	}
+}
 
-		if err != nil {
-			if err != io.EOF {
-				req.ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": "Internal server error",
-				})
+func (rb *RequestBuffer) watchCancellations(pattern string) {
+	messages, errs, close := rb.rdb.PSubscribe(rb.ctx, pattern)
+	defer close()
+
+	for {
+		select {
+		case <-rb.ctx.Done():
+			return
+		case msg := <-messages:
+			if msg == nil {
+				continue
 			}
 
-			break
+			if abort, ok := rb.abortFuncs.Get(msg.Payload); ok {
+				abort(errTaskAborted)
+			}
+		case err := <-errs:
+			log.Printf("<%s> error with task cancellation subscription: %v\n", rb.stubId, err)
+			return
 		}
 	}
 }
 
-func (rb *RequestBuffer) heartBeat(req request, containerId string) {
-	ctx := req.ctx.Request().Context()
+// heartBeat keeps a request alive for as long as it's being handled, including while its response is streamed.
+// The heartbeat outlives the interval it's refreshed at, so it doesn't lapse between refreshes, and the container's
+// in-flight requests are kept from expiring so long requests stay counted by the autoscaler.
+func (rb *RequestBuffer) heartBeat(ctx context.Context, req request, containerId string) {
 	ticker := time.NewTicker(endpointRequestHeartbeatInterval)
 	defer ticker.Stop()
 
-	rb.rdb.Set(rb.ctx, Keys.endpointRequestHeartbeat(rb.workspace.Name, rb.stubId, req.taskMessage.TaskId), containerId, endpointRequestHeartbeatInterval)
+	rb.rdb.Set(rb.ctx, Keys.endpointRequestHeartbeat(rb.workspace.Name, rb.stubId, req.taskMessage.TaskId), containerId, endpointRequestHeartbeatTTL)
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			rb.rdb.Set(rb.ctx, Keys.endpointRequestHeartbeat(rb.workspace.Name, rb.stubId, req.taskMessage.TaskId), containerId, endpointRequestHeartbeatInterval)
+			rb.rdb.Set(rb.ctx, Keys.endpointRequestHeartbeat(rb.workspace.Name, rb.stubId, req.taskMessage.TaskId), containerId, endpointRequestHeartbeatTTL)
+			rb.rdb.Expire(rb.ctx, Keys.endpointRequestsInFlight(rb.workspace.Name, rb.stubId, containerId), time.Duration(endpointRequestTimeoutS)*time.Second)
 		}
 	}
 }
@@ -0,0 +1,43 @@
+package endpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbortOnCancel(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rb := &RequestBuffer{
+		ctx:        ctx,
+		rdb:        rdb,
+		workspace:  &types.Workspace{Name: "workspace"},
+		stubId:     "stub",
+		abortFuncs: common.NewSafeMap[context.CancelCauseFunc](),
+	}
+	go rb.abortOnCancel()
+
+	requestCtx1, abort1 := context.WithCancelCause(context.Background())
+	requestCtx2, abort2 := context.WithCancelCause(context.Background())
+	rb.abortFuncs.Set("task-1", abort1)
+	rb.abortFuncs.Set("task-2", abort2)
+
+	// Only the cancelled task's request is aborted
+	assert.Eventually(t, func() bool {
+		rdb.Publish(ctx, common.RedisKeys.TaskCancel("workspace", "stub", "task-1"), "task-1")
+		return requestCtx1.Err() != nil
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, errTaskAborted, context.Cause(requestCtx1))
+	assert.Nil(t, requestCtx2.Err())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
@@ -51,6 +52,7 @@ var (
 	endpointServeContainerTimeout           time.Duration = 10 * time.Minute
 	endpointServeContainerKeepaliveInterval time.Duration = 30 * time.Second
 	endpointRequestHeartbeatInterval        time.Duration = 30 * time.Second
+	endpointRequestHeartbeatTTL             time.Duration = 2 * endpointRequestHeartbeatInterval
 	endpointMinRequestBufferSize            int           = 10
 )
 
@@ -128,6 +130,17 @@ func NewHTTPEndpointService(
 	)
 	go eventBus.ReceiveEvents(ctx)
 
+	// Start deployments that keep containers running without traffic
+	go func() {
+		err := abstractions.WarmDeployments(ctx, opts.BackendRepo, types.StubTypeEndpointDeployment, func(stubId string) error {
+			_, err := es.getOrCreateEndpointInstance(stubId)
+			return err
+		})
+		if err != nil {
+			log.Printf("unable to warm deployments: %v\n", err)
+		}
+	}()
+
 	// Register task dispatcher
 	es.taskDispatcher.Register(string(types.ExecutorEndpoint), es.endpointTaskFactory)
 
@@ -183,11 +196,27 @@ func (es *HttpEndpointService) forwardRequest(
 		})
 	}
 
-	payload, err := task.SerializeHttpPayload(ctx)
-	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": err.Error(),
-		})
+	// Requests to endpoints in the asgi mode are passed through untouched, so their bodies aren't decoded
+	payload := &types.TaskPayload{Kwargs: map[string]interface{}{}}
+	if instance.StubConfig.EndpointMode != types.EndpointModeASGI {
+		if ctx.Param("*") != "" {
+			return ctx.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Not found",
+			})
+		}
+
+		if method := ctx.Request().Method; method != http.MethodGet && method != http.MethodPost {
+			return ctx.JSON(http.StatusMethodNotAllowed, map[string]interface{}{
+				"error": "Method not allowed",
+			})
+		}
+
+		payload, err = task.SerializeHttpPayload(ctx)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
 	}
 
 	task, err := es.taskDispatcher.Send(ctx.Request().Context(), string(types.ExecutorEndpoint), authInfo, stubId, payload, types.TaskPolicy{
@@ -250,6 +279,7 @@ func (es *HttpEndpointService) getOrCreateEndpointInstance(stubId string, option
 		BackendRepo:         es.backendRepo,
 		TaskRepo:            es.taskRepo,
 		InstanceLockKey:     Keys.endpointInstanceLock(stub.Workspace.Name, stubId),
+		MaxReplicas:         es.config.GatewayService.MaxReplicas,
 		StartContainersFunc: instance.startContainers,
 		StopContainersFunc:  instance.stopContainers,
 	})
@@ -269,7 +299,12 @@ func (es *HttpEndpointService) getOrCreateEndpointInstance(stubId string, option
 
 	if instance.Autoscaler == nil {
 		if stub.Type.IsDeployment() {
-			instance.Autoscaler = abstractions.NewAutoscaler(instance, endpointSampleFunc, endpointDeploymentScaleFunc)
+			switch stubConfig.Autoscaler.Type {
+			case types.ConcurrencyAutoscaler, types.RPSAutoscaler, types.P95LatencyAutoscaler:
+				instance.Autoscaler = abstractions.NewStabilizedAutoscaler(instance, endpointSampleFunc, endpointDeploymentScaleFunc)
+			default:
+				instance.Autoscaler = abstractions.NewAutoscaler(instance, endpointSampleFunc, endpointDeploymentScaleFunc)
+			}
 		} else if stub.Type.IsServe() {
 			instance.Autoscaler = abstractions.NewAutoscaler(instance, endpointSampleFunc, endpointServeScaleFunc)
 		}
@@ -301,6 +336,8 @@ var (
 	endpointRequestsInFlight string = "endpoint:%s:%s:requests_in_flight:%s"
 	endpointRequestHeartbeat string = "endpoint:%s:%s:request_heartbeat:%s"
 	endpointServeLock        string = "endpoint:%s:%s:serve_lock"
+	endpointRequestArrivals  string = "endpoint:%s:%s:request_arrivals"
+	endpointRequestDurations string = "endpoint:%s:%s:request_durations"
 )
 
 func (k *keys) endpointKeepWarmLock(workspaceName, stubId, containerId string) string {
@@ -322,3 +359,11 @@ func (k *keys) endpointRequestHeartbeat(workspaceName, stubId, taskId string) st
 func (k *keys) endpointServeLock(workspaceName, stubId string) string {
 	return fmt.Sprintf(endpointServeLock, workspaceName, stubId)
 }
+
+func (k *keys) endpointRequestArrivals(workspaceName, stubId string) string {
+	return fmt.Sprintf(endpointRequestArrivals, workspaceName, stubId)
+}
+
+func (k *keys) endpointRequestDurations(workspaceName, stubId string) string {
+	return fmt.Sprintf(endpointRequestDurations, workspaceName, stubId)
+}
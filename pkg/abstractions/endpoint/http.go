@@ -2,6 +2,7 @@ package endpoint
 
 import (
 	"strconv"
+	"strings"
 
 	apiv1 "github.com/beam-cloud/beta9/pkg/api/v1"
 	"github.com/beam-cloud/beta9/pkg/auth"
@@ -17,17 +18,17 @@ type endpointGroup struct {
 func registerEndpointRoutes(g *echo.Group, es *HttpEndpointService) *endpointGroup {
 	group := &endpointGroup{routeGroup: g, es: es}
 
-	g.POST("/id/:stubId", auth.WithAuth(group.endpointRequest))
-	g.POST("/:deploymentName", auth.WithAuth(group.endpointRequest))
-	g.POST("/:deploymentName/latest", auth.WithAuth(group.endpointRequest))
-	g.POST("/:deploymentName/v:version", auth.WithAuth(group.endpointRequest))
-	g.POST("/public/:stubId", auth.WithAssumedStubAuth(group.endpointRequest, group.es.isPublic))
-
-	g.GET("/id/:stubId", auth.WithAuth(group.endpointRequest))
-	g.GET("/:deploymentName", auth.WithAuth(group.endpointRequest))
-	g.GET("/:deploymentName/latest", auth.WithAuth(group.endpointRequest))
-	g.GET("/:deploymentName/v:version", auth.WithAuth(group.endpointRequest))
-	g.GET("/public/:stubId", auth.WithAssumedStubAuth(group.endpointRequest, group.es.isPublic))
+	// Endpoints in the asgi mode take any method, and a path after the endpoint that's passed through to their app
+	g.Any("/id/:stubId", auth.WithAuth(group.endpointRequest))
+	g.Any("/id/:stubId/*", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName/*", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName/latest", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName/latest/*", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName/v:version", auth.WithAuth(group.endpointRequest))
+	g.Any("/:deploymentName/v:version/*", auth.WithAuth(group.endpointRequest))
+	g.Any("/public/:stubId", auth.WithAssumedStubAuth(group.endpointRequest, group.es.isPublic))
+	g.Any("/public/:stubId/*", auth.WithAssumedStubAuth(group.endpointRequest, group.es.isPublic))
 
 	return group
 }
@@ -37,7 +38,7 @@ func (g *endpointGroup) endpointRequest(ctx echo.Context) error {
 
 	stubId := ctx.Param("stubId")
 	deploymentName := ctx.Param("deploymentName")
-	version := ctx.Param("version")
+	version := deploymentVersion(ctx)
 
 	if deploymentName != "" {
 		var deployment *types.DeploymentWithRelated
@@ -73,3 +74,26 @@ func (g *endpointGroup) endpointRequest(ctx echo.Context) error {
 
 	return g.es.forwardRequest(ctx, cc.AuthInfo, stubId)
 }
+
+// deploymentVersion returns the deployment version a request was routed to, or "" for the latest version. Paths
+// after a deployment's name that only look like a version, such as /values, are passed through to the latest version.
+func deploymentVersion(ctx echo.Context) string {
+	if !strings.Contains(ctx.Path(), ":version") {
+		return ""
+	}
+
+	version := ctx.Param("version")
+	if _, err := strconv.Atoi(version); err == nil {
+		return version
+	}
+
+	deploymentName := ctx.Param("deploymentName")
+	path := "v" + version
+	if suffix := ctx.Param("*"); suffix != "" {
+		path += "/" + suffix
+	}
+
+	ctx.SetParamNames("deploymentName", "*")
+	ctx.SetParamValues(deploymentName, path)
+	return ""
+}
@@ -0,0 +1,50 @@
+package endpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentVersion(t *testing.T) {
+	var version, name, path string
+	handler := func(ctx echo.Context) error {
+		version = deploymentVersion(ctx)
+		name = ctx.Param("deploymentName")
+		path = ctx.Param("*")
+		return nil
+	}
+
+	e := echo.New()
+	g := e.Group("/endpoint")
+	g.Any("/:deploymentName", handler)
+	g.Any("/:deploymentName/*", handler)
+	g.Any("/:deploymentName/latest", handler)
+	g.Any("/:deploymentName/latest/*", handler)
+	g.Any("/:deploymentName/v:version", handler)
+	g.Any("/:deploymentName/v:version/*", handler)
+
+	tests := []struct {
+		url     string
+		version string
+		path    string
+	}{
+		{url: "/endpoint/app", version: "", path: ""},
+		{url: "/endpoint/app/predict", version: "", path: "predict"},
+		{url: "/endpoint/app/latest/predict", version: "", path: "predict"},
+		{url: "/endpoint/app/v2", version: "2", path: ""},
+		{url: "/endpoint/app/v2/predict", version: "2", path: "predict"},
+		{url: "/endpoint/app/values", version: "", path: "values"},
+		{url: "/endpoint/app/values/1", version: "", path: "values/1"},
+	}
+
+	for _, tt := range tests {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.url, nil))
+		assert.Equal(t, "app", name, tt.url)
+		assert.Equal(t, tt.version, version, tt.url)
+		assert.Equal(t, tt.path, path, tt.url)
+	}
+}
@@ -47,6 +47,7 @@ func (i *endpointInstance) startContainers(containersToRun int) error {
 		fmt.Sprintf("PYTHON_VERSION=%s", i.StubConfig.PythonVersion),
 		fmt.Sprintf("CALLBACK_URL=%s", i.StubConfig.CallbackUrl),
 		fmt.Sprintf("TIMEOUT=%d", i.StubConfig.TaskPolicy.Timeout),
+		fmt.Sprintf("ENDPOINT_MODE=%s", i.StubConfig.EndpointMode),
 	}
 
 	env = append(secrets, env...)
@@ -65,10 +66,12 @@ func (i *endpointInstance) startContainers(containersToRun int) error {
 			Memory:      i.StubConfig.Runtime.Memory,
 			Gpu:         string(i.StubConfig.Runtime.Gpu),
 			GpuCount:    uint32(gpuCount),
+			GpuMemory:   i.StubConfig.Runtime.GpuMemory,
 			ImageId:     i.StubConfig.Runtime.ImageId,
 			StubId:      i.Stub.ExternalId,
 			WorkspaceId: i.Workspace.ExternalId,
 			EntryPoint:  i.EntryPoint,
+			Priority:    i.StubConfig.Priority,
 			Mounts: abstractions.ConfigureContainerRequestMounts(
 				i.Stub.Object.ExternalId,
 				i.Workspace.Name,
@@ -0,0 +1,229 @@
+package endpoint
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/beam-cloud/beta9/pkg/network"
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+// Headers that only apply to a single connection, so they aren't passed on by proxies
+var hopByHopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// newPassthroughRequest builds the request sent to a container for an endpoint in the asgi mode. The original
+// method, path after the endpoint, query, headers and body are passed through untouched.
+func newPassthroughRequest(ctx context.Context, req request, address string) (*http.Request, error) {
+	request := req.ctx.Request()
+
+	httpReq, err := http.NewRequestWithContext(ctx, request.Method, passthroughUrl(address, request, req.ctx.Param("*")), request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.ContentLength = request.ContentLength
+	httpReq.Header = passthroughHeaders(request, isWebSocketUpgrade(request))
+	httpReq.Header.Set("X-Forwarded-For", req.ctx.RealIP())
+	httpReq.Header.Set("X-Forwarded-Host", request.Host)
+	httpReq.Header.Set("X-Forwarded-Proto", req.ctx.Scheme())
+	httpReq.Header.Set("X-Forwarded-Prefix", strings.TrimSuffix(strings.TrimSuffix(request.URL.Path, req.ctx.Param("*")), "/"))
+
+	return httpReq, nil
+}
+
+// passthroughUrl returns the url of a container that a request is passed through to, given the path after the endpoint
+func passthroughUrl(address string, request *http.Request, suffix string) string {
+	u := &url.URL{
+		Scheme:   "http",
+		Host:     address,
+		Path:     "/" + suffix,
+		RawQuery: request.URL.RawQuery,
+	}
+
+	// The path after the endpoint is still escaped if the request's path was escaped in a non-default way
+	if request.URL.RawPath != "" {
+		if path, err := url.PathUnescape(suffix); err == nil {
+			u.Path = "/" + path
+			u.RawPath = "/" + suffix
+		}
+	}
+
+	return u.String()
+}
+
+// passthroughHeaders copies the headers of a request that are passed on to a container. Hop-by-hop headers are
+// dropped, except the ones needed to upgrade the connection, and so are the gateway's credentials.
+func passthroughHeaders(request *http.Request, upgrade bool) http.Header {
+	header := request.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	removeHopByHopHeaders(header)
+	header.Del("Authorization")
+	header.Del("X-TASK-ID")
+
+	if upgrade {
+		header.Set("Connection", "Upgrade")
+		header.Set("Upgrade", request.Header.Get("Upgrade"))
+	}
+
+	return header
+}
+
+func removeHopByHopHeaders(header http.Header) {
+	// Connection can list more headers that only apply to this connection
+	for _, value := range header.Values("Connection") {
+		for _, name := range strings.Split(value, ",") {
+			if name = textproto.TrimString(name); name != "" {
+				header.Del(name)
+			}
+		}
+	}
+
+	for _, name := range hopByHopHeaders {
+		header.Del(name)
+	}
+}
+
+func isWebSocketUpgrade(request *http.Request) bool {
+	return headerContainsToken(request.Header, "Connection", "upgrade") &&
+		strings.EqualFold(request.Header.Get("Upgrade"), "websocket")
+}
+
+func headerContainsToken(header http.Header, name string, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, t := range strings.Split(value, ",") {
+			if strings.EqualFold(textproto.TrimString(t), token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// proxyWebSocket opens a websocket to a container and relays frames between it and the client until either side
+// closes it. If the container can't be reached, the client isn't responded to and false is returned, so the
+// connection can be retried on another container.
+func (rb *RequestBuffer) proxyWebSocket(ctx context.Context, req request, c container) bool {
+	err := rb.incrementRequestsInFlight(c.id)
+	if err != nil {
+		return false
+	}
+
+	conn, err := network.ConnectToHost(ctx, c.address, types.RequestTimeoutDurationS, rb.tailscale, rb.tsConfig)
+	if err != nil {
+		rb.decrementRequestsInFlight(c.id)
+		if ctx.Err() == nil && rb.balancer.recordFailure(c.id) {
+			log.Printf("<%s> ejected container %s after %d consecutive upstream failures\n", rb.stubId, c.id, containerEjectionErrors)
+		}
+		return false
+	}
+	defer conn.Close()
+
+	proxyCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upstreamReq, err := newPassthroughRequest(proxyCtx, req, c.address)
+	if err != nil {
+		rb.decrementRequestsInFlight(c.id)
+		return false
+	}
+	upstreamReq.Header.Add("X-TASK-ID", req.taskMessage.TaskId)
+
+	go rb.heartBeat(proxyCtx, req, c.id) // Send heartbeat via redis for as long as the connection is open
+	defer rb.afterRequest(req, c.id)
+
+	upstream := bufio.NewReader(conn)
+	resp, err := upgradeUpstream(conn, upstream, upstreamReq)
+	if err != nil {
+		if rb.balancer.recordFailure(c.id) {
+			log.Printf("<%s> ejected container %s after %d consecutive upstream failures\n", rb.stubId, c.id, containerEjectionErrors)
+		}
+
+		req.ctx.JSON(http.StatusBadGateway, map[string]interface{}{
+			"error": "Unable to connect to container",
+		})
+		return true
+	}
+	defer resp.Body.Close()
+	rb.balancer.recordSuccess(c.id)
+
+	// The app turned the websocket down, so its response is passed on as is
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		removeHopByHopHeaders(resp.Header)
+		for key, values := range resp.Header {
+			for _, value := range values {
+				req.ctx.Response().Header().Add(key, value)
+			}
+		}
+
+		req.ctx.Response().WriteHeader(resp.StatusCode)
+		io.Copy(req.ctx.Response(), resp.Body)
+		return true
+	}
+
+	// Connections that can't be taken over, such as HTTP/2 streams, can't carry a websocket
+	client, clientBuf, err := req.ctx.Response().Hijack()
+	if err != nil {
+		req.ctx.JSON(http.StatusHTTPVersionNotSupported, map[string]interface{}{
+			"error": "Websockets require HTTP/1.1",
+		})
+		return true
+	}
+	defer client.Close()
+
+	if _, err := fmt.Fprintf(client, "HTTP/1.1 %s\r\n", resp.Status); err != nil {
+		return true
+	}
+	if err := resp.Header.Write(client); err != nil {
+		return true
+	}
+	if _, err := io.WriteString(client, "\r\n"); err != nil {
+		return true
+	}
+
+	// Both sides are read through their buffers, since either may hold frames sent right after the upgrade
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(conn, clientBuf)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(client, upstream)
+		errs <- err
+	}()
+
+	select {
+	case <-proxyCtx.Done():
+	case <-errs:
+	}
+
+	return true
+}
+
+// upgradeUpstream sends the upgrade request to a container and reads its response
+func upgradeUpstream(conn io.Writer, upstream *bufio.Reader, upstreamReq *http.Request) (*http.Response, error) {
+	if err := upstreamReq.Write(conn); err != nil {
+		return nil, err
+	}
+
+	return http.ReadResponse(upstream, upstreamReq)
+}
@@ -0,0 +1,176 @@
+package endpoint
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassthroughUrl(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/endpoint/id/stub-id/v1/items?limit=10&tag=a%20b", nil)
+	assert.Equal(t, "http://10.0.0.1:8001/v1/items?limit=10&tag=a%20b", passthroughUrl("10.0.0.1:8001", request, "v1/items"))
+
+	// Escaped slashes in the path are kept escaped
+	request = httptest.NewRequest(http.MethodGet, "/endpoint/id/stub-id/files/a%2Fb", nil)
+	assert.Equal(t, "http://10.0.0.1:8001/files/a%2Fb", passthroughUrl("10.0.0.1:8001", request, "files/a%2Fb"))
+
+	request = httptest.NewRequest(http.MethodPost, "/endpoint/id/stub-id", nil)
+	assert.Equal(t, "http://10.0.0.1:8001/", passthroughUrl("10.0.0.1:8001", request, ""))
+}
+
+func TestPassthroughHeaders(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/endpoint/id/stub-id/upload", nil)
+	request.Header.Set("Content-Type", "multipart/form-data; boundary=abc")
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set("X-TASK-ID", "spoofed")
+	request.Header.Set("Connection", "keep-alive, X-Internal")
+	request.Header.Set("X-Internal", "1")
+	request.Header.Set("Keep-Alive", "timeout=5")
+	request.Header.Add("X-Custom", "a")
+	request.Header.Add("X-Custom", "b")
+
+	header := passthroughHeaders(request, false)
+	assert.Equal(t, "multipart/form-data; boundary=abc", header.Get("Content-Type"))
+	assert.Equal(t, []string{"a", "b"}, header.Values("X-Custom"))
+	assert.Empty(t, header.Get("Authorization"))
+	assert.Empty(t, header.Get("X-TASK-ID"))
+	assert.Empty(t, header.Get("Connection"))
+	assert.Empty(t, header.Get("X-Internal"))
+	assert.Empty(t, header.Get("Keep-Alive"))
+
+	// The original request isn't changed
+	assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+}
+
+func TestPassthroughHeadersWebSocket(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/endpoint/id/stub-id/ws", nil)
+	request.Header.Set("Connection", "keep-alive, Upgrade")
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	assert.True(t, isWebSocketUpgrade(request))
+
+	header := passthroughHeaders(request, true)
+	assert.Equal(t, "Upgrade", header.Get("Connection"))
+	assert.Equal(t, "websocket", header.Get("Upgrade"))
+	assert.Equal(t, "dGhlIHNhbXBsZSBub25jZQ==", header.Get("Sec-WebSocket-Key"))
+
+	request.Header.Set("Upgrade", "h2c")
+	assert.False(t, isWebSocketUpgrade(request))
+}
+
+func TestProxyWebSocket(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	// The container accepts the upgrade and echoes back whatever it's sent
+	upstreamRequests := make(chan *http.Request, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamRequests <- r
+
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		io.Copy(conn, buf)
+	}))
+	defer upstream.Close()
+
+	rb := &RequestBuffer{
+		ctx:        context.Background(),
+		rdb:        rdb,
+		workspace:  &types.Workspace{Name: "test"},
+		stubId:     "stub-id",
+		stubConfig: &types.StubConfigV1{EndpointMode: types.EndpointModeASGI},
+		balancer:   newLoadBalancer(nil),
+	}
+
+	e := echo.New()
+	e.GET("/endpoint/id/:stubId/*", func(ctx echo.Context) error {
+		rb.proxyWebSocket(ctx.Request().Context(), request{
+			ctx:         ctx,
+			taskMessage: &types.TaskMessage{TaskId: "task-id"},
+			done:        make(chan bool, 1),
+		}, container{id: "container-id", address: strings.TrimPrefix(upstream.URL, "http://")})
+		return nil
+	})
+
+	gateway := httptest.NewServer(e)
+	defer gateway.Close()
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(gateway.URL, "http://"))
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	io.WriteString(conn, "GET /endpoint/id/stub-id/chat?room=1 HTTP/1.1\r\nHost: gateway\r\nAuthorization: Bearer token\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n")
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+	upstreamReq := <-upstreamRequests
+	assert.Equal(t, "/chat", upstreamReq.URL.Path)
+	assert.Equal(t, "room=1", upstreamReq.URL.RawQuery)
+	assert.Equal(t, "task-id", upstreamReq.Header.Get("X-TASK-ID"))
+	assert.Equal(t, "/endpoint/id/stub-id", upstreamReq.Header.Get("X-Forwarded-Prefix"))
+	assert.Empty(t, upstreamReq.Header.Get("Authorization"))
+
+	io.WriteString(conn, "hello")
+	echoed := make([]byte, 5)
+	_, err = io.ReadFull(reader, echoed)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(echoed))
+}
+
+func TestProxyWebSocketWithoutHijack(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+	}))
+	defer upstream.Close()
+
+	rb := &RequestBuffer{
+		ctx:        context.Background(),
+		rdb:        rdb,
+		workspace:  &types.Workspace{Name: "test"},
+		stubId:     "stub-id",
+		stubConfig: &types.StubConfigV1{EndpointMode: types.EndpointModeASGI},
+		balancer:   newLoadBalancer(nil),
+	}
+
+	// The client's connection can't be taken over, so it's told websockets aren't supported
+	httpReq := httptest.NewRequest(http.MethodGet, "/endpoint/id/stub-id/chat", nil)
+	httpReq.Header.Set("Connection", "Upgrade")
+	httpReq.Header.Set("Upgrade", "websocket")
+	recorder := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httpReq, recorder)
+
+	handled := rb.proxyWebSocket(context.Background(), request{
+		ctx:         ctx,
+		taskMessage: &types.TaskMessage{TaskId: "task-id"},
+		done:        make(chan bool, 1),
+	}, container{id: "container-id", address: strings.TrimPrefix(upstream.URL, "http://")})
+	assert.True(t, handled)
+	assert.Equal(t, http.StatusHTTPVersionNotSupported, recorder.Code)
+}
@@ -0,0 +1,112 @@
+package endpoint
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+var errClientDisconnected = errors.New("client disconnected")
+
+const (
+	streamBufferSize   int           = 4096
+	streamWriteTimeout time.Duration = 30 * time.Second // How long a client can stall a response before it's treated as disconnected
+)
+
+// streamResponse copies a container's response to the client, flushing each chunk as soon as it's read so streamed
+// responses, like server-sent events, reach the client right away. The container is only read from once the client
+// has taken the last chunk, so a slow client slows down the container instead of the response piling up in the
+// gateway. If the client goes away, cancel closes the connection to the container so it can stop generating it.
+func streamResponse(req request, resp *http.Response, cancel func()) error {
+	res := req.ctx.Response()
+	rc := http.NewResponseController(res.Writer)
+	defer rc.SetWriteDeadline(time.Time{})
+
+	removeHopByHopHeaders(resp.Header)
+	for key, values := range resp.Header {
+		for _, value := range values {
+			res.Header().Add(key, value)
+		}
+	}
+
+	if isEventStream(resp.Header) {
+		// Keep proxies in front of the gateway from buffering or caching events
+		res.Header().Del("Content-Length")
+		res.Header().Set("X-Accel-Buffering", "no")
+		if res.Header().Get("Cache-Control") == "" {
+			res.Header().Set("Cache-Control", "no-cache")
+		}
+	}
+
+	// Headers are sent right away, since the first chunk of a stream can take a while
+	res.WriteHeader(resp.StatusCode)
+	if err := flush(rc); err != nil {
+		cancel()
+		return errClientDisconnected
+	}
+
+	buf := make([]byte, streamBufferSize)
+	for {
+		n, readErr := resp.Body.Read(buf)
+		if n > 0 {
+			rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+
+			if _, err := res.Write(buf[:n]); err != nil {
+				cancel()
+				return errClientDisconnected
+			}
+
+			if err := flush(rc); err != nil {
+				cancel()
+				return errClientDisconnected
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+
+		if readErr != nil {
+			if req.ctx.Request().Context().Err() != nil {
+				return errClientDisconnected
+			}
+
+			return readErr
+		}
+	}
+}
+
+// flush sends buffered data to the client, if its connection supports it
+func flush(rc *http.ResponseController) error {
+	err := rc.Flush()
+	if errors.Is(err, http.ErrNotSupported) {
+		return nil
+	}
+
+	return err
+}
+
+// abortResponse closes the client's connection, so a response that fails after it was started isn't mistaken for a
+// complete one. Clients of an event stream reconnect when this happens.
+func abortResponse(res *echo.Response) {
+	conn, _, err := http.NewResponseController(res.Writer).Hijack()
+	if err != nil {
+		return
+	}
+
+	conn.Close()
+}
+
+// isStreamingResponse reports whether a container's response is streamed, rather than sent all at once
+func isStreamingResponse(resp *http.Response) bool {
+	return isEventStream(resp.Header) || resp.ContentLength < 0
+}
+
+func isEventStream(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && mediaType == "text/event-stream"
+}
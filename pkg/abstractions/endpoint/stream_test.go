@@ -0,0 +1,101 @@
+package endpoint
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// disconnectedWriter fails writes after its first one, like a client that went away mid-stream
+type disconnectedWriter struct {
+	*httptest.ResponseRecorder
+	writes int
+}
+
+func (w *disconnectedWriter) Write(b []byte) (int, error) {
+	w.writes++
+	if w.writes > 1 {
+		return 0, errors.New("broken pipe")
+	}
+
+	return w.ResponseRecorder.Write(b)
+}
+
+func newStreamRequest(w http.ResponseWriter) request {
+	e := echo.New()
+	return request{ctx: e.NewContext(httptest.NewRequest(http.MethodPost, "/endpoint/id/stub-id", nil), w)}
+}
+
+func newUpstreamResponse(contentType string, body io.Reader) *http.Response {
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          io.NopCloser(body),
+		ContentLength: -1,
+	}
+}
+
+func TestStreamResponseEventStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+	events := "data: {\"token\": \"hello\"}\n\ndata: {\"token\": \"world\"}\n\n"
+	resp := newUpstreamResponse("text/event-stream; charset=utf-8", strings.NewReader(events))
+	resp.Header.Set("Content-Length", "100")
+
+	cancelled := false
+	err := streamResponse(newStreamRequest(rec), resp, func() { cancelled = true })
+	assert.Nil(t, err)
+	assert.False(t, cancelled)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, events, rec.Body.String())
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "no", rec.Header().Get("X-Accel-Buffering"))
+	assert.Empty(t, rec.Header().Get("Content-Length"))
+	assert.True(t, rec.Flushed)
+}
+
+func TestStreamResponseClientDisconnected(t *testing.T) {
+	w := &disconnectedWriter{ResponseRecorder: httptest.NewRecorder()}
+	resp := newUpstreamResponse("text/event-stream", io.MultiReader(
+		strings.NewReader("data: 1\n\n"),
+		strings.NewReader("data: 2\n\n"),
+		strings.NewReader("data: 3\n\n"),
+	))
+
+	// The container's connection is closed as soon as the client is gone
+	cancelled := false
+	err := streamResponse(newStreamRequest(w), resp, func() { cancelled = true })
+	assert.Equal(t, errClientDisconnected, err)
+	assert.True(t, cancelled)
+	assert.Equal(t, "data: 1\n\n", w.Body.String())
+}
+
+func TestStreamResponseUpstreamFailure(t *testing.T) {
+	rec := httptest.NewRecorder()
+	resp := newUpstreamResponse("text/event-stream", io.MultiReader(
+		strings.NewReader("data: 1\n\n"),
+		iotest.ErrReader(io.ErrUnexpectedEOF),
+	))
+
+	// No error is written into a response that already started
+	err := streamResponse(newStreamRequest(rec), resp, func() {})
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	assert.Equal(t, "data: 1\n\n", rec.Body.String())
+}
+
+func TestIsStreamingResponse(t *testing.T) {
+	assert.True(t, isStreamingResponse(newUpstreamResponse("text/event-stream", http.NoBody)))
+
+	resp := newUpstreamResponse("application/json", http.NoBody)
+	assert.True(t, isStreamingResponse(resp))
+
+	resp.ContentLength = 12
+	assert.False(t, isStreamingResponse(resp))
+}
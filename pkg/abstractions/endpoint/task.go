@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/beam-cloud/beta9/pkg/common"
 	"github.com/beam-cloud/beta9/pkg/types"
 	"github.com/labstack/echo/v4"
 )
@@ -62,6 +63,8 @@ func (t *EndpointTask) Cancel(ctx context.Context, reason types.TaskCancellation
 		task.Status = types.TaskStatusTimeout
 	case types.TaskExceededRetryLimit:
 		task.Status = types.TaskStatusError
+	case types.TaskTimedOut:
+		task.Status = types.TaskStatusTimeout
 	default:
 		task.Status = types.TaskStatusError
 	}
@@ -71,6 +74,11 @@ func (t *EndpointTask) Cancel(ctx context.Context, reason types.TaskCancellation
 		return err
 	}
 
+	// Signal the gateway forwarding the request to abort it
+	if reason == types.TaskTimedOut {
+		return t.es.rdb.Publish(ctx, common.RedisKeys.TaskCancel(t.msg.WorkspaceName, t.msg.StubId, t.msg.TaskId), t.msg.TaskId).Err()
+	}
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package function
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -36,11 +37,13 @@ const (
 	functionArgsExpirationTimeout   time.Duration = 600 * time.Second
 	functionResultExpirationTimeout time.Duration = 600 * time.Second
 	functionDefaultTaskExpiration   int           = 3600 * 12 // 12 hours
+	functionRetryPollInterval       time.Duration = time.Second
 )
 
 type RunCFunctionService struct {
 	pb.UnimplementedFunctionServiceServer
 	taskDispatcher  *task.Dispatcher
+	stubConfigCache *common.SafeMap[*types.StubConfigV1]
 	backendRepo     repository.BackendRepository
 	taskRepo        repository.TaskRepository
 	containerRepo   repository.ContainerRepository
@@ -76,6 +79,7 @@ func NewRuncFunctionService(ctx context.Context,
 
 	fs := &RunCFunctionService{
 		config:          opts.Config,
+		stubConfigCache: common.NewSafeMap[*types.StubConfigV1](),
 		backendRepo:     opts.BackendRepo,
 		taskRepo:        opts.TaskRepo,
 		containerRepo:   opts.ContainerRepo,
@@ -102,13 +106,34 @@ func (fs *RunCFunctionService) FunctionInvoke(in *pb.FunctionInvokeRequest, stre
 	authInfo, _ := auth.AuthInfoFromContext(stream.Context())
 	ctx := stream.Context()
 
-	task, err := fs.invoke(ctx, authInfo, in.StubId, &types.TaskPayload{
-		Args: []interface{}{in.Args},
-	})
+	payload := &types.TaskPayload{
+		Args:           []interface{}{in.Args},
+		DelaySeconds:   in.DelaySeconds,
+		IdempotencyKey: in.IdempotencyKey,
+	}
+	if in.RunAt != nil {
+		runAt := in.RunAt.AsTime()
+		payload.RunAt = &runAt
+	}
+
+	task, err := fs.invoke(ctx, authInfo, in.StubId, payload)
 	if err != nil {
 		return err
 	}
 
+	// Tasks that weren't started by this invocation are either delayed, or were started by an earlier
+	// invocation with the same idempotency key, in which case the caller is attached to that task
+	if task.Metadata().ContainerId == "" {
+		attached, err := fs.attach(ctx, stream, authInfo, in.StubId, task.Metadata().TaskId)
+		if err != nil || attached {
+			return err
+		}
+
+		// Delayed tasks aren't done, they're scheduled. Their output and result can be fetched by invoking
+		// again with the same idempotency key once they've started, which attaches to the task.
+		return stream.Send(&pb.FunctionInvokeResponse{TaskId: task.Metadata().TaskId, Done: false, Scheduled: true})
+	}
+
 	go func() {
 		stub, err := fs.backendRepo.GetStubByExternalId(ctx, in.StubId)
 		if err != nil {
@@ -119,12 +144,76 @@ func (fs *RunCFunctionService) FunctionInvoke(in *pb.FunctionInvokeRequest, stre
 		go fs.eventRepo.PushRunStubEvent(authInfo.Workspace.ExternalId, &stub.Stub)
 	}()
 
-	return fs.stream(ctx, stream, authInfo, task)
+	return fs.stream(ctx, stream, authInfo, in.StubId, task.Metadata().TaskId, task.Metadata().ContainerId)
+}
+
+// attach streams a task that's already running to the caller, or sends its result if it's finished.
+// It reports whether the task was attached, which it isn't if the task hasn't been started yet.
+func (fs *RunCFunctionService) attach(ctx context.Context, stream pb.FunctionService_FunctionInvokeServer, authInfo *auth.AuthInfo, stubId, taskId string) (bool, error) {
+	task, err := fs.backendRepo.GetTask(ctx, taskId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if task.ContainerId == "" {
+		return false, nil
+	}
+
+	if !task.Status.IsCompleted() {
+		return true, fs.stream(ctx, stream, authInfo, stubId, taskId, task.ContainerId)
+	}
+
+	var exitCode int32 = 0
+	if task.Status != types.TaskStatusComplete {
+		code, err := fs.containerRepo.GetContainerExitCode(task.ContainerId)
+		if err != nil || code == 0 {
+			code = 1
+		}
+		exitCode = int32(code)
+	}
+
+	result, _ := fs.rdb.Get(ctx, Keys.FunctionResult(authInfo.Workspace.Name, taskId)).Bytes()
+	return true, stream.Send(&pb.FunctionInvokeResponse{TaskId: taskId, Done: true, Result: result, ExitCode: exitCode})
+}
+
+func (fs *RunCFunctionService) getStubConfig(ctx context.Context, stubId string) (*types.StubConfigV1, error) {
+	config, exists := fs.stubConfigCache.Get(stubId)
+
+	if !exists {
+		stub, err := fs.backendRepo.GetStubByExternalId(ctx, stubId)
+		if err != nil {
+			return nil, err
+		}
+
+		var stubConfig types.StubConfigV1 = types.StubConfigV1{}
+		err = json.Unmarshal([]byte(stub.Config), &stubConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		config = &stubConfig
+		fs.stubConfigCache.Set(stubId, config)
+	}
+
+	return config, nil
 }
 
 func (fs *RunCFunctionService) invoke(ctx context.Context, authInfo *auth.AuthInfo, stubId string, payload *types.TaskPayload) (types.TaskInterface, error) {
-	policy := types.DefaultTaskPolicy
-	policy.Expires = time.Now().Add(time.Duration(functionDefaultTaskExpiration) * time.Second)
+	stubConfig, err := fs.getStubConfig(ctx, stubId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := functionTaskPolicy(stubConfig, time.Now())
+
+	notBefore, err := payload.NotBefore()
+	if err != nil {
+		return nil, err
+	}
+	policy.NotBefore = notBefore
 
 	task, err := fs.taskDispatcher.SendAndExecute(ctx, string(types.ExecutorFunction), authInfo, stubId, payload, policy)
 	if err != nil {
@@ -134,6 +223,20 @@ func (fs *RunCFunctionService) invoke(ctx context.Context, authInfo *auth.AuthIn
 	return task, err
 }
 
+// functionTaskPolicy returns the policy of a function's tasks. The timeout is the stub's own, so the dispatcher
+// agrees with FunctionMonitor on when a task times out, and a timeout <= 0 means the task never times out.
+func functionTaskPolicy(stubConfig *types.StubConfigV1, now time.Time) types.TaskPolicy {
+	policy := types.DefaultTaskPolicy
+	policy.Timeout = stubConfig.TaskPolicy.Timeout
+	policy.Expires = now.Add(time.Duration(functionDefaultTaskExpiration) * time.Second)
+	policy.BackoffBase = stubConfig.TaskPolicy.BackoffBase
+	policy.BackoffMax = stubConfig.TaskPolicy.BackoffMax
+	policy.Jitter = stubConfig.TaskPolicy.Jitter
+	policy.RetryOn = stubConfig.TaskPolicy.RetryOn
+
+	return policy
+}
+
 func (fs *RunCFunctionService) functionTaskFactory(ctx context.Context, msg types.TaskMessage) (types.TaskInterface, error) {
 	return &FunctionTask{
 		msg: &msg,
@@ -141,23 +244,31 @@ func (fs *RunCFunctionService) functionTaskFactory(ctx context.Context, msg type
 	}, nil
 }
 
-func (fs *RunCFunctionService) stream(ctx context.Context, stream pb.FunctionService_FunctionInvokeServer, authInfo *auth.AuthInfo, task types.TaskInterface) error {
-	taskId := task.Metadata().TaskId
-	containerId := task.Metadata().ContainerId
-
+// stream sends a task's output to the caller. A task that's retried runs again in a new container, so the
+// stream follows the task from container to container, and only reports it done once no attempt is left.
+func (fs *RunCFunctionService) stream(ctx context.Context, stream pb.FunctionService_FunctionInvokeServer, authInfo *auth.AuthInfo, stubId, taskId, containerId string) error {
+	// Output of each attempt is forwarded as it arrives, but the caller is only told the task is done at the end
+	var lastOutput *common.OutputMsg = nil
+	var exitCode int32 = 0
+	exited := false
 	sendCallback := func(o common.OutputMsg) error {
-		if err := stream.Send(&pb.FunctionInvokeResponse{TaskId: taskId, Output: o.Msg, Done: o.Done}); err != nil {
+		if o.Done {
+			lastOutput = &o
+		}
+
+		if o.Msg == "" {
+			return nil
+		}
+
+		if err := stream.Send(&pb.FunctionInvokeResponse{TaskId: taskId, Output: o.Msg, Done: false}); err != nil {
 			return err
 		}
 
 		return nil
 	}
 
-	exitCallback := func(exitCode int32) error {
-		result, _ := fs.rdb.Get(stream.Context(), Keys.FunctionResult(authInfo.Workspace.Name, taskId)).Bytes()
-		if err := stream.Send(&pb.FunctionInvokeResponse{TaskId: taskId, Done: true, Result: result, ExitCode: int32(exitCode)}); err != nil {
-			return err
-		}
+	exitCallback := func(code int32) error {
+		exitCode, exited = code, true
 		return nil
 	}
 
@@ -173,7 +284,85 @@ func (fs *RunCFunctionService) stream(ctx context.Context, stream pb.FunctionSer
 		return err
 	}
 
-	return logStream.Stream(ctx, authInfo, containerId)
+	for {
+		lastOutput, exited = nil, false
+
+		err = logStream.Stream(ctx, authInfo, containerId)
+		if schedulingErr, ok := err.(*types.ErrContainerSchedulingFailed); ok {
+			t, getErr := fs.backendRepo.GetTask(ctx, taskId)
+			if getErr == nil {
+				getErr = abstractions.RecordTaskSchedulingFailure(ctx, fs.backendRepo, t, schedulingErr.Error())
+			}
+			if getErr != nil {
+				log.Printf("error failing task: %v", getErr)
+			}
+
+			fs.taskDispatcher.Complete(ctx, authInfo.Workspace.Name, stubId, taskId)
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// The container's output ends with a done message, so without one the stream itself failed
+		if lastOutput == nil {
+			return err
+		}
+
+		nextContainerId, err := fs.waitForRetry(ctx, taskId, containerId)
+		if err != nil {
+			return err
+		}
+
+		if nextContainerId == "" {
+			break
+		}
+
+		containerId = nextContainerId
+	}
+
+	// The output can end before the container's exit code is reported
+	if !exited {
+		code, err := fs.containerRepo.GetContainerExitCode(containerId)
+		if err != nil && lastOutput.Success {
+			code = 0
+		}
+
+		exitCode = int32(code)
+	}
+
+	result, _ := fs.rdb.Get(ctx, Keys.FunctionResult(authInfo.Workspace.Name, taskId)).Bytes()
+	return stream.Send(&pb.FunctionInvokeResponse{TaskId: taskId, Done: true, Result: result, ExitCode: exitCode})
+}
+
+// waitForRetry returns the container running the next attempt of a task whose attempt in containerId just ended,
+// or an empty container id if the task isn't being retried
+func (fs *RunCFunctionService) waitForRetry(ctx context.Context, taskId, containerId string) (string, error) {
+	ticker := time.NewTicker(functionRetryPollInterval)
+	defer ticker.Stop()
+
+	for {
+		task, err := fs.backendRepo.GetTask(ctx, taskId)
+		if err != nil {
+			return "", err
+		}
+
+		// Retries wait out their backoff before the dispatcher starts them in a new container
+		if task.ContainerId != "" && task.ContainerId != containerId && !task.Status.IsCompleted() {
+			return task.ContainerId, nil
+		}
+
+		if task.Status != types.TaskStatusRetry {
+			return "", nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
 
 func (fs *RunCFunctionService) FunctionGetArgs(ctx context.Context, in *pb.FunctionGetArgsRequest) (*pb.FunctionGetArgsResponse, error) {
@@ -284,7 +473,11 @@ func (fs *RunCFunctionService) FunctionMonitor(req *pb.FunctionMonitorRequest, s
 
 				case msg := <-messages:
 					if msg != nil && task != nil && msg.Payload == task.ExternalId {
-						cancelFlag <- true
+						if fs.taskDispatcher.TimedOut(ctx, task.ExternalId) {
+							timeoutFlag <- true
+						} else {
+							cancelFlag <- true
+						}
 						return
 					}
 
@@ -341,6 +534,11 @@ func (fs *RunCFunctionService) FunctionMonitor(req *pb.FunctionMonitorRequest, s
 			}
 
 			if !claimed {
+				if fs.taskDispatcher.TimedOut(ctx, task.ExternalId) {
+					stream.Send(&pb.FunctionMonitorResponse{Ok: true, Cancelled: false, Complete: false, TimedOut: true})
+					return nil
+				}
+
 				stream.Send(&pb.FunctionMonitorResponse{Ok: true, Cancelled: false, Complete: true, TimedOut: false})
 			}
 
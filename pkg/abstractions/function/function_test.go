@@ -0,0 +1,106 @@
+package function
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type functionInvokeStreamForTest struct {
+	grpc.ServerStream
+	responses []*pb.FunctionInvokeResponse
+}
+
+func (s *functionInvokeStreamForTest) Send(response *pb.FunctionInvokeResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestFunctionTaskPolicyTimeout(t *testing.T) {
+	now := time.Now()
+
+	// Functions without a timeout are never timed out, however long they run
+	policy := functionTaskPolicy(&types.StubConfigV1{TaskPolicy: types.TaskPolicy{Timeout: -1}}, now)
+	claim := &types.TaskClaim{ContainerId: "function-1", ClaimedAt: now.Add(-48 * time.Hour)}
+	assert.Equal(t, -1, policy.Timeout)
+	assert.False(t, claim.TimedOut(policy, now))
+
+	// Timeouts above the default are kept
+	policy = functionTaskPolicy(&types.StubConfigV1{TaskPolicy: types.TaskPolicy{Timeout: 7200}}, now)
+	claim.ClaimedAt = now.Add(-90 * time.Minute)
+	assert.Equal(t, 7200, policy.Timeout)
+	assert.False(t, claim.TimedOut(policy, now))
+
+	claim.ClaimedAt = now.Add(-3 * time.Hour)
+	assert.True(t, claim.TimedOut(policy, now))
+}
+
+func TestWaitForRetry(t *testing.T) {
+	backendRepo, mock := repository.NewBackendPostgresRepositoryForTest()
+	fs := &RunCFunctionService{backendRepo: backendRepo}
+	taskColumns := []string{"external_id", "status", "container_id"}
+
+	// A retried task is followed into its next container once the dispatcher starts it
+	mock.ExpectQuery("SELECT (.+) FROM task").WithArgs("task-1").
+		WillReturnRows(sqlmock.NewRows(taskColumns).AddRow("task-1", types.TaskStatusRetry, "function-1"))
+	mock.ExpectQuery("SELECT (.+) FROM task").WithArgs("task-1").
+		WillReturnRows(sqlmock.NewRows(taskColumns).AddRow("task-1", types.TaskStatusRunning, "function-2"))
+
+	containerId, err := fs.waitForRetry(context.Background(), "task-1", "function-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "function-2", containerId)
+
+	// Tasks that aren't retried are done
+	mock.ExpectQuery("SELECT (.+) FROM task").WithArgs("task-1").
+		WillReturnRows(sqlmock.NewRows(taskColumns).AddRow("task-1", types.TaskStatusError, "function-2"))
+
+	containerId, err = fs.waitForRetry(context.Background(), "task-1", "function-2")
+	assert.Nil(t, err)
+	assert.Empty(t, containerId)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestAttachToFinishedTask(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	backendRepo, mock := repository.NewBackendPostgresRepositoryForTest()
+	fs := &RunCFunctionService{backendRepo: backendRepo, rdb: rdb}
+	authInfo := &auth.AuthInfo{Workspace: &types.Workspace{Name: "workspace"}}
+	stream := &functionInvokeStreamForTest{}
+	taskColumns := []string{"external_id", "status", "container_id"}
+
+	// Tasks that haven't been started yet can't be attached to
+	mock.ExpectQuery("SELECT (.+) FROM task").WithArgs("task-1").
+		WillReturnRows(sqlmock.NewRows(taskColumns).AddRow("task-1", types.TaskStatusPending, ""))
+
+	attached, err := fs.attach(context.Background(), stream, authInfo, "stub-1", "task-1")
+	assert.Nil(t, err)
+	assert.False(t, attached)
+	assert.Empty(t, stream.responses)
+
+	// Finished tasks send their stored result
+	err = rdb.Set(context.Background(), Keys.FunctionResult("workspace", "task-1"), "result", time.Minute).Err()
+	assert.Nil(t, err)
+
+	mock.ExpectQuery("SELECT (.+) FROM task").WithArgs("task-1").
+		WillReturnRows(sqlmock.NewRows(taskColumns).AddRow("task-1", types.TaskStatusComplete, "function-1"))
+
+	attached, err = fs.attach(context.Background(), stream, authInfo, "stub-1", "task-1")
+	assert.Nil(t, err)
+	assert.True(t, attached)
+	assert.Equal(t, 1, len(stream.responses))
+	assert.Equal(t, "task-1", stream.responses[0].TaskId)
+	assert.True(t, stream.responses[0].Done)
+	assert.Equal(t, "result", string(stream.responses[0].Result))
+	assert.Equal(t, int32(0), stream.responses[0].ExitCode)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
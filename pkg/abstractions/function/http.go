@@ -72,6 +72,11 @@ func (g *functionGroup) FunctionInvoke(ctx echo.Context) error {
 			"error": err.Error(),
 		})
 	}
+	if err := task.SerializeHttpTaskOptions(ctx, payload); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
 
 	task, err := g.fs.invoke(ctx.Request().Context(), cc.AuthInfo, stubId, payload)
 	if err != nil {
@@ -149,11 +149,13 @@ func (t *FunctionTask) run(ctx context.Context, stub *types.StubWithRelated) err
 		Memory:      stubConfig.Runtime.Memory,
 		Gpu:         string(stubConfig.Runtime.Gpu),
 		GpuCount:    uint32(gpuCount),
+		GpuMemory:   stubConfig.Runtime.GpuMemory,
 		ImageId:     stubConfig.Runtime.ImageId,
 		StubId:      stub.ExternalId,
 		WorkspaceId: stub.Workspace.ExternalId,
 		EntryPoint:  []string{stubConfig.PythonVersion, "-m", "beta9.runner.function"},
 		Mounts:      mounts,
+		Priority:    stubConfig.Priority,
 	})
 	if err != nil {
 		return err
@@ -173,6 +175,12 @@ func (t *FunctionTask) Cancel(ctx context.Context, reason types.TaskCancellation
 		task.Status = types.TaskStatusTimeout
 	case types.TaskExceededRetryLimit:
 		task.Status = types.TaskStatusError
+	case types.TaskDependencyFailed:
+		task.Status = types.TaskStatusCancelled
+	case types.TaskSchedulingFailed:
+		task.Status = types.TaskStatusError
+	case types.TaskTimedOut:
+		task.Status = types.TaskStatusTimeout
 	default:
 		task.Status = types.TaskStatusError
 	}
@@ -182,6 +190,11 @@ func (t *FunctionTask) Cancel(ctx context.Context, reason types.TaskCancellation
 		return err
 	}
 
+	// Function containers only run a single task, so stopping the container stops the task
+	if reason == types.TaskTimedOut && task.ContainerId != "" {
+		return t.fs.scheduler.Stop(task.ContainerId)
+	}
+
 	return nil
 }
 
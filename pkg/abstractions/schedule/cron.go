@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	scheduleCheckInterval         time.Duration = 10 * time.Second
+	scheduleLeaderLockTtlS        int           = 30
+	scheduleDefaultTaskExpiration int           = 3600 * 2 // 2 hours
+)
+
+// nextRunAt returns the first time after after that a cron expression is due, evaluated in timezone
+func nextRunAt(cronExpression string, timezone string, after time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression <%s>: %v", cronExpression, err)
+	}
+
+	// time.LoadLocation treats an empty timezone as UTC
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timezone <%s>: %v", timezone, err)
+	}
+
+	next := schedule.Next(after.In(location))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression <%s> is never due", cronExpression)
+	}
+
+	return next, nil
+}
+
+func (s *CronScheduleService) run() {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			// Only one gateway runs due schedules at a time
+			err := s.lock.Acquire(s.ctx, Keys.scheduleLeaderLock(), common.RedisLockOptions{TtlS: scheduleLeaderLockTtlS, Retries: 0})
+			if err != nil {
+				continue
+			}
+
+			s.runDueSchedules(s.ctx)
+			s.lock.Release(Keys.scheduleLeaderLock())
+		}
+	}
+}
+
+func (s *CronScheduleService) runDueSchedules(ctx context.Context) {
+	now := time.Now()
+
+	schedules, err := s.backendRepo.ListDueSchedules(ctx, now)
+	if err != nil {
+		log.Printf("<schedule> unable to list due schedules: %v\n", err)
+		return
+	}
+
+	for i := range schedules {
+		schedule := &schedules[i]
+
+		// Runs missed while the gateway was down are collapsed into this one
+		next, err := nextRunAt(schedule.CronExpression, schedule.Timezone, now)
+		if err != nil {
+			log.Printf("<schedule> invalid schedule <%s>: %v\n", schedule.ExternalId, err)
+			continue
+		}
+
+		claimed, err := s.backendRepo.ClaimScheduleRun(ctx, schedule.Id, schedule.NextRunAt, next)
+		if err != nil || !claimed {
+			continue
+		}
+
+		err = s.runSchedule(ctx, schedule)
+		if err != nil {
+			log.Printf("<schedule> unable to run schedule <%s>: %v\n", schedule.ExternalId, err)
+		}
+	}
+}
+
+// runSchedule creates a task for the schedule's deployment, with the same policy as a task put on it directly
+func (s *CronScheduleService) runSchedule(ctx context.Context, schedule *types.ScheduleWithRelated) error {
+	executor, ok := scheduleExecutors[schedule.Deployment.StubType]
+	if !ok {
+		return errUnsupportedDeployment
+	}
+
+	var payload types.TaskPayload
+	if err := json.Unmarshal(schedule.Payload, &payload); err != nil {
+		return errInvalidPayload
+	}
+
+	var stubConfig types.StubConfigV1
+	if err := json.Unmarshal([]byte(schedule.Stub.Config), &stubConfig); err != nil {
+		return err
+	}
+
+	policy := types.DefaultTaskPolicy
+	if executor == types.ExecutorTaskQueue {
+		policy = stubConfig.TaskPolicy
+
+		tasksInFlight, err := s.taskRepo.TasksInFlight(ctx, schedule.Workspace.Name, schedule.Stub.ExternalId)
+		if err != nil {
+			return err
+		}
+
+		if tasksInFlight >= int(stubConfig.MaxPendingTasks) {
+			return &types.ErrExceededTaskLimit{MaxPendingTasks: stubConfig.MaxPendingTasks}
+		}
+	}
+	policy.Expires = time.Now().Add(time.Duration(scheduleDefaultTaskExpiration) * time.Second)
+
+	authInfo := &auth.AuthInfo{Workspace: &schedule.Workspace}
+	_, err := s.taskDispatcher.SendAndExecute(ctx, string(executor), authInfo, schedule.Stub.ExternalId, &types.TaskPayload{
+		Args:   payload.Args,
+		Kwargs: payload.Kwargs,
+	}, policy)
+
+	return err
+}
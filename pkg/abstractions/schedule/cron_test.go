@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextRunAt(t *testing.T) {
+	after := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	next, err := nextRunAt("0 * * * *", "", after)
+	assert.Nil(t, err)
+	assert.True(t, next.Equal(time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)))
+
+	// 9am in New York is 2pm UTC in March before daylight saving time starts
+	next, err = nextRunAt("0 9 * * *", "America/New_York", after)
+	assert.Nil(t, err)
+	assert.True(t, next.Equal(time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC)))
+
+	_, err = nextRunAt("not a cron expression", "", after)
+	assert.NotNil(t, err)
+
+	_, err = nextRunAt("0 * * * *", "Not/A_Timezone", after)
+	assert.NotNil(t, err)
+}
+
+func TestParsePayload(t *testing.T) {
+	payload, err := parsePayload(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "{}", string(payload))
+
+	payload, err = parsePayload([]byte(`{"args": [1], "kwargs": {"key": "value"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"args": [1], "kwargs": {"key": "value"}}`, string(payload))
+
+	_, err = parsePayload([]byte(`[1, 2, 3]`))
+	assert.Equal(t, errInvalidPayload, err)
+}
+
+func TestRunScheduleRejectsWhenMaxPendingTasksReached(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	s := &CronScheduleService{taskRepo: repository.NewTaskRedisRepository(rdb)}
+	schedule := &types.ScheduleWithRelated{
+		Schedule:   types.Schedule{ExternalId: "schedule1", Payload: []byte("{}")},
+		Workspace:  types.Workspace{Name: "workspace1"},
+		Deployment: types.Deployment{StubType: types.StubTypeTaskQueueDeployment},
+		Stub:       types.Stub{ExternalId: "stub1", Config: `{"max_pending_tasks": 2}`},
+	}
+
+	err = rdb.SAdd(context.Background(), common.RedisKeys.TaskIndexByStub("workspace1", "stub1"), "task1", "task2").Err()
+	assert.Nil(t, err)
+
+	// A scheduled run is held to the same limit as a task put on the queue directly
+	err = s.runSchedule(context.Background(), schedule)
+	assert.Equal(t, &types.ErrExceededTaskLimit{MaxPendingTasks: 2}, err)
+}
@@ -0,0 +1,174 @@
+package schedule
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+type scheduleGroup struct {
+	routeGroup *echo.Group
+	ss         *CronScheduleService
+}
+
+type scheduleInput struct {
+	DeploymentId   string          `json:"deployment_id"`
+	CronExpression *string         `json:"cron_expression"`
+	Timezone       *string         `json:"timezone"`
+	Payload        json.RawMessage `json:"payload"`
+	Active         *bool           `json:"active"`
+}
+
+func registerScheduleRoutes(g *echo.Group, ss *CronScheduleService) *scheduleGroup {
+	group := &scheduleGroup{
+		routeGroup: g,
+		ss:         ss,
+	}
+
+	g.POST("/:workspaceId", auth.WithWorkspaceAuth(group.CreateSchedule))
+	g.GET("/:workspaceId/:scheduleId", auth.WithWorkspaceAuth(group.GetSchedule))
+	g.GET("/:workspaceId", auth.WithWorkspaceAuth(group.ListSchedules))
+	g.PATCH("/:workspaceId/:scheduleId", auth.WithWorkspaceAuth(group.UpdateSchedule))
+	g.DELETE("/:workspaceId/:scheduleId", auth.WithWorkspaceAuth(group.DeleteSchedule))
+
+	return group
+}
+
+func (g *scheduleGroup) errorResponse(ctx echo.Context, err error) error {
+	status := http.StatusBadRequest
+	switch {
+	case err == errScheduleNotFound || err == errDeploymentNotFound:
+		status = http.StatusNotFound
+	default:
+		if _, ok := err.(*pq.Error); ok {
+			status = http.StatusInternalServerError
+		}
+	}
+
+	return ctx.JSON(status, map[string]interface{}{
+		"error": handleErrMsg(err),
+	})
+}
+
+func (g *scheduleGroup) workspace(ctx echo.Context) (*types.Workspace, error) {
+	workspace, err := g.ss.backendRepo.GetWorkspaceByExternalId(ctx.Request().Context(), ctx.Param("workspaceId"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+func (g *scheduleGroup) CreateSchedule(ctx echo.Context) error {
+	workspace, err := g.workspace(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid workspace ID",
+		})
+	}
+
+	input := scheduleInput{}
+	if err := ctx.Bind(&input); err != nil || input.CronExpression == nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid schedule",
+		})
+	}
+
+	timezone := ""
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+
+	schedule, err := g.ss.createSchedule(ctx.Request().Context(), workspace, input.DeploymentId, *input.CronExpression, timezone, input.Payload)
+	if err != nil {
+		return g.errorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+func (g *scheduleGroup) GetSchedule(ctx echo.Context) error {
+	workspace, err := g.workspace(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid workspace ID",
+		})
+	}
+
+	schedule, err := g.ss.backendRepo.GetSchedule(ctx.Request().Context(), workspace.Id, ctx.Param("scheduleId"))
+	if err == nil && schedule == nil {
+		err = errScheduleNotFound
+	}
+	if err != nil {
+		return g.errorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+func (g *scheduleGroup) ListSchedules(ctx echo.Context) error {
+	workspace, err := g.workspace(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid workspace ID",
+		})
+	}
+
+	schedules, err := g.ss.backendRepo.ListSchedules(ctx.Request().Context(), workspace.Id)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "failed to list schedules",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, schedules)
+}
+
+func (g *scheduleGroup) UpdateSchedule(ctx echo.Context) error {
+	workspace, err := g.workspace(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid workspace ID",
+		})
+	}
+
+	input := scheduleInput{}
+	if err := ctx.Bind(&input); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid schedule",
+		})
+	}
+
+	schedule, err := g.ss.updateSchedule(ctx.Request().Context(), workspace, ctx.Param("scheduleId"), input.CronExpression, input.Timezone, input.Payload, input.Active)
+	if err != nil {
+		return g.errorResponse(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+func (g *scheduleGroup) DeleteSchedule(ctx echo.Context) error {
+	workspace, err := g.workspace(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "invalid workspace ID",
+		})
+	}
+
+	err = g.ss.backendRepo.DeleteSchedule(ctx.Request().Context(), workspace.Id, ctx.Param("scheduleId"))
+	if err == sql.ErrNoRows {
+		return g.errorResponse(ctx, errScheduleNotFound)
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "failed to delete schedule",
+		})
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,305 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/task"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ScheduleService interface {
+	pb.ScheduleServiceServer
+	CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleResponse, error)
+	GetSchedule(ctx context.Context, req *pb.GetScheduleRequest) (*pb.GetScheduleResponse, error)
+	ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error)
+	UpdateSchedule(ctx context.Context, req *pb.UpdateScheduleRequest) (*pb.UpdateScheduleResponse, error)
+	DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error)
+}
+
+type ScheduleServiceOpts struct {
+	RedisClient    *common.RedisClient
+	BackendRepo    repository.BackendRepository
+	TaskRepo       repository.TaskRepository
+	TaskDispatcher *task.Dispatcher
+	RouteGroup     *echo.Group
+}
+
+type CronScheduleService struct {
+	pb.UnimplementedScheduleServiceServer
+	ctx            context.Context
+	backendRepo    repository.BackendRepository
+	taskRepo       repository.TaskRepository
+	taskDispatcher *task.Dispatcher
+	lock           *common.RedisLock
+}
+
+var scheduleRoutePrefix = "/schedule"
+
+var (
+	errDeploymentNotFound    = errors.New("deployment not found")
+	errScheduleNotFound      = errors.New("schedule not found")
+	errUnsupportedDeployment = errors.New("schedules are only supported for task queue and function deployments")
+	errInvalidPayload        = errors.New("invalid payload")
+)
+
+// The executor that runs a schedule's tasks, by the stub type of its deployment
+var scheduleExecutors = map[string]types.TaskExecutor{
+	types.StubTypeTaskQueueDeployment: types.ExecutorTaskQueue,
+	types.StubTypeFunctionDeployment:  types.ExecutorFunction,
+}
+
+func NewCronScheduleService(ctx context.Context, opts ScheduleServiceOpts) (ScheduleService, error) {
+	s := &CronScheduleService{
+		ctx:            ctx,
+		backendRepo:    opts.BackendRepo,
+		taskRepo:       opts.TaskRepo,
+		taskDispatcher: opts.TaskDispatcher,
+		lock:           common.NewRedisLock(opts.RedisClient),
+	}
+
+	// Register HTTP routes
+	authMiddleware := auth.AuthMiddleware(opts.BackendRepo)
+	registerScheduleRoutes(opts.RouteGroup.Group(scheduleRoutePrefix, authMiddleware), s)
+
+	go s.run()
+
+	return s, nil
+}
+
+func handleErrMsg(err error) string {
+	// Don't expose pg error messages
+	if _, ok := err.(*pq.Error); ok {
+		return "Failed to save schedule"
+	}
+
+	if err == sql.ErrNoRows {
+		return errScheduleNotFound.Error()
+	}
+
+	return err.Error()
+}
+
+// parsePayload checks that a payload holds the args and kwargs of a task, and defaults an empty payload
+func parsePayload(payload []byte) ([]byte, error) {
+	if len(payload) == 0 {
+		return []byte("{}"), nil
+	}
+
+	var taskPayload types.TaskPayload
+	if err := json.Unmarshal(payload, &taskPayload); err != nil {
+		return nil, errInvalidPayload
+	}
+
+	return payload, nil
+}
+
+func (s *CronScheduleService) createSchedule(ctx context.Context, workspace *types.Workspace, deploymentId string, cronExpression string, timezone string, payload []byte) (*types.ScheduleWithRelated, error) {
+	deployment, err := s.backendRepo.GetDeploymentByExternalId(ctx, workspace.Id, deploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment == nil {
+		return nil, errDeploymentNotFound
+	}
+
+	if _, ok := scheduleExecutors[deployment.StubType]; !ok {
+		return nil, errUnsupportedDeployment
+	}
+
+	next, err := nextRunAt(cronExpression, timezone, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err = parsePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.backendRepo.CreateSchedule(ctx, workspace.Id, deployment.Id, cronExpression, timezone, payload, next)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.backendRepo.GetSchedule(ctx, workspace.Id, schedule.ExternalId)
+}
+
+func (s *CronScheduleService) updateSchedule(ctx context.Context, workspace *types.Workspace, scheduleId string, cronExpression *string, timezone *string, payload []byte, active *bool) (*types.ScheduleWithRelated, error) {
+	existing, err := s.backendRepo.GetSchedule(ctx, workspace.Id, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, errScheduleNotFound
+	}
+
+	schedule := existing.Schedule
+	if cronExpression != nil {
+		schedule.CronExpression = *cronExpression
+	}
+
+	if timezone != nil {
+		schedule.Timezone = *timezone
+	}
+
+	if payload != nil {
+		schedule.Payload, err = parsePayload(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if active != nil {
+		schedule.Active = *active
+	}
+
+	// Runs missed while the schedule was inactive are skipped
+	schedule.NextRunAt, err = nextRunAt(schedule.CronExpression, schedule.Timezone, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.backendRepo.UpdateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.backendRepo.GetSchedule(ctx, workspace.Id, scheduleId)
+}
+
+func scheduleToProto(schedule *types.ScheduleWithRelated) *pb.Schedule {
+	s := &pb.Schedule{
+		Id:             schedule.ExternalId,
+		DeploymentId:   schedule.Deployment.ExternalId,
+		CronExpression: schedule.CronExpression,
+		Timezone:       schedule.Timezone,
+		Payload:        schedule.Payload,
+		Active:         schedule.Active,
+		NextRunAt:      timestamppb.New(schedule.NextRunAt),
+		CreatedAt:      timestamppb.New(schedule.CreatedAt),
+		UpdatedAt:      timestamppb.New(schedule.UpdatedAt),
+	}
+
+	if schedule.LastRunAt.Valid {
+		s.LastRunAt = timestamppb.New(schedule.LastRunAt.Time)
+	}
+
+	return s
+}
+
+func (s *CronScheduleService) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	schedule, err := s.createSchedule(ctx, authInfo.Workspace, req.DeploymentId, req.CronExpression, req.Timezone, req.Payload)
+	if err != nil {
+		return &pb.CreateScheduleResponse{
+			Ok:     false,
+			ErrMsg: handleErrMsg(err),
+		}, nil
+	}
+
+	return &pb.CreateScheduleResponse{
+		Ok:       true,
+		Schedule: scheduleToProto(schedule),
+	}, nil
+}
+
+func (s *CronScheduleService) GetSchedule(ctx context.Context, req *pb.GetScheduleRequest) (*pb.GetScheduleResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	schedule, err := s.backendRepo.GetSchedule(ctx, authInfo.Workspace.Id, req.ScheduleId)
+	if err == nil && schedule == nil {
+		err = errScheduleNotFound
+	}
+	if err != nil {
+		return &pb.GetScheduleResponse{
+			Ok:     false,
+			ErrMsg: handleErrMsg(err),
+		}, nil
+	}
+
+	return &pb.GetScheduleResponse{
+		Ok:       true,
+		Schedule: scheduleToProto(schedule),
+	}, nil
+}
+
+func (s *CronScheduleService) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	schedules, err := s.backendRepo.ListSchedules(ctx, authInfo.Workspace.Id)
+	if err != nil {
+		return &pb.ListSchedulesResponse{
+			Ok:     false,
+			ErrMsg: handleErrMsg(err),
+		}, nil
+	}
+
+	scheduleList := make([]*pb.Schedule, 0, len(schedules))
+	for i := range schedules {
+		scheduleList = append(scheduleList, scheduleToProto(&schedules[i]))
+	}
+
+	return &pb.ListSchedulesResponse{
+		Ok:        true,
+		Schedules: scheduleList,
+	}, nil
+}
+
+func (s *CronScheduleService) UpdateSchedule(ctx context.Context, req *pb.UpdateScheduleRequest) (*pb.UpdateScheduleResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	schedule, err := s.updateSchedule(ctx, authInfo.Workspace, req.ScheduleId, req.CronExpression, req.Timezone, req.Payload, req.Active)
+	if err != nil {
+		return &pb.UpdateScheduleResponse{
+			Ok:     false,
+			ErrMsg: handleErrMsg(err),
+		}, nil
+	}
+
+	return &pb.UpdateScheduleResponse{
+		Ok:       true,
+		Schedule: scheduleToProto(schedule),
+	}, nil
+}
+
+func (s *CronScheduleService) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	err := s.backendRepo.DeleteSchedule(ctx, authInfo.Workspace.Id, req.ScheduleId)
+	if err != nil {
+		return &pb.DeleteScheduleResponse{
+			Ok:     false,
+			ErrMsg: handleErrMsg(err),
+		}, nil
+	}
+
+	return &pb.DeleteScheduleResponse{
+		Ok: true,
+	}, nil
+}
+
+// Redis keys
+var (
+	Keys                      = &keys{}
+	scheduleLeaderLock string = "schedule:leader_lock"
+)
+
+type keys struct{}
+
+func (k *keys) scheduleLeaderLock() string {
+	return scheduleLeaderLock
+}
@@ -1,8 +1,6 @@
 package taskqueue
 
 import (
-	"math"
-
 	abstractions "github.com/beam-cloud/beta9/pkg/abstractions/common"
 )
 
@@ -66,9 +64,8 @@ func taskQueueScaleFunc(i *taskQueueInstance, s *taskQueueAutoscalerSample) *abs
 			desiredContainers += 1
 		}
 
-		// Limit max replicas to either what was set in autoscaler config, or our default of MaxReplicas (whichever is lower)
-		maxReplicas := math.Min(float64(i.StubConfig.Autoscaler.MaxContainers), float64(abstractions.MaxReplicas))
-		desiredContainers = int(math.Min(maxReplicas, float64(desiredContainers)))
+		// Limit max replicas to what was set in autoscaler config
+		desiredContainers = min(desiredContainers, int(i.StubConfig.Autoscaler.MaxContainers))
 	}
 
 	return &abstractions.AutoscalerResult{
@@ -110,3 +110,25 @@ func TestDeploymentScaleFuncWithMaxTasksPerContainer(t *testing.T) {
 	assert.Equal(t, true, result.ResultValid)
 	assert.Equal(t, 2, result.DesiredContainers)
 }
+
+func TestScaleFuncWithoutReplicaCap(t *testing.T) {
+	autoscaledInstance := &abstractions.AutoscaledInstance{}
+	autoscaledInstance.StubConfig = &types.StubConfigV1{}
+	autoscaledInstance.StubConfig.Autoscaler = &types.Autoscaler{
+		Type:              "queue_depth",
+		MaxContainers:     50,
+		TasksPerContainer: 1,
+	}
+
+	instance := &taskQueueInstance{}
+	instance.AutoscaledInstance = autoscaledInstance
+
+	// Scale past the old limit of 5 replicas, up to max containers
+	result := taskQueueScaleFunc(instance, &taskQueueAutoscalerSample{QueueLength: 40})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 40, result.DesiredContainers)
+
+	result = taskQueueScaleFunc(instance, &taskQueueAutoscalerSample{QueueLength: 100})
+	assert.Equal(t, true, result.ResultValid)
+	assert.Equal(t, 50, result.DesiredContainers)
+}
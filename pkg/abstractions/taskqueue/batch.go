@@ -0,0 +1,222 @@
+package taskqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+)
+
+const (
+	taskQueueMaxBatchSize   int = 10000
+	taskQueueBatchChunkSize int = 1000
+)
+
+var errBatchTooLarge = fmt.Errorf("batches can't have more than %d tasks", taskQueueMaxBatchSize)
+
+// putBatch adds several tasks to a queue. The pending task limit is checked once for the whole batch, and
+// plain tasks are written in chunks. Tasks that are delayed, have dependencies, or use idempotency keys are
+// sent one at a time. If a chunk fails, the tasks sent before it stay queued and their ids are returned.
+func (tq *RedisTaskQueue) putBatch(ctx context.Context, authInfo *auth.AuthInfo, stubId string, payloads []*types.TaskPayload) ([]string, error) {
+	if len(payloads) > taskQueueMaxBatchSize {
+		return nil, errBatchTooLarge
+	}
+
+	stubConfig, err := tq.getStubConfig(stubId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasksInFlight, err := tq.taskRepo.TasksInFlight(ctx, authInfo.Workspace.Name, stubId)
+	if err != nil {
+		return nil, err
+	}
+
+	if tasksInFlight+len(payloads) > int(stubConfig.MaxPendingTasks) {
+		return nil, &types.ErrExceededTaskLimit{MaxPendingTasks: stubConfig.MaxPendingTasks}
+	}
+
+	policy := stubConfig.TaskPolicy
+	policy.Expires = time.Now().Add(time.Duration(taskQueueDefaultTaskExpiration) * time.Second)
+
+	taskIds := make([]string, 0, len(payloads))
+	chunk := make([]*types.TaskPayload, 0, min(len(payloads), taskQueueBatchChunkSize))
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		chunkTaskIds, err := tq.sendBatch(ctx, authInfo, stubId, policy, chunk)
+		if err != nil {
+			return err
+		}
+
+		taskIds = append(taskIds, chunkTaskIds...)
+		chunk = chunk[:0]
+		return nil
+	}
+
+	for _, payload := range payloads {
+		if batchable(payload) {
+			chunk = append(chunk, payload)
+			if len(chunk) == taskQueueBatchChunkSize {
+				if err := flush(); err != nil {
+					return taskIds, err
+				}
+			}
+			continue
+		}
+
+		// Flush pending tasks first, so task ids are returned in the order they were given
+		if err := flush(); err != nil {
+			return taskIds, err
+		}
+
+		taskId, err := tq.send(ctx, authInfo, stubId, stubConfig.TaskPolicy, payload)
+		if err != nil {
+			return taskIds, err
+		}
+
+		taskIds = append(taskIds, taskId)
+	}
+
+	if err := flush(); err != nil {
+		return taskIds, err
+	}
+
+	return taskIds, nil
+}
+
+// batchable reports whether a task can be written with the rest of its chunk
+func batchable(payload *types.TaskPayload) bool {
+	return payload.RunAt == nil && payload.DelaySeconds == 0 && len(payload.DependsOn) == 0 && payload.IdempotencyKey == ""
+}
+
+// sendBatch stores the state of a chunk of tasks, creates their rows, and pushes them to the queue
+func (tq *RedisTaskQueue) sendBatch(ctx context.Context, authInfo *auth.AuthInfo, stubId string, policy types.TaskPolicy, payloads []*types.TaskPayload) ([]string, error) {
+	instance, err := tq.getOrCreateQueueInstance(stubId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := tq.taskDispatcher.SendBatch(ctx, string(types.ExecutorTaskQueue), authInfo, stubId, payloads, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIds := make([]string, 0, len(tasks))
+	msgs := make([]*types.TaskMessage, 0, len(tasks))
+	params := make([]*types.TaskParams, 0, len(tasks))
+	for _, task := range tasks {
+		t := task.(*TaskQueueTask)
+		taskIds = append(taskIds, t.msg.TaskId)
+		msgs = append(msgs, t.msg)
+		params = append(params, &types.TaskParams{
+			TaskId:      t.msg.TaskId,
+			StubId:      instance.Stub.Id,
+			WorkspaceId: instance.Stub.WorkspaceId,
+		})
+	}
+
+	err = tq.backendRepo.CreateTasks(ctx, params)
+	if err == nil {
+		err = tq.queueClient.PushBatch(ctx, authInfo.Workspace.Name, stubId, msgs)
+		if err != nil {
+			for _, taskId := range taskIds {
+				tq.backendRepo.DeleteTask(context.TODO(), taskId)
+			}
+		}
+	}
+
+	if err != nil {
+		for _, taskId := range taskIds {
+			tq.taskDispatcher.Complete(ctx, authInfo.Workspace.Name, stubId, taskId)
+		}
+		return nil, err
+	}
+
+	return taskIds, nil
+}
+
+// getTaskStatuses returns the status of several tasks in a queue. Ids that don't match a task are skipped.
+func (tq *RedisTaskQueue) getTaskStatuses(ctx context.Context, authInfo *auth.AuthInfo, stubId string, taskIds []string) ([]*pb.TaskQueueTaskStatus, error) {
+	if len(taskIds) > taskQueueMaxBatchSize {
+		return nil, errBatchTooLarge
+	}
+
+	tasks, err := tq.backendRepo.GetTasksByWorkspace(ctx, taskIds, authInfo.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*pb.TaskQueueTaskStatus, 0, len(tasks))
+	for _, task := range tasks {
+		if task.Stub.ExternalId != stubId {
+			continue
+		}
+
+		statuses = append(statuses, &pb.TaskQueueTaskStatus{
+			TaskId: task.ExternalId,
+			Status: string(task.Status),
+		})
+	}
+
+	return statuses, nil
+}
+
+func (tq *RedisTaskQueue) TaskQueuePutBatch(ctx context.Context, in *pb.TaskQueuePutBatchRequest) (*pb.TaskQueuePutBatchResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	payloads := make([]*types.TaskPayload, 0, len(in.Items))
+	for _, item := range in.Items {
+		var payload types.TaskPayload
+		err := json.Unmarshal(item.Payload, &payload)
+		if err != nil {
+			return &pb.TaskQueuePutBatchResponse{Ok: false, ErrMsg: "Invalid payload"}, nil
+		}
+
+		if item.RunAt != nil {
+			runAt := item.RunAt.AsTime()
+			payload.RunAt = &runAt
+		}
+		payload.DelaySeconds = item.DelaySeconds
+		payload.IdempotencyKey = item.IdempotencyKey
+
+		payloads = append(payloads, &payload)
+	}
+
+	taskIds, err := tq.putBatch(ctx, authInfo, in.StubId, payloads)
+	if err != nil {
+		errMsg := "Unable to put tasks"
+		var limitErr *types.ErrExceededTaskLimit
+		if errors.As(err, &limitErr) || errors.Is(err, errBatchTooLarge) {
+			errMsg = err.Error()
+		}
+
+		return &pb.TaskQueuePutBatchResponse{Ok: false, TaskIds: taskIds, ErrMsg: errMsg}, nil
+	}
+
+	return &pb.TaskQueuePutBatchResponse{Ok: true, TaskIds: taskIds}, nil
+}
+
+func (tq *RedisTaskQueue) TaskQueueGetTaskStatuses(ctx context.Context, in *pb.TaskQueueGetTaskStatusesRequest) (*pb.TaskQueueGetTaskStatusesResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	statuses, err := tq.getTaskStatuses(ctx, authInfo, in.StubId, in.TaskIds)
+	if err != nil {
+		errMsg := "Unable to get task statuses"
+		if errors.Is(err, errBatchTooLarge) {
+			errMsg = err.Error()
+		}
+
+		return &pb.TaskQueueGetTaskStatusesResponse{Ok: false, ErrMsg: errMsg}, nil
+	}
+
+	return &pb.TaskQueueGetTaskStatusesResponse{Ok: true, Statuses: statuses}, nil
+}
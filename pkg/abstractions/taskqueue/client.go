@@ -40,6 +40,21 @@ func (qc *taskQueueClient) Push(ctx context.Context, taskMessage *types.TaskMess
 	return nil
 }
 
+// Add several tasks for the same stub to the queue at once
+func (qc *taskQueueClient) PushBatch(ctx context.Context, workspaceName, stubId string, taskMessages []*types.TaskMessage) error {
+	encodedMessages := make([]interface{}, 0, len(taskMessages))
+	for _, taskMessage := range taskMessages {
+		encodedMessage, err := taskMessage.Encode()
+		if err != nil {
+			return err
+		}
+
+		encodedMessages = append(encodedMessages, encodedMessage)
+	}
+
+	return qc.rdb.RPush(ctx, Keys.taskQueueList(workspaceName, stubId), encodedMessages...).Err()
+}
+
 func (qc *taskQueueClient) Pop(ctx context.Context, workspaceName, stubId, containerId string) ([]byte, error) {
 	queueLength, err := qc.rdb.LLen(ctx, Keys.taskQueueList(workspaceName, stubId)).Result()
 	if err != nil {
@@ -0,0 +1,87 @@
+package taskqueue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const benchmarkBatchSize = 1000
+
+func benchmarkTaskMessages() []*types.TaskMessage {
+	msgs := make([]*types.TaskMessage, 0, benchmarkBatchSize)
+	for i := 0; i < benchmarkBatchSize; i++ {
+		msgs = append(msgs, &types.TaskMessage{
+			TaskId:        fmt.Sprintf("task%d", i),
+			StubId:        "stub",
+			WorkspaceName: "workspace",
+			Args:          []interface{}{i},
+		})
+	}
+
+	return msgs
+}
+
+func TestPushBatch(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	qc := newRedisTaskQueueClient(rdb, repository.NewTaskRedisRepository(rdb))
+	ctx := context.Background()
+
+	msgs := benchmarkTaskMessages()[:3]
+	err = qc.PushBatch(ctx, "workspace", "stub", msgs)
+	assert.Nil(t, err)
+
+	queueLength, err := rdb.LLen(ctx, Keys.taskQueueList("workspace", "stub")).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), queueLength)
+
+	// Tasks are queued in the order they were given
+	for _, msg := range msgs {
+		value, err := rdb.LPop(ctx, Keys.taskQueueList("workspace", "stub")).Bytes()
+		assert.Nil(t, err)
+
+		var tm types.TaskMessage
+		assert.Nil(t, tm.Decode(value))
+		assert.Equal(t, msg.TaskId, tm.TaskId)
+	}
+}
+
+func BenchmarkPush(b *testing.B) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(b, err)
+
+	qc := newRedisTaskQueueClient(rdb, repository.NewTaskRedisRepository(rdb))
+	ctx := context.Background()
+	msgs := benchmarkTaskMessages()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, msg := range msgs {
+			err := qc.Push(ctx, msg)
+			assert.Nil(b, err)
+		}
+	}
+}
+
+func BenchmarkPushBatch(b *testing.B) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(b, err)
+
+	qc := newRedisTaskQueueClient(rdb, repository.NewTaskRedisRepository(rdb))
+	ctx := context.Background()
+	msgs := benchmarkTaskMessages()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := qc.PushBatch(ctx, "workspace", "stub", msgs)
+		assert.Nil(b, err)
+	}
+}
@@ -0,0 +1,124 @@
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/task"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	deadLetterDefaultLimit int = 100
+	deadLetterMaxLimit     int = 1000
+)
+
+func (tq *RedisTaskQueue) listDeadLetters(ctx context.Context, authInfo *auth.AuthInfo, stubId string, offset, limit int) ([]types.DeadLetterTask, int, error) {
+	if limit <= 0 {
+		limit = deadLetterDefaultLimit
+	}
+
+	if limit > deadLetterMaxLimit {
+		limit = deadLetterMaxLimit
+	}
+
+	return tq.taskRepo.ListDeadLetterTasks(ctx, authInfo.Workspace.Name, stubId, offset, limit)
+}
+
+func (tq *RedisTaskQueue) redrive(ctx context.Context, authInfo *auth.AuthInfo, stubId, taskId string) error {
+	expires := time.Now().Add(time.Duration(taskQueueDefaultTaskExpiration) * time.Second)
+	return tq.taskDispatcher.Redrive(ctx, authInfo.Workspace.Name, stubId, taskId, expires)
+}
+
+func (tq *RedisTaskQueue) TaskQueueListDeadLetters(ctx context.Context, in *pb.TaskQueueListDeadLettersRequest) (*pb.TaskQueueListDeadLettersResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	tasks, total, err := tq.listDeadLetters(ctx, authInfo, in.StubId, int(in.Offset), int(in.Limit))
+	if err != nil {
+		return &pb.TaskQueueListDeadLettersResponse{Ok: false, ErrMsg: "Unable to list dead-letter tasks"}, nil
+	}
+
+	deadLetters := make([]*pb.DeadLetterTask, 0, len(tasks))
+	for i := range tasks {
+		deadLetter, err := deadLetterToProto(&tasks[i])
+		if err != nil {
+			continue
+		}
+
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return &pb.TaskQueueListDeadLettersResponse{
+		Ok:    true,
+		Tasks: deadLetters,
+		Total: uint32(total),
+	}, nil
+}
+
+func (tq *RedisTaskQueue) TaskQueueGetDeadLetter(ctx context.Context, in *pb.TaskQueueGetDeadLetterRequest) (*pb.TaskQueueGetDeadLetterResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	deadLetterTask, err := tq.taskRepo.GetDeadLetterTask(ctx, authInfo.Workspace.Name, in.StubId, in.TaskId)
+	if err != nil {
+		return &pb.TaskQueueGetDeadLetterResponse{Ok: false, ErrMsg: "Unable to get dead-letter task"}, nil
+	}
+
+	if deadLetterTask == nil {
+		return &pb.TaskQueueGetDeadLetterResponse{Ok: false, ErrMsg: task.ErrTaskNotDeadLettered.Error()}, nil
+	}
+
+	deadLetter, err := deadLetterToProto(deadLetterTask)
+	if err != nil {
+		return &pb.TaskQueueGetDeadLetterResponse{Ok: false, ErrMsg: "Unable to encode dead-letter task"}, nil
+	}
+
+	return &pb.TaskQueueGetDeadLetterResponse{Ok: true, Task: deadLetter}, nil
+}
+
+func (tq *RedisTaskQueue) TaskQueueRedriveDeadLetter(ctx context.Context, in *pb.TaskQueueRedriveDeadLetterRequest) (*pb.TaskQueueRedriveDeadLetterResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	err := tq.redrive(ctx, authInfo, in.StubId, in.TaskId)
+	if err != nil {
+		errMsg := "Unable to re-drive dead-letter task"
+		if errors.Is(err, task.ErrTaskNotDeadLettered) {
+			errMsg = err.Error()
+		}
+
+		return &pb.TaskQueueRedriveDeadLetterResponse{Ok: false, ErrMsg: errMsg}, nil
+	}
+
+	return &pb.TaskQueueRedriveDeadLetterResponse{Ok: true}, nil
+}
+
+func (tq *RedisTaskQueue) TaskQueuePurgeDeadLetters(ctx context.Context, in *pb.TaskQueuePurgeDeadLettersRequest) (*pb.TaskQueuePurgeDeadLettersResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	purged, err := tq.taskRepo.PurgeDeadLetterTasks(ctx, authInfo.Workspace.Name, in.StubId)
+	if err != nil {
+		return &pb.TaskQueuePurgeDeadLettersResponse{Ok: false, Purged: uint32(purged), ErrMsg: "Unable to purge dead-letter tasks"}, nil
+	}
+
+	return &pb.TaskQueuePurgeDeadLettersResponse{Ok: true, Purged: uint32(purged)}, nil
+}
+
+func deadLetterToProto(deadLetterTask *types.DeadLetterTask) (*pb.DeadLetterTask, error) {
+	msg, err := deadLetterTask.TaskMessage.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeadLetterTask{
+		TaskId:         deadLetterTask.TaskMessage.TaskId,
+		StubId:         deadLetterTask.TaskMessage.StubId,
+		TaskMsg:        msg,
+		Reason:         string(deadLetterTask.Reason),
+		Error:          deadLetterTask.Error,
+		Retries:        uint32(deadLetterTask.TaskMessage.Retries),
+		DeadLetteredAt: timestamppb.New(deadLetterTask.DeadLetteredAt),
+	}, nil
+}
@@ -1,8 +1,11 @@
 package taskqueue
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	apiv1 "github.com/beam-cloud/beta9/pkg/api/v1"
 	"github.com/beam-cloud/beta9/pkg/auth"
@@ -25,12 +28,25 @@ func registerTaskQueueRoutes(g *echo.Group, tq *RedisTaskQueue) *taskQueueGroup
 	g.POST("/:deploymentName/latest", auth.WithAuth(group.TaskQueuePut))
 	g.POST("/:deploymentName/v:version", auth.WithAuth(group.TaskQueuePut))
 
+	g.POST("/id/:stubId/batch", auth.WithAuth(group.TaskQueuePutBatch))
+	g.POST("/:deploymentName/batch", auth.WithAuth(group.TaskQueuePutBatch))
+	g.POST("/:deploymentName/latest/batch", auth.WithAuth(group.TaskQueuePutBatch))
+	g.POST("/:deploymentName/v:version/batch", auth.WithAuth(group.TaskQueuePutBatch))
+
+	g.POST("/id/:stubId/tasks/status", auth.WithAuth(group.TaskQueueGetTaskStatuses))
+
+	g.GET("/:stubId/tasks/:taskId/result", auth.WithAuth(group.TaskQueueGetResult))
+
+	g.GET("/id/:stubId/dead-letters", auth.WithAuth(group.ListDeadLetters))
+	g.GET("/id/:stubId/dead-letters/:taskId", auth.WithAuth(group.GetDeadLetter))
+	g.POST("/id/:stubId/dead-letters/:taskId/redrive", auth.WithAuth(group.RedriveDeadLetter))
+	g.DELETE("/id/:stubId/dead-letters", auth.WithAuth(group.PurgeDeadLetters))
+
 	return group
 }
 
-func (g *taskQueueGroup) TaskQueuePut(ctx echo.Context) error {
-	cc, _ := ctx.(*auth.HttpAuthContext)
-
+// stubId returns the stub a request targets, either directly by id or through a deployment's name and version
+func (g *taskQueueGroup) stubId(ctx echo.Context, authInfo *auth.AuthInfo) (string, error) {
 	stubId := ctx.Param("stubId")
 	deploymentName := ctx.Param("deploymentName")
 	version := ctx.Param("version")
@@ -40,39 +56,55 @@ func (g *taskQueueGroup) TaskQueuePut(ctx echo.Context) error {
 
 		if version == "" {
 			var err error
-			deployment, err = g.tq.backendRepo.GetLatestDeploymentByName(ctx.Request().Context(), cc.AuthInfo.Workspace.Id, deploymentName, types.StubTypeTaskQueueDeployment, true)
+			deployment, err = g.tq.backendRepo.GetLatestDeploymentByName(ctx.Request().Context(), authInfo.Workspace.Id, deploymentName, types.StubTypeTaskQueueDeployment, true)
 			if err != nil {
-				return apiv1.HTTPBadRequest("Invalid deployment")
+				return "", apiv1.HTTPBadRequest("Invalid deployment")
 			}
 		} else {
 			version, err := strconv.Atoi(version)
 			if err != nil {
-				return apiv1.HTTPBadRequest("Invalid deployment version")
+				return "", apiv1.HTTPBadRequest("Invalid deployment version")
 			}
 
-			deployment, err = g.tq.backendRepo.GetDeploymentByNameAndVersion(ctx.Request().Context(), cc.AuthInfo.Workspace.Id, deploymentName, uint(version), types.StubTypeTaskQueueDeployment)
+			deployment, err = g.tq.backendRepo.GetDeploymentByNameAndVersion(ctx.Request().Context(), authInfo.Workspace.Id, deploymentName, uint(version), types.StubTypeTaskQueueDeployment)
 			if err != nil {
-				return apiv1.HTTPBadRequest("Invalid deployment")
+				return "", apiv1.HTTPBadRequest("Invalid deployment")
 			}
 		}
 
 		if deployment == nil {
-			return apiv1.HTTPBadRequest("Invalid deployment")
+			return "", apiv1.HTTPBadRequest("Invalid deployment")
 		}
 
 		if !deployment.Active {
-			return apiv1.HTTPBadRequest("Deployment is not active")
+			return "", apiv1.HTTPBadRequest("Deployment is not active")
 		}
 
 		stubId = deployment.Stub.ExternalId
 	}
 
+	return stubId, nil
+}
+
+func (g *taskQueueGroup) TaskQueuePut(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	stubId, err := g.stubId(ctx, cc.AuthInfo)
+	if err != nil {
+		return err
+	}
+
 	payload, err := task.SerializeHttpPayload(ctx)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": err.Error(),
 		})
 	}
+	if err := task.SerializeHttpTaskOptions(ctx, payload); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
 
 	taskId, err := g.tq.put(ctx.Request().Context(), cc.AuthInfo, stubId, payload)
 	if err != nil {
@@ -91,3 +123,185 @@ func (g *taskQueueGroup) TaskQueuePut(ctx echo.Context) error {
 		"task_id": taskId,
 	})
 }
+
+// TaskQueuePutBatch adds several tasks to a queue. On failure, the ids of any tasks that were queued before
+// it are returned with the error.
+func (g *taskQueueGroup) TaskQueuePutBatch(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	stubId, err := g.stubId(ctx, cc.AuthInfo)
+	if err != nil {
+		return err
+	}
+
+	payloads, err := task.SerializeHttpPayloads(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	taskIds, err := g.tq.putBatch(ctx.Request().Context(), cc.AuthInfo, stubId, payloads)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(*types.ErrExceededTaskLimit); ok {
+			status = http.StatusTooManyRequests
+		} else if errors.Is(err, errBatchTooLarge) {
+			status = http.StatusBadRequest
+		}
+
+		return ctx.JSON(status, map[string]interface{}{
+			"error":    err.Error(),
+			"task_ids": taskIds,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"task_ids": taskIds,
+	})
+}
+
+type taskStatusesRequest struct {
+	TaskIds []string `json:"task_ids"`
+}
+
+func (g *taskQueueGroup) TaskQueueGetTaskStatuses(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	var req taskStatusesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apiv1.HTTPBadRequest("Failed to decode task ids")
+	}
+
+	statuses, err := g.tq.getTaskStatuses(ctx.Request().Context(), cc.AuthInfo, ctx.Param("stubId"), req.TaskIds)
+	if err != nil {
+		if errors.Is(err, errBatchTooLarge) {
+			return apiv1.HTTPBadRequest(err.Error())
+		}
+
+		return apiv1.HTTPInternalServerError("Failed to get task statuses")
+	}
+
+	response := make(map[string]string, len(statuses))
+	for _, status := range statuses {
+		response[status.TaskId] = status.Status
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"statuses": response,
+	})
+}
+
+// TaskQueueGetResult returns a task's result. With a wait query parameter, the request is held for up to that
+// many seconds until the task finishes.
+func (g *taskQueueGroup) TaskQueueGetResult(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	wait := 0
+	if ctx.QueryParam("wait") != "" {
+		var err error
+		wait, err = strconv.Atoi(ctx.QueryParam("wait"))
+		if err != nil || wait < 0 {
+			return apiv1.HTTPBadRequest("Invalid wait")
+		}
+	}
+
+	taskId := ctx.Param("taskId")
+	result, done, err := g.tq.getResult(ctx.Request().Context(), cc.AuthInfo, ctx.Param("stubId"), taskId, time.Duration(wait)*time.Second)
+	if err != nil {
+		if err == errTaskNotFound {
+			return apiv1.HTTPNotFound()
+		}
+
+		return apiv1.HTTPInternalServerError("Failed to get task result")
+	}
+
+	response := map[string]interface{}{
+		"task_id": taskId,
+		"status":  result.Status,
+		"done":    done,
+		"result":  nil,
+	}
+
+	// Runners send results as json, anything else is returned as a string
+	if len(result.Result) > 0 {
+		if json.Valid(result.Result) {
+			response["result"] = json.RawMessage(result.Result)
+		} else {
+			response["result"] = string(result.Result)
+		}
+	}
+
+	status := http.StatusOK
+	if !done {
+		status = http.StatusAccepted
+	}
+
+	return ctx.JSON(status, response)
+}
+
+func (g *taskQueueGroup) ListDeadLetters(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	offset, _ := strconv.Atoi(ctx.QueryParam("offset"))
+	limit, _ := strconv.Atoi(ctx.QueryParam("limit"))
+	if offset < 0 || limit < 0 {
+		return apiv1.HTTPBadRequest("Invalid offset or limit")
+	}
+
+	tasks, total, err := g.tq.listDeadLetters(ctx.Request().Context(), cc.AuthInfo, ctx.Param("stubId"), offset, limit)
+	if err != nil {
+		return apiv1.HTTPInternalServerError("Failed to list dead-letter tasks")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"tasks": tasks,
+		"total": total,
+	})
+}
+
+func (g *taskQueueGroup) GetDeadLetter(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	deadLetterTask, err := g.tq.taskRepo.GetDeadLetterTask(ctx.Request().Context(), cc.AuthInfo.Workspace.Name, ctx.Param("stubId"), ctx.Param("taskId"))
+	if err != nil {
+		return apiv1.HTTPInternalServerError("Failed to get dead-letter task")
+	}
+
+	if deadLetterTask == nil {
+		return apiv1.HTTPNotFound()
+	}
+
+	return ctx.JSON(http.StatusOK, deadLetterTask)
+}
+
+func (g *taskQueueGroup) RedriveDeadLetter(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	taskId := ctx.Param("taskId")
+	err := g.tq.redrive(ctx.Request().Context(), cc.AuthInfo, ctx.Param("stubId"), taskId)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotDeadLettered) {
+			return apiv1.HTTPNotFound()
+		}
+
+		return apiv1.HTTPInternalServerError("Failed to re-drive dead-letter task")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"task_id": taskId,
+	})
+}
+
+func (g *taskQueueGroup) PurgeDeadLetters(ctx echo.Context) error {
+	cc, _ := ctx.(*auth.HttpAuthContext)
+
+	purged, err := g.tq.taskRepo.PurgeDeadLetterTasks(ctx.Request().Context(), cc.AuthInfo.Workspace.Name, ctx.Param("stubId"))
+	if err != nil {
+		return apiv1.HTTPInternalServerError("Failed to purge dead-letter tasks")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"purged": purged,
+	})
+}
@@ -63,10 +63,12 @@ func (i *taskQueueInstance) startContainers(containersToRun int) error {
 			Memory:      i.StubConfig.Runtime.Memory,
 			Gpu:         string(i.StubConfig.Runtime.Gpu),
 			GpuCount:    uint32(gpuCount),
+			GpuMemory:   i.StubConfig.Runtime.GpuMemory,
 			ImageId:     i.StubConfig.Runtime.ImageId,
 			StubId:      i.Stub.ExternalId,
 			WorkspaceId: i.Workspace.ExternalId,
 			EntryPoint:  i.EntryPoint,
+			Priority:    i.StubConfig.Priority,
 			Mounts: abstractions.ConfigureContainerRequestMounts(
 				i.Stub.Object.ExternalId,
 				i.Workspace.Name,
@@ -0,0 +1,318 @@
+package taskqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	taskResultStoreS3              string        = "s3"
+	taskResultDefaultTTL           time.Duration = 24 * time.Hour
+	taskResultDefaultMaxInlineSize int64         = 1024 * 1024 // 1MiB
+	taskResultPollInterval         time.Duration = 500 * time.Millisecond
+	taskResultMaxWait              time.Duration = 60 * time.Second
+	taskResultCleanupInterval      time.Duration = time.Minute
+	taskResultCleanupBatchSize     int64         = 100
+)
+
+var (
+	errTaskNotFound       = errors.New("task not found")
+	errTaskResultTooLarge = errors.New("task result is too large to store without a result store")
+)
+
+// taskResult is the result of a finished task. Results too large to keep in Redis are spilled to the
+// result store, and only their key is kept.
+type taskResult struct {
+	Status    types.TaskStatus `json:"status"`
+	Result    []byte           `json:"result,omitempty"`
+	ObjectKey string           `json:"object_key,omitempty"`
+}
+
+type taskResultStore struct {
+	rdb           *common.RedisClient
+	store         common.ObjectStore // Shared by every gateway replica, or nil if large results aren't kept
+	ttl           time.Duration
+	maxInlineSize int64
+}
+
+func newTaskResultStore(rdb *common.RedisClient, config types.TaskResultConfig) (*taskResultStore, error) {
+	var store common.ObjectStore = nil
+	if config.Store == taskResultStoreS3 {
+		s3Store, err := common.NewS3Store(config.S3)
+		if err != nil {
+			return nil, err
+		}
+		store = s3Store
+	}
+
+	ttl := config.TTL
+	if ttl <= 0 {
+		ttl = taskResultDefaultTTL
+	}
+
+	maxInlineSize := config.MaxInlineSize
+	if maxInlineSize <= 0 {
+		maxInlineSize = taskResultDefaultMaxInlineSize
+	}
+
+	return &taskResultStore{
+		rdb:           rdb,
+		store:         store,
+		ttl:           ttl,
+		maxInlineSize: maxInlineSize,
+	}, nil
+}
+
+// set stores a task's result. Results too large to keep in Redis are dropped if there's no result store to
+// spill them to, in which case only the task's status is kept and errTaskResultTooLarge is returned.
+func (s *taskResultStore) set(ctx context.Context, workspaceName, stubId, taskId string, status types.TaskStatus, result []byte) error {
+	entry := taskResult{Status: status, Result: result}
+
+	var resultErr error = nil
+	if int64(len(result)) > s.maxInlineSize {
+		entry.Result = nil
+
+		if s.store == nil {
+			resultErr = errTaskResultTooLarge
+		} else {
+			// Spilled objects are tracked until the entry pointing at them expires, so that they can be removed
+			objectKey := fmt.Sprintf("%s/%s.result", workspaceName, taskId)
+			err := s.rdb.ZAdd(ctx, Keys.taskQueueResultObjects(), redis.Z{Score: float64(time.Now().Add(s.ttl).Unix()), Member: objectKey}).Err()
+			if err != nil {
+				return err
+			}
+
+			if err := s.spill(ctx, objectKey, result); err != nil {
+				return err
+			}
+
+			entry.ObjectKey = objectKey
+		}
+	}
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	err = s.rdb.Set(ctx, Keys.taskQueueTaskResult(workspaceName, stubId, taskId), value, s.ttl).Err()
+	if err != nil {
+		return err
+	}
+
+	return resultErr
+}
+
+// get returns a task's result, or nil if the task hasn't stored one
+func (s *taskResultStore) get(ctx context.Context, workspaceName, stubId, taskId string) (*taskResult, error) {
+	value, err := s.rdb.Get(ctx, Keys.taskQueueTaskResult(workspaceName, stubId, taskId)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &taskResult{}
+	if err := json.Unmarshal(value, entry); err != nil {
+		return nil, err
+	}
+
+	if entry.ObjectKey != "" && s.store != nil {
+		entry.Result, err = s.load(ctx, entry.ObjectKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entry, nil
+}
+
+// delete removes a task's result, along with the spilled object if there is one
+func (s *taskResultStore) delete(ctx context.Context, workspaceName, stubId, taskId string) error {
+	key := Keys.taskQueueTaskResult(workspaceName, stubId, taskId)
+
+	value, err := s.rdb.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	entry := &taskResult{}
+	if err := json.Unmarshal(value, entry); err == nil && entry.ObjectKey != "" && s.store != nil {
+		if err := s.store.Delete(ctx, entry.ObjectKey); err != nil {
+			return err
+		}
+
+		s.rdb.ZRem(ctx, Keys.taskQueueResultObjects(), entry.ObjectKey)
+	}
+
+	return s.rdb.Del(ctx, key).Err()
+}
+
+// deleteExpiredObjects periodically removes spilled results whose entries have expired
+func (s *taskResultStore) deleteExpiredObjects(ctx context.Context) {
+	if s.store == nil {
+		return
+	}
+
+	ticker := time.NewTicker(taskResultCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.deleteObjectsExpiredBy(ctx, time.Now()); err != nil {
+				log.Printf("unable to delete expired task results: %v\n", err)
+			}
+		}
+	}
+}
+
+// deleteObjectsExpiredBy removes the spilled results whose entries expired by now
+func (s *taskResultStore) deleteObjectsExpiredBy(ctx context.Context, now time.Time) error {
+	for {
+		objectKeys, err := s.rdb.ZRangeByScore(ctx, Keys.taskQueueResultObjects(), &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   strconv.FormatInt(now.Unix(), 10),
+			Count: taskResultCleanupBatchSize,
+		}).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(objectKeys) == 0 {
+			return nil
+		}
+
+		for _, objectKey := range objectKeys {
+			if err := s.store.Delete(ctx, objectKey); err != nil {
+				return err
+			}
+
+			err := s.rdb.ZRem(ctx, Keys.taskQueueResultObjects(), objectKey).Err()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// spill and load move results through a temporary file, since the object store works with local paths
+func (s *taskResultStore) spill(ctx context.Context, objectKey string, result []byte) error {
+	f, err := os.CreateTemp("", "task-result-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(result)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	return s.store.Put(ctx, f.Name(), objectKey)
+}
+
+func (s *taskResultStore) load(ctx context.Context, objectKey string) ([]byte, error) {
+	f, err := os.CreateTemp("", "task-result-*")
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	if err := s.store.Get(ctx, objectKey, f.Name()); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(f.Name())
+}
+
+// getResult returns a task's result, waiting up to wait for the task to finish. It also reports whether the
+// task is done, since tasks that finished without a result, such as ones that timed out, have no result.
+func (tq *RedisTaskQueue) getResult(ctx context.Context, authInfo *auth.AuthInfo, stubId, taskId string, wait time.Duration) (*taskResult, bool, error) {
+	if wait > taskResultMaxWait {
+		wait = taskResultMaxWait
+	}
+	deadline := time.Now().Add(wait)
+
+	for {
+		result, err := tq.results.get(ctx, authInfo.Workspace.Name, stubId, taskId)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if result != nil {
+			return result, true, nil
+		}
+
+		task, err := tq.backendRepo.GetTaskByWorkspace(ctx, taskId, authInfo.Workspace)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if task == nil || task.Stub.ExternalId != stubId {
+			return nil, false, errTaskNotFound
+		}
+
+		// Results are stored before the task is marked as finished
+		if task.Status.IsCompleted() {
+			result, err = tq.results.get(ctx, authInfo.Workspace.Name, stubId, taskId)
+			if err != nil || result != nil {
+				return result, result != nil, err
+			}
+
+			return &taskResult{Status: task.Status}, true, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return &taskResult{Status: task.Status}, false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-time.After(min(remaining, taskResultPollInterval)):
+		}
+	}
+}
+
+func (tq *RedisTaskQueue) TaskQueueGetResult(ctx context.Context, in *pb.TaskQueueGetResultRequest) (*pb.TaskQueueGetResultResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	result, done, err := tq.getResult(ctx, authInfo, in.StubId, in.TaskId, time.Duration(in.WaitSeconds)*time.Second)
+	if err != nil {
+		errMsg := "Unable to get task result"
+		if err == errTaskNotFound {
+			errMsg = err.Error()
+		}
+
+		return &pb.TaskQueueGetResultResponse{Ok: false, ErrMsg: errMsg}, nil
+	}
+
+	return &pb.TaskQueueGetResultResponse{
+		Ok:     true,
+		Done:   done,
+		Status: string(result.Status),
+		Result: result.Result,
+	}, nil
+}
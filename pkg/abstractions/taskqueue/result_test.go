@@ -0,0 +1,117 @@
+package taskqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskResultStore(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	storePath := t.TempDir()
+	results := &taskResultStore{
+		rdb:           rdb,
+		store:         &common.LocalObjectStore{Path: storePath},
+		ttl:           time.Minute,
+		maxInlineSize: 8,
+	}
+	ctx := context.Background()
+
+	result, err := results.get(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	// Small results are kept in redis
+	err = results.set(ctx, "workspace", "stub", "task1", types.TaskStatusComplete, []byte(`"ok"`))
+	assert.Nil(t, err)
+
+	result, err = results.get(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.TaskStatusComplete, result.Status)
+	assert.Equal(t, `"ok"`, string(result.Result))
+	assert.Equal(t, "", result.ObjectKey)
+
+	// Large results are spilled to the result store
+	err = results.set(ctx, "workspace", "stub", "task2", types.TaskStatusComplete, []byte(`{"key": "a larger result"}`))
+	assert.Nil(t, err)
+
+	result, err = results.get(ctx, "workspace", "stub", "task2")
+	assert.Nil(t, err)
+	assert.Equal(t, "workspace/task2.result", result.ObjectKey)
+	assert.Equal(t, `{"key": "a larger result"}`, string(result.Result))
+	assert.FileExists(t, storePath+"/workspace/task2.result")
+
+	err = results.delete(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+
+	result, err = results.get(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	// Deleting a spilled result removes it from the result store too
+	err = results.delete(ctx, "workspace", "stub", "task2")
+	assert.Nil(t, err)
+	assert.NoFileExists(t, storePath+"/workspace/task2.result")
+
+	count, err := rdb.ZCard(ctx, Keys.taskQueueResultObjects()).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestTaskResultStoreDeletesExpiredObjects(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	storePath := t.TempDir()
+	results := &taskResultStore{
+		rdb:           rdb,
+		store:         &common.LocalObjectStore{Path: storePath},
+		ttl:           time.Minute,
+		maxInlineSize: 8,
+	}
+	ctx := context.Background()
+
+	err = results.set(ctx, "workspace", "stub", "task1", types.TaskStatusComplete, []byte(`{"key": "a larger result"}`))
+	assert.Nil(t, err)
+
+	// Objects are kept while their entry is still around
+	err = results.deleteObjectsExpiredBy(ctx, time.Now())
+	assert.Nil(t, err)
+	assert.FileExists(t, storePath+"/workspace/task1.result")
+
+	// And removed once it has expired
+	err = results.deleteObjectsExpiredBy(ctx, time.Now().Add(2*time.Minute))
+	assert.Nil(t, err)
+	assert.NoFileExists(t, storePath+"/workspace/task1.result")
+
+	count, err := rdb.ZCard(ctx, Keys.taskQueueResultObjects()).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestTaskResultStoreWithoutStore(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	results, err := newTaskResultStore(rdb, types.TaskResultConfig{MaxInlineSize: 8})
+	assert.Nil(t, err)
+	assert.Nil(t, results.store)
+	ctx := context.Background()
+
+	// Without a shared store, large results are dropped but the task's status is kept
+	err = results.set(ctx, "workspace", "stub", "task1", types.TaskStatusComplete, []byte(`{"key": "a larger result"}`))
+	assert.Equal(t, errTaskResultTooLarge, err)
+
+	result, err := results.get(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.TaskStatusComplete, result.Status)
+	assert.Nil(t, result.Result)
+	assert.Equal(t, "", result.ObjectKey)
+}
@@ -3,6 +3,7 @@ package taskqueue
 import (
 	"context"
 
+	"github.com/beam-cloud/beta9/pkg/common"
 	"github.com/beam-cloud/beta9/pkg/types"
 )
 
@@ -26,6 +27,12 @@ func (t *TaskQueueTask) Execute(ctx context.Context, options ...interface{}) err
 		return err
 	}
 
+	// A re-driven task drops the result of its failed run, so callers wait on the new one
+	err = t.tq.results.delete(ctx, t.msg.WorkspaceName, t.msg.StubId, t.msg.TaskId)
+	if err != nil {
+		return err
+	}
+
 	err = t.tq.queueClient.Push(ctx, t.msg)
 	if err != nil {
 		t.tq.backendRepo.DeleteTask(context.TODO(), t.msg.TaskId)
@@ -75,6 +82,10 @@ func (t *TaskQueueTask) Cancel(ctx context.Context, reason types.TaskCancellatio
 		task.Status = types.TaskStatusTimeout
 	case types.TaskExceededRetryLimit:
 		task.Status = types.TaskStatusError
+	case types.TaskDependencyFailed:
+		task.Status = types.TaskStatusCancelled
+	case types.TaskTimedOut:
+		task.Status = types.TaskStatusTimeout
 	default:
 		task.Status = types.TaskStatusError
 	}
@@ -84,6 +95,11 @@ func (t *TaskQueueTask) Cancel(ctx context.Context, reason types.TaskCancellatio
 		return err
 	}
 
+	// Signal the container to abort the task, its monitor reports the timeout
+	if reason == types.TaskTimedOut {
+		return t.tq.rdb.Publish(ctx, common.RedisKeys.TaskCancel(t.msg.WorkspaceName, t.msg.StubId, t.msg.TaskId), t.msg.TaskId).Err()
+	}
+
 	return nil
 }
 
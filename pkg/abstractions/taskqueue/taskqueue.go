@@ -69,6 +69,7 @@ type RedisTaskQueue struct {
 	queueClient     *taskQueueClient
 	tailscale       *network.Tailscale
 	eventRepo       repository.EventRepository
+	results         *taskResultStore
 }
 
 func NewRedisTaskQueueService(
@@ -86,6 +87,11 @@ func NewRedisTaskQueueService(
 	}
 	config := configManager.GetConfig()
 
+	results, err := newTaskResultStore(opts.RedisClient, config.GatewayService.TaskResults)
+	if err != nil {
+		return nil, err
+	}
+
 	tq := &RedisTaskQueue{
 		ctx:             ctx,
 		config:          config,
@@ -101,6 +107,7 @@ func NewRedisTaskQueueService(
 		queueInstances:  common.NewSafeMap[*taskQueueInstance](),
 		tailscale:       opts.Tailscale,
 		eventRepo:       opts.EventRepo,
+		results:         results,
 	}
 
 	// Listen for container events with a certain prefix
@@ -134,6 +141,20 @@ func NewRedisTaskQueueService(
 	)
 	go eventBus.ReceiveEvents(ctx)
 
+	// Start deployments that keep containers running without traffic
+	go func() {
+		err := abstractions.WarmDeployments(ctx, opts.BackendRepo, types.StubTypeTaskQueueDeployment, func(stubId string) error {
+			_, err := tq.getOrCreateQueueInstance(stubId)
+			return err
+		})
+		if err != nil {
+			log.Printf("unable to warm deployments: %v\n", err)
+		}
+	}()
+
+	// Remove spilled results once they've expired
+	go tq.results.deleteExpiredObjects(ctx)
+
 	// Register task dispatcher
 	tq.taskDispatcher.Register(string(types.ExecutorTaskQueue), tq.taskQueueTaskFactory)
 
@@ -188,8 +209,16 @@ func (tq *RedisTaskQueue) put(ctx context.Context, authInfo *auth.AuthInfo, stub
 		return "", &types.ErrExceededTaskLimit{MaxPendingTasks: stubConfig.MaxPendingTasks}
 	}
 
-	policy := stubConfig.TaskPolicy
+	return tq.send(ctx, authInfo, stubId, stubConfig.TaskPolicy, payload)
+}
+
+func (tq *RedisTaskQueue) send(ctx context.Context, authInfo *auth.AuthInfo, stubId string, policy types.TaskPolicy, payload *types.TaskPayload) (string, error) {
+	var err error
 	policy.Expires = time.Now().Add(time.Duration(taskQueueDefaultTaskExpiration) * time.Second)
+	policy.NotBefore, err = payload.NotBefore()
+	if err != nil {
+		return "", err
+	}
 
 	task, err := tq.taskDispatcher.SendAndExecute(ctx, string(types.ExecutorTaskQueue), authInfo, stubId, payload, policy)
 	if err != nil {
@@ -211,6 +240,13 @@ func (tq *RedisTaskQueue) TaskQueuePut(ctx context.Context, in *pb.TaskQueuePutR
 		}, nil
 	}
 
+	if in.RunAt != nil {
+		runAt := in.RunAt.AsTime()
+		payload.RunAt = &runAt
+	}
+	payload.DelaySeconds = in.DelaySeconds
+	payload.IdempotencyKey = in.IdempotencyKey
+
 	taskId, err := tq.put(ctx, authInfo, in.StubId, &payload)
 	return &pb.TaskQueuePutResponse{
 		Ok:     err == nil,
@@ -325,8 +361,29 @@ func (tq *RedisTaskQueue) TaskQueueComplete(ctx context.Context, in *pb.TaskQueu
 		}, nil
 	}
 
+	if types.TaskStatus(in.TaskStatus) == types.TaskStatusError {
+		retried, err := tq.taskDispatcher.RetryFailedTask(ctx, authInfo.Workspace.Name, in.StubId, in.TaskId, in.ContainerId, in.ErrorKind, in.ErrorMessage)
+		if err != nil {
+			log.Printf("unable to retry task <%s>: %v\n", in.TaskId, err)
+		}
+
+		if retried {
+			return &pb.TaskQueueCompleteResponse{Ok: true}, nil
+		}
+	}
+
 	task.EndedAt = sql.NullTime{Time: time.Now(), Valid: true}
-	task.Status = types.TaskStatus(in.TaskStatus)
+
+	// A cancelled task stays cancelled, whatever its handler returned while stopping
+	if task.Status != types.TaskStatusCancelled {
+		task.Status = types.TaskStatus(in.TaskStatus)
+	}
+
+	// Results are stored before the task is marked as finished, so anyone waiting on it gets the result
+	err = tq.results.set(ctx, authInfo.Workspace.Name, in.StubId, in.TaskId, task.Status, in.Result)
+	if err != nil {
+		log.Printf("unable to store result of task <%s>: %v\n", in.TaskId, err)
+	}
 
 	err = tq.taskDispatcher.Complete(ctx, authInfo.Workspace.Name, in.StubId, in.TaskId)
 	if err != nil {
@@ -375,6 +432,7 @@ func (tq *RedisTaskQueue) TaskQueueMonitor(req *pb.TaskQueueMonitorRequest, stre
 
 	// Listen for task cancellation events
 	channelKey := common.RedisKeys.TaskCancel(authInfo.Workspace.Name, req.StubId, req.TaskId)
+	cancelGracePeriod := float32(tq.taskDispatcher.CancelGracePeriod().Seconds())
 	cancelFlag := make(chan bool, 1)
 	timeoutFlag := make(chan bool, 1)
 
@@ -416,7 +474,11 @@ func (tq *RedisTaskQueue) TaskQueueMonitor(req *pb.TaskQueueMonitorRequest, stre
 
 				case msg := <-messages:
 					if msg != nil && task != nil && msg.Payload == task.ExternalId {
-						cancelFlag <- true
+						if tq.taskDispatcher.TimedOut(ctx, task.ExternalId) {
+							timeoutFlag <- true
+						} else {
+							cancelFlag <- true
+						}
 						return
 					}
 
@@ -444,7 +506,7 @@ func (tq *RedisTaskQueue) TaskQueueMonitor(req *pb.TaskQueueMonitorRequest, stre
 				return err
 			}
 
-			stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: true, Complete: false, TimedOut: false})
+			stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: true, Complete: false, TimedOut: false, CancelGracePeriodSeconds: cancelGracePeriod})
 			return nil
 
 		case <-timeoutFlag:
@@ -458,7 +520,7 @@ func (tq *RedisTaskQueue) TaskQueueMonitor(req *pb.TaskQueueMonitorRequest, stre
 
 		default:
 			if task.Status == types.TaskStatusCancelled {
-				stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: true, Complete: false, TimedOut: false})
+				stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: true, Complete: false, TimedOut: false, CancelGracePeriodSeconds: cancelGracePeriod})
 				return nil
 			}
 
@@ -478,6 +540,11 @@ func (tq *RedisTaskQueue) TaskQueueMonitor(req *pb.TaskQueueMonitorRequest, stre
 			}
 
 			if !claimed {
+				if tq.taskDispatcher.TimedOut(ctx, task.ExternalId) {
+					stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: false, Complete: false, TimedOut: true})
+					return nil
+				}
+
 				stream.Send(&pb.TaskQueueMonitorResponse{Ok: true, Cancelled: false, Complete: true, TimedOut: false})
 			}
 
@@ -546,6 +613,7 @@ func (tq *RedisTaskQueue) getOrCreateQueueInstance(stubId string, options ...fun
 		BackendRepo:         tq.backendRepo,
 		TaskRepo:            tq.taskRepo,
 		InstanceLockKey:     Keys.taskQueueInstanceLock(stub.Workspace.Name, stubId),
+		MaxReplicas:         tq.config.GatewayService.MaxReplicas,
 		StartContainersFunc: instance.startContainers,
 		StopContainersFunc:  instance.stopContainers,
 	})
@@ -596,6 +664,8 @@ var (
 	taskQueueProcessingLock      string = "taskqueue:%s:%s:processing_lock:%s"
 	taskQueueKeepWarmLock        string = "taskqueue:%s:%s:keep_warm_lock:%s"
 	taskQueueTaskRunningLock     string = "taskqueue:%s:%s:task_running:%s:%s"
+	taskQueueTaskResult          string = "taskqueue:%s:%s:task:result:%s"
+	taskQueueResultObjects       string = "taskqueue:task:result_objects"
 )
 
 var Keys = &keys{}
@@ -618,6 +688,14 @@ func (k *keys) taskQueueTaskHeartbeat(workspaceName, stubId, taskId string) stri
 	return fmt.Sprintf(taskQueueTaskHeartbeat, workspaceName, stubId, taskId)
 }
 
+func (k *keys) taskQueueTaskResult(workspaceName, stubId, taskId string) string {
+	return fmt.Sprintf(taskQueueTaskResult, workspaceName, stubId, taskId)
+}
+
+func (k *keys) taskQueueResultObjects() string {
+	return taskQueueResultObjects
+}
+
 func (k *keys) taskQueueTaskDuration(workspaceName, stubId string) string {
 	return fmt.Sprintf(taskQueueTaskDuration, workspaceName, stubId)
 }
@@ -1,6 +1,7 @@
 package apiv1
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/beam-cloud/beta9/pkg/auth"
@@ -65,8 +66,17 @@ func (c *ConcurrencyLimitGroup) CreateOrUpdateConcurrencyLimit(ctx echo.Context)
 		return HTTPBadRequest("Invalid request")
 	}
 
+	if data.Priority < types.ContainerPriorityMin || data.Priority > types.ContainerPriorityMax {
+		return HTTPBadRequest(fmt.Sprintf("Priority must be between %d and %d", types.ContainerPriorityMin, types.ContainerPriorityMax))
+	}
+
+	// Workspaces get an equal share of the cluster unless they're given a weight
+	if data.FairShareWeight == 0 {
+		data.FairShareWeight = 1
+	}
+
 	if workspace.ConcurrencyLimitId != nil {
-		concurrencyLimit, err := c.backendRepo.UpdateConcurrencyLimit(ctx.Request().Context(), *workspace.ConcurrencyLimitId, data.GPULimit, data.CPUMillicoreLimit)
+		concurrencyLimit, err := c.backendRepo.UpdateConcurrencyLimit(ctx.Request().Context(), *workspace.ConcurrencyLimitId, data.GPULimit, data.CPUMillicoreLimit, data.MaxReplicas, data.Priority, data.FairShareWeight)
 		if err != nil {
 			return HTTPInternalServerError("Failed to update concurrency limit")
 		}
@@ -79,7 +89,7 @@ func (c *ConcurrencyLimitGroup) CreateOrUpdateConcurrencyLimit(ctx echo.Context)
 		return ctx.JSON(http.StatusOK, concurrencyLimit)
 	}
 
-	concurrencyLimit, err := c.backendRepo.CreateConcurrencyLimit(ctx.Request().Context(), workspace.Id, data.GPULimit, data.CPUMillicoreLimit)
+	concurrencyLimit, err := c.backendRepo.CreateConcurrencyLimit(ctx.Request().Context(), workspace.Id, data.GPULimit, data.CPUMillicoreLimit, data.MaxReplicas, data.Priority, data.FairShareWeight)
 	if err != nil {
 		return HTTPInternalServerError("Failed to create concurrency limit")
 	}
@@ -0,0 +1,55 @@
+package apiv1
+
+import (
+	"net/http"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/scheduler"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type SchedulerGroup struct {
+	routerGroup *echo.Group
+	scheduler   *scheduler.Scheduler
+}
+
+func NewSchedulerGroup(g *echo.Group, scheduler *scheduler.Scheduler) *SchedulerGroup {
+	group := &SchedulerGroup{routerGroup: g,
+		scheduler: scheduler,
+	}
+
+	g.POST("/explain", auth.WithClusterAdminAuth(group.ExplainRequest))
+	g.GET("/explain/:containerId", auth.WithClusterAdminAuth(group.ExplainContainer))
+
+	return group
+}
+
+func (s *SchedulerGroup) ExplainRequest(ctx echo.Context) error {
+	request := new(types.ContainerRequest)
+	if err := ctx.Bind(request); err != nil {
+		return HTTPBadRequest("Invalid request")
+	}
+
+	explanation, err := s.scheduler.Explain(request)
+	if err != nil {
+		return HTTPInternalServerError("Failed to explain request")
+	}
+
+	return ctx.JSON(http.StatusOK, explanation)
+}
+
+func (s *SchedulerGroup) ExplainContainer(ctx echo.Context) error {
+	containerId := ctx.Param("containerId")
+
+	explanation, err := s.scheduler.ExplainContainer(containerId)
+	if err != nil {
+		if _, ok := err.(*types.ErrContainerStateNotFound); ok {
+			return HTTPNotFound()
+		}
+
+		return HTTPInternalServerError("Failed to explain container")
+	}
+
+	return ctx.JSON(http.StatusOK, explanation)
+}
@@ -2,10 +2,7 @@ package apiv1
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"net/http"
-	"time"
 
 	"github.com/beam-cloud/beta9/pkg/abstractions/output"
 	"github.com/beam-cloud/beta9/pkg/auth"
@@ -161,7 +158,7 @@ func (g *TaskGroup) StopTasks(ctx echo.Context) error {
 			continue
 		}
 
-		err = g.stopTask(ctx.Request().Context(), task)
+		err = g.taskDispatcher.Cancel(ctx.Request().Context(), task)
 		if err != nil {
 			continue
 		}
@@ -170,30 +167,6 @@ func (g *TaskGroup) StopTasks(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, map[string]interface{}{})
 }
 
-func (g *TaskGroup) stopTask(ctx context.Context, task *types.TaskWithRelated) error {
-	if task.Status.IsCompleted() {
-		return nil
-	}
-
-	err := g.taskDispatcher.Complete(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
-	if err != nil {
-		return errors.New("failed to complete task")
-	}
-
-	err = g.redisClient.Publish(ctx, common.RedisKeys.TaskCancel(task.Workspace.Name, task.Stub.ExternalId, task.ExternalId), task.ExternalId).Err()
-	if err != nil {
-		return errors.New("failed to cancel task")
-	}
-
-	task.Status = types.TaskStatusCancelled
-	task.EndedAt = sql.NullTime{Time: time.Now(), Valid: true}
-	if _, err := g.backendRepo.UpdateTask(ctx, task.ExternalId, task.Task); err != nil {
-		return errors.New("failed to update task")
-	}
-
-	return nil
-}
-
 func (g *TaskGroup) preprocessFilters(ctx echo.Context) (*types.TaskFilter, error) {
 	var filters types.TaskFilter
 	workspaceId := ctx.Param("workspaceId")
@@ -0,0 +1,49 @@
+package apiv1
+
+import (
+	"net/http"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/labstack/echo/v4"
+)
+
+type WorkflowGroup struct {
+	routerGroup *echo.Group
+	backendRepo repository.BackendRepository
+}
+
+func NewWorkflowGroup(g *echo.Group, backendRepo repository.BackendRepository) *WorkflowGroup {
+	group := &WorkflowGroup{routerGroup: g,
+		backendRepo: backendRepo,
+	}
+
+	g.GET("/:workspaceId/:taskId", auth.WithWorkspaceAuth(group.RetrieveWorkflow)) // Allows workspace admins to retrieve the task graph a task belongs to
+
+	return group
+}
+
+func (g *WorkflowGroup) RetrieveWorkflow(ctx echo.Context) error {
+	workspaceId := ctx.Param("workspaceId")
+	workspace, err := g.backendRepo.GetWorkspaceByExternalId(ctx.Request().Context(), workspaceId)
+	if err != nil {
+		return HTTPBadRequest("Invalid workspace ID")
+	}
+
+	taskId := ctx.Param("taskId")
+	task, err := g.backendRepo.GetTaskByWorkspace(ctx.Request().Context(), taskId, &workspace)
+	if err != nil {
+		return HTTPInternalServerError("Failed to retrieve task")
+	}
+
+	if task == nil {
+		return HTTPNotFound()
+	}
+
+	graph, err := g.backendRepo.GetTaskGraph(ctx.Request().Context(), taskId)
+	if err != nil {
+		return HTTPInternalServerError("Failed to retrieve workflow")
+	}
+
+	return ctx.JSON(http.StatusOK, graph)
+}
@@ -6,7 +6,10 @@ import (
 
 var (
 	schedulerPrefix                  string = "scheduler:"
-	schedulerContainerRequests       string = "scheduler:container_requests"
+	schedulerContainerRequests       string = "scheduler:container_requests:workspace:%s"
+	schedulerLegacyContainerRequests string = "scheduler:container_requests"
+	schedulerContainerRequestsIndex  string = "scheduler:container_requests:workspace_index"
+	schedulerWorkspaceWeights        string = "scheduler:container_requests:workspace_weights"
 	schedulerWorkerLock              string = "scheduler:worker:lock:%s"
 	schedulerWorkerRequests          string = "scheduler:worker:requests:%s"
 	schedulerWorkerIndex             string = "scheduler:worker:worker_index"
@@ -20,6 +23,7 @@ var (
 	schedulerWorkerAddress           string = "scheduler:container:worker_addr:%s"
 	schedulerContainerLock           string = "scheduler:container:lock:%s"
 	schedulerContainerExitCode       string = "scheduler:container:exit_code:%s"
+	schedulerContainerFailure        string = "scheduler:container:scheduling_failure:%s"
 )
 
 var (
@@ -36,13 +40,24 @@ var (
 )
 
 var (
-	taskPrefix      string = "task"
-	taskIndex       string = "task:index"
-	taskIndexByStub string = "task:%s:%s:stub_index"
-	taskClaimIndex  string = "task:%s:%s:claim_index"
-	taskEntry       string = "task:%s:%s:%s"
-	taskClaim       string = "task:%s:%s:%s:claim"
-	taskCancel      string = "task:%s:%s:%s:cancel"
+	taskPrefix          string = "task"
+	taskIndex           string = "task:index"
+	taskIndexShard      string = "task:index:%d"
+	taskMonitorIndex    string = "task:monitor:index"
+	taskMonitorLease    string = "task:monitor:shard:%d:lease"
+	taskIndexByStub     string = "task:%s:%s:stub_index"
+	taskClaimIndex      string = "task:%s:%s:claim_index"
+	taskEntry           string = "task:%s:%s:%s"
+	taskClaim           string = "task:%s:%s:%s:claim"
+	taskCancel          string = "task:%s:%s:%s:cancel"
+	taskCancelled       string = "task:%s:%s:%s:cancelled"
+	taskWaitIndex       string = "task:wait_index"
+	taskWaitEntry       string = "task:%s:%s:%s:wait"
+	taskDelayIndex      string = "task:delay_index"
+	taskDelayEntry      string = "task:%s:%s:%s:delay"
+	taskIdempotency     string = "task:%s:%s:idempotency:%s"
+	taskDeadLetterIndex string = "task:%s:%s:dead_letter_index"
+	taskDeadLetterEntry string = "task:%s:%s:%s:dead_letter"
 )
 
 var (
@@ -88,8 +103,21 @@ func (rk *redisKeys) SchedulerWorkerIndex() string {
 	return schedulerWorkerIndex
 }
 
-func (rk *redisKeys) SchedulerContainerRequests() string {
-	return schedulerContainerRequests
+func (rk *redisKeys) SchedulerContainerRequests(workspaceId string) string {
+	return fmt.Sprintf(schedulerContainerRequests, workspaceId)
+}
+
+// SchedulerLegacyContainerRequests is the single backlog used by schedulers before requests were kept per workspace
+func (rk *redisKeys) SchedulerLegacyContainerRequests() string {
+	return schedulerLegacyContainerRequests
+}
+
+func (rk *redisKeys) SchedulerContainerRequestsIndex() string {
+	return schedulerContainerRequestsIndex
+}
+
+func (rk *redisKeys) SchedulerWorkspaceWeights() string {
+	return schedulerWorkspaceWeights
 }
 
 func (rk *redisKeys) SchedulerWorkerLock(workerId string) string {
@@ -140,6 +168,10 @@ func (rk *redisKeys) SchedulerContainerExitCode(containerId string) string {
 	return fmt.Sprintf(schedulerContainerExitCode, containerId)
 }
 
+func (rk *redisKeys) SchedulerContainerFailure(containerId string) string {
+	return fmt.Sprintf(schedulerContainerFailure, containerId)
+}
+
 // Gateway keys
 func (rk *redisKeys) GatewayPrefix() string {
 	return gatewayPrefix
@@ -179,10 +211,26 @@ func (rk *redisKeys) TaskIndex() string {
 	return taskIndex
 }
 
+func (rk *redisKeys) TaskIndexShard(shard int) string {
+	return fmt.Sprintf(taskIndexShard, shard)
+}
+
+func (rk *redisKeys) TaskMonitorIndex() string {
+	return taskMonitorIndex
+}
+
+func (rk *redisKeys) TaskMonitorLease(shard int) string {
+	return fmt.Sprintf(taskMonitorLease, shard)
+}
+
 func (rk *redisKeys) TaskCancel(workspaceName, stubId, taskId string) string {
 	return fmt.Sprintf(taskCancel, workspaceName, stubId, taskId)
 }
 
+func (rk *redisKeys) TaskCancelled(workspaceName, stubId, taskId string) string {
+	return fmt.Sprintf(taskCancelled, workspaceName, stubId, taskId)
+}
+
 func (rk *redisKeys) TaskIndexByStub(workspaceName, stubId string) string {
 	return fmt.Sprintf(taskIndexByStub, workspaceName, stubId)
 }
@@ -199,6 +247,34 @@ func (rk *redisKeys) TaskClaim(workspaceName, stubId, taskId string) string {
 	return fmt.Sprintf(taskClaim, workspaceName, stubId, taskId)
 }
 
+func (rk *redisKeys) TaskWaitIndex() string {
+	return taskWaitIndex
+}
+
+func (rk *redisKeys) TaskWaitEntry(workspaceName, stubId, taskId string) string {
+	return fmt.Sprintf(taskWaitEntry, workspaceName, stubId, taskId)
+}
+
+func (rk *redisKeys) TaskIdempotencyKey(workspaceName, stubId, idempotencyKey string) string {
+	return fmt.Sprintf(taskIdempotency, workspaceName, stubId, idempotencyKey)
+}
+
+func (rk *redisKeys) TaskDelayIndex() string {
+	return taskDelayIndex
+}
+
+func (rk *redisKeys) TaskDelayEntry(workspaceName, stubId, taskId string) string {
+	return fmt.Sprintf(taskDelayEntry, workspaceName, stubId, taskId)
+}
+
+func (rk *redisKeys) TaskDeadLetterIndex(workspaceName, stubId string) string {
+	return fmt.Sprintf(taskDeadLetterIndex, workspaceName, stubId)
+}
+
+func (rk *redisKeys) TaskDeadLetterEntry(workspaceName, stubId, taskId string) string {
+	return fmt.Sprintf(taskDeadLetterEntry, workspaceName, stubId, taskId)
+}
+
 // Workspace keys
 func (rk *redisKeys) WorkspacePrefix() string {
 	return workspacePrefix
@@ -75,6 +75,7 @@ type ObjectStore interface {
 	Get(ctx context.Context, key string, localPath string) error
 	Exists(ctx context.Context, key string) bool
 	Size(ctx context.Context, key string) (int64, error)
+	Delete(ctx context.Context, key string) error
 }
 
 func NewS3Store(config types.S3ImageRegistryConfig) (*S3Store, error) {
@@ -165,6 +166,15 @@ func (s *S3Store) Size(ctx context.Context, key string) (int64, error) {
 	return *res.ContentLength, nil
 }
 
+// Delete removes the object
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
 // headObject returns the metadata of an object
 func (s *S3Store) headObject(ctx context.Context, key string) (*s3.HeadObjectOutput, error) {
 	_, err := s.client.GetObject(ctx, &s3.GetObjectInput{
@@ -201,6 +211,10 @@ func (s *LocalObjectStore) Put(ctx context.Context, localPath string, key string
 	defer srcFile.Close()
 
 	destPath := filepath.Join(s.Path, key)
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return err
+	}
+
 	destFile, err := os.Create(destPath)
 	if err != nil {
 		log.Printf("error creating file<%s>: %v", destPath, err)
@@ -254,3 +268,11 @@ func (s *LocalObjectStore) Size(ctx context.Context, key string) (int64, error)
 	}
 	return fileInfo.Size(), nil
 }
+
+func (s *LocalObjectStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(filepath.Join(s.Path, key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"syscall"
 
 	"github.com/beam-cloud/beta9/pkg/abstractions/endpoint"
+	"github.com/beam-cloud/beta9/pkg/abstractions/schedule"
 	"github.com/beam-cloud/beta9/pkg/abstractions/secret"
 	"github.com/beam-cloud/beta9/pkg/task"
 	"github.com/labstack/echo-contrib/pprof"
@@ -122,7 +123,7 @@ func NewGateway() (*Gateway, error) {
 	containerRepo := repository.NewContainerRedisRepository(redisClient)
 	providerRepo := repository.NewProviderRedisRepository(redisClient)
 	taskRepo := repository.NewTaskRedisRepository(redisClient)
-	taskDispatcher, err := task.NewDispatcher(ctx, taskRepo)
+	taskDispatcher, err := task.NewDispatcher(ctx, taskRepo, backendRepo, metricsRepo, config)
 	if err != nil {
 		return nil, err
 	}
@@ -175,10 +176,12 @@ func (g *Gateway) initHttp() error {
 	apiv1.NewWorkspaceGroup(g.baseRouteGroup.Group("/workspace", authMiddleware), g.BackendRepo, g.Config)
 	apiv1.NewTokenGroup(g.baseRouteGroup.Group("/token", authMiddleware), g.BackendRepo, g.Config)
 	apiv1.NewTaskGroup(g.baseRouteGroup.Group("/task", authMiddleware), g.RedisClient, g.TaskRepo, g.BackendRepo, g.TaskDispatcher, g.Config)
+	apiv1.NewWorkflowGroup(g.baseRouteGroup.Group("/workflow", authMiddleware), g.BackendRepo)
 	apiv1.NewContainerGroup(g.baseRouteGroup.Group("/container", authMiddleware), g.BackendRepo, g.ContainerRepo, *g.Scheduler, g.Config)
 	apiv1.NewStubGroup(g.baseRouteGroup.Group("/stub", authMiddleware), g.BackendRepo, g.Config)
 	apiv1.NewConcurrencyLimitGroup(g.baseRouteGroup.Group("/concurrency-limit", authMiddleware), g.BackendRepo, g.WorkspaceRepo)
 	apiv1.NewDeploymentGroup(g.baseRouteGroup.Group("/deployment", authMiddleware), g.BackendRepo, g.ContainerRepo, *g.Scheduler, g.RedisClient, g.Config)
+	apiv1.NewSchedulerGroup(g.baseRouteGroup.Group("/scheduler", authMiddleware), g.Scheduler)
 
 	return nil
 }
@@ -316,6 +319,19 @@ func (g *Gateway) registerServices() error {
 	secretService := secret.NewSecretService(g.BackendRepo, g.rootRouteGroup)
 	pb.RegisterSecretServiceServer(g.grpcServer, secretService)
 
+	// Register schedule service
+	scheduleService, err := schedule.NewCronScheduleService(g.ctx, schedule.ScheduleServiceOpts{
+		RedisClient:    g.RedisClient,
+		BackendRepo:    g.BackendRepo,
+		TaskRepo:       g.TaskRepo,
+		TaskDispatcher: g.TaskDispatcher,
+		RouteGroup:     g.rootRouteGroup,
+	})
+	if err != nil {
+		return err
+	}
+	pb.RegisterScheduleServiceServer(g.grpcServer, scheduleService)
+
 	// Register Signal service
 	signalService, err := _signal.NewRedisSignalService(g.RedisClient)
 	if err != nil {
@@ -3,8 +3,10 @@ package gatewayservices
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/scheduler"
 	"github.com/beam-cloud/beta9/pkg/types"
 	pb "github.com/beam-cloud/beta9/proto"
 )
@@ -26,6 +28,7 @@ func (gws *GatewayService) ListPools(ctx context.Context, in *pb.ListPoolsReques
 	}
 	sort.Strings(keys)
 
+	now := time.Now()
 	formattedPools := []*pb.Pool{}
 	for _, poolName := range keys {
 		poolConfig := pools[poolName]
@@ -38,6 +41,7 @@ func (gws *GatewayService) ListPools(ctx context.Context, in *pb.ListPoolsReques
 			DefaultWorkerCpu:      poolConfig.PoolSizing.DefaultWorkerCPU,
 			DefaultWorkerMemory:   poolConfig.PoolSizing.DefaultWorkerMemory,
 			DefaultWorkerGpuCount: poolConfig.PoolSizing.DefaultWorkerGpuCount,
+			ActiveSizingSchedules: scheduler.ActiveSizingSchedules(poolConfig.PoolSizing, now),
 		})
 	}
 
@@ -0,0 +1,71 @@
+package gatewayservices
+
+import (
+	"context"
+
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
+)
+
+func (gws *GatewayService) ExplainScheduling(ctx context.Context, in *pb.ExplainSchedulingRequest) (*pb.ExplainSchedulingResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+	if authInfo.Token.TokenType != types.TokenTypeClusterAdmin {
+		return &pb.ExplainSchedulingResponse{
+			Ok:     false,
+			ErrMsg: "This action is not permitted",
+		}, nil
+	}
+
+	var explanation *types.SchedulingExplanation
+	var err error
+	if in.ContainerId != "" {
+		explanation, err = gws.scheduler.ExplainContainer(in.ContainerId)
+	} else {
+		explanation, err = gws.scheduler.Explain(&types.ContainerRequest{
+			WorkspaceId:  in.WorkspaceId,
+			Cpu:          in.Cpu,
+			Memory:       in.Memory,
+			Gpu:          in.Gpu,
+			GpuCount:     in.GpuCount,
+			PoolSelector: in.PoolSelector,
+			Priority:     in.Priority,
+		})
+	}
+	if err != nil {
+		return &pb.ExplainSchedulingResponse{
+			Ok:     false,
+			ErrMsg: err.Error(),
+		}, nil
+	}
+
+	workers := []*pb.WorkerCandidate{}
+	for _, candidate := range explanation.Workers {
+		rejections := []string{}
+		for _, rejection := range candidate.Rejections {
+			rejections = append(rejections, string(rejection))
+		}
+
+		workers = append(workers, &pb.WorkerCandidate{
+			WorkerId:             candidate.Worker.Id,
+			PoolName:             candidate.Worker.PoolName,
+			Status:               string(candidate.Worker.Status),
+			FreeCpu:              candidate.Worker.FreeCpu,
+			FreeMemory:           candidate.Worker.FreeMemory,
+			Gpu:                  candidate.Worker.Gpu,
+			FreeGpuCount:         candidate.Worker.FreeGpuCount,
+			RequiresPoolSelector: candidate.Worker.RequiresPoolSelector,
+			Rejections:           rejections,
+		})
+	}
+
+	return &pb.ExplainSchedulingResponse{
+		Ok:               true,
+		Workers:          workers,
+		SelectedWorkerId: explanation.SelectedWorkerId,
+		PoolName:         explanation.PoolName,
+		ControllerName:   explanation.ControllerName,
+		ControllerError:  explanation.ControllerError,
+		ThrottledReason:  explanation.ThrottledReason,
+	}, nil
+}
@@ -3,6 +3,7 @@ package gatewayservices
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/beam-cloud/beta9/pkg/auth"
 	"github.com/beam-cloud/beta9/pkg/common"
@@ -22,22 +23,31 @@ func (gws *GatewayService) GetOrCreateStub(ctx context.Context, in *pb.GetOrCrea
 		autoscaler.Type = types.AutoscalerType(in.Autoscaler.Type)
 		autoscaler.MaxContainers = uint(in.Autoscaler.MaxContainers)
 		autoscaler.TasksPerContainer = uint(in.Autoscaler.TasksPerContainer)
+		autoscaler.TargetConcurrency = float64(in.Autoscaler.TargetConcurrency)
+		autoscaler.TargetLatencyMs = float64(in.Autoscaler.TargetLatencyMs)
+		autoscaler.TargetRPS = float64(in.Autoscaler.TargetRps)
+		autoscaler.MinContainers = min(uint(in.Autoscaler.MinContainers), autoscaler.MaxContainers)
 	}
 
 	stubConfig := types.StubConfigV1{
 		Runtime: types.Runtime{
-			Cpu:     in.Cpu,
-			Gpu:     types.GpuType(in.Gpu),
-			Memory:  in.Memory,
-			ImageId: in.ImageId,
+			Cpu:       in.Cpu,
+			Gpu:       types.GpuType(in.Gpu),
+			GpuMemory: in.GpuMemory,
+			Memory:    in.Memory,
+			ImageId:   in.ImageId,
 		},
 		Handler:       in.Handler,
 		OnStart:       in.OnStart,
 		CallbackUrl:   in.CallbackUrl,
 		PythonVersion: in.PythonVersion,
 		TaskPolicy: types.TaskPolicy{
-			MaxRetries: uint(in.Retries),
-			Timeout:    int(in.Timeout),
+			MaxRetries:  uint(in.Retries),
+			Timeout:     int(in.Timeout),
+			BackoffBase: float64(in.GetRetryPolicy().GetBackoffBase()),
+			BackoffMax:  float64(in.GetRetryPolicy().GetBackoffMax()),
+			Jitter:      float64(in.GetRetryPolicy().GetJitter()),
+			RetryOn:     in.GetRetryPolicy().GetRetryOn(),
 		},
 		KeepWarmSeconds: uint(in.KeepWarmSeconds),
 		Workers:         uint(in.Workers),
@@ -46,6 +56,18 @@ func (gws *GatewayService) GetOrCreateStub(ctx context.Context, in *pb.GetOrCrea
 		Secrets:         []types.Secret{},
 		Authorized:      in.Authorized,
 		Autoscaler:      autoscaler,
+		Priority:        in.Priority,
+	}
+
+	if types.EndpointMode(in.EndpointMode) == types.EndpointModeASGI {
+		stubConfig.EndpointMode = types.EndpointModeASGI
+	}
+
+	if in.LoadBalancer != nil && in.LoadBalancer.Policy != "" {
+		stubConfig.LoadBalancer = &types.LoadBalancer{
+			Policy:     types.LoadBalancerPolicy(in.LoadBalancer.Policy),
+			HashHeader: in.LoadBalancer.HashHeader,
+		}
 	}
 
 	// Get secrets
@@ -126,6 +148,16 @@ func (gws *GatewayService) DeployStub(ctx context.Context, in *pb.DeployStubRequ
 
 	go gws.eventRepo.PushDeployStubEvent(authInfo.Workspace.ExternalId, &stub.Stub)
 
+	// Deployments that keep containers running without traffic are started right away
+	var stubConfig types.StubConfigV1
+	if err := json.Unmarshal([]byte(stub.Config), &stubConfig); err == nil && stubConfig.Autoscaler != nil && stubConfig.Autoscaler.MinContainers > 0 {
+		eventBus := common.NewEventBus(gws.redisClient)
+		eventBus.Send(&common.Event{Type: common.EventTypeReloadInstance, Retries: 3, LockAndDelete: false, Args: map[string]any{
+			"stub_id":   stub.ExternalId,
+			"stub_type": string(stub.Type),
+		}})
+	}
+
 	return &pb.DeployStubResponse{
 		Ok:           true,
 		DeploymentId: deployment.ExternalId,
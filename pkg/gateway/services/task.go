@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/beam-cloud/beta9/pkg/auth"
 	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/task"
 	"github.com/beam-cloud/beta9/pkg/types"
 	pb "github.com/beam-cloud/beta9/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -70,8 +72,23 @@ func (gws *GatewayService) EndTask(ctx context.Context, in *pb.EndTaskRequest) (
 		return &pb.EndTaskResponse{Ok: false}, nil
 	}
 
+	if types.TaskStatus(in.TaskStatus) == types.TaskStatusError {
+		retried, err := gws.taskDispatcher.RetryFailedTask(ctx, task.Workspace.Name, task.Stub.ExternalId, in.TaskId, in.ContainerId, in.ErrorKind, in.ErrorMessage)
+		if err != nil {
+			log.Printf("unable to retry task <%s>: %v\n", in.TaskId, err)
+		}
+
+		if retried {
+			return &pb.EndTaskResponse{Ok: true}, nil
+		}
+	}
+
 	task.EndedAt = sql.NullTime{Time: time.Now(), Valid: true}
-	task.Status = types.TaskStatus(in.TaskStatus)
+
+	// A cancelled task stays cancelled, whatever its handler returned while stopping
+	if task.Status != types.TaskStatusCancelled {
+		task.Status = types.TaskStatus(in.TaskStatus)
+	}
 
 	if in.ContainerId != "" {
 		task.ContainerId = in.ContainerId
@@ -163,7 +180,7 @@ func (gws *GatewayService) StopTasks(ctx context.Context, in *pb.StopTasksReques
 			return &pb.StopTasksResponse{Ok: false, ErrMsg: "Invalid workspace ID"}, nil
 		}
 
-		err = gws.stopTask(ctx, authInfo, task)
+		err = gws.taskDispatcher.Cancel(ctx, task)
 		if err != nil {
 			return &pb.StopTasksResponse{Ok: false, ErrMsg: err.Error()}, nil
 		}
@@ -172,27 +189,112 @@ func (gws *GatewayService) StopTasks(ctx context.Context, in *pb.StopTasksReques
 	return &pb.StopTasksResponse{Ok: true}, nil
 }
 
-// TODO: consolidate this logic with the stopTask function in /api/v1/task.go
-func (gws *GatewayService) stopTask(ctx context.Context, authInfo *auth.AuthInfo, task *types.TaskWithRelated) error {
-	if task.Status.IsCompleted() {
-		return nil
+const taskCancellationCheckInterval time.Duration = time.Second
+
+// WatchTaskCancellation sends a response once a task is cancelled, with the grace period its handler has to stop,
+// or once the task ends without being cancelled.
+func (gws *GatewayService) WatchTaskCancellation(in *pb.WatchTaskCancellationRequest, stream pb.GatewayService_WatchTaskCancellationServer) error {
+	authInfo, _ := auth.AuthInfoFromContext(stream.Context())
+	ctx := stream.Context()
+
+	task, err := gws.backendRepo.GetTaskWithRelated(ctx, in.TaskId)
+	if err != nil {
+		return err
+	}
+
+	if task == nil || task.Workspace.ExternalId != authInfo.Workspace.ExternalId {
+		return errors.New("task not found")
 	}
 
-	err := gws.taskDispatcher.Complete(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
+	messages, errs := gws.redisClient.Subscribe(ctx, common.RedisKeys.TaskCancel(task.Workspace.Name, task.Stub.ExternalId, task.ExternalId))
+
+	reason, err := gws.taskDispatcher.Cancelled(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
 	if err != nil {
-		return errors.New("failed to complete task")
+		return err
+	}
+
+	// The subscription is made asynchronously, so the cancellation is also checked periodically in case
+	// its notification was sent before the subscription was in place
+	ticker := time.NewTicker(taskCancellationCheckInterval)
+	defer ticker.Stop()
+
+	// Timeouts are signalled on the same channel, but don't mark the task as cancelled
+	for reason == "" {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			reason, err = gws.taskDispatcher.Cancelled(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
+			if err != nil {
+				return err
+			}
+
+			if reason != "" {
+				continue
+			}
+
+			// Stop watching once the task has ended
+			claimed, err := gws.taskDispatcher.IsClaimed(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
+			if err != nil {
+				return err
+			}
+
+			if !claimed {
+				return stream.Send(&pb.WatchTaskCancellationResponse{Cancelled: false})
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			if msg == nil || msg.Payload != task.ExternalId {
+				continue
+			}
+
+			reason, err = gws.taskDispatcher.Cancelled(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
+			if err != nil {
+				return err
+			}
+		case err, ok := <-errs:
+			if !ok {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	err = gws.redisClient.Publish(ctx, common.RedisKeys.TaskCancel(authInfo.Workspace.Name, task.Stub.ExternalId, task.ExternalId), task.ExternalId).Err()
+	return stream.Send(&pb.WatchTaskCancellationResponse{
+		Cancelled:          true,
+		Reason:             string(reason),
+		GracePeriodSeconds: float32(gws.taskDispatcher.CancelGracePeriod().Seconds()),
+	})
+}
+
+// ResolveTaskCancellation records how a runner stopped a cancelled task
+func (gws *GatewayService) ResolveTaskCancellation(ctx context.Context, in *pb.ResolveTaskCancellationRequest) (*pb.ResolveTaskCancellationResponse, error) {
+	authInfo, _ := auth.AuthInfoFromContext(ctx)
+
+	t, err := gws.backendRepo.GetTaskWithRelated(ctx, in.TaskId)
 	if err != nil {
-		return errors.New("failed to cancel task")
+		return &pb.ResolveTaskCancellationResponse{Ok: false, ErrMsg: "Unable to get task"}, nil
 	}
 
-	task.Status = types.TaskStatusCancelled
-	task.EndedAt = sql.NullTime{Time: time.Now(), Valid: true}
-	if _, err := gws.backendRepo.UpdateTask(ctx, task.ExternalId, task.Task); err != nil {
-		return errors.New("failed to update task")
+	if t == nil || t.Workspace.ExternalId != authInfo.Workspace.ExternalId {
+		return &pb.ResolveTaskCancellationResponse{Ok: false, ErrMsg: "Task not found"}, nil
+	}
+
+	err = gws.taskDispatcher.ResolveCancellation(ctx, t, types.TaskCancellationOutcome(in.Outcome))
+	if err != nil {
+		errMsg := "Unable to record task cancellation"
+		if errors.Is(err, task.ErrTaskNotCancelled) || errors.Is(err, task.ErrInvalidCancellationOutcome) {
+			errMsg = err.Error()
+		}
+
+		return &pb.ResolveTaskCancellationResponse{Ok: false, ErrMsg: errMsg}, nil
 	}
 
-	return nil
+	return &pb.ResolveTaskCancellationResponse{Ok: true}, nil
 }
@@ -10,6 +10,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	pkgCommon "github.com/beam-cloud/beta9/pkg/common"
@@ -355,9 +356,11 @@ func (r *PostgresBackendRepository) CreateTask(ctx context.Context, params *type
 		params.TaskId = externalId
 	}
 
+	// Tasks that waited on their dependencies already exist, and move from WAITING to PENDING
 	query := `
     INSERT INTO task (external_id, container_id, workspace_id, stub_id)
     VALUES ($1, $2, $3, $4)
+    ON CONFLICT (external_id) DO UPDATE SET status = EXCLUDED.status, container_id = EXCLUDED.container_id, updated_at = CURRENT_TIMESTAMP
     RETURNING id, external_id, status, container_id, workspace_id, stub_id, started_at, ended_at, created_at, updated_at;
     `
 
@@ -370,6 +373,38 @@ func (r *PostgresBackendRepository) CreateTask(ctx context.Context, params *type
 	return &newTask, nil
 }
 
+// CreateTasks inserts several new tasks with a single statement
+func (r *PostgresBackendRepository) CreateTasks(ctx context.Context, params []*types.TaskParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	qb := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
+		Insert("task").
+		Columns("external_id", "container_id", "workspace_id", "stub_id")
+
+	for _, p := range params {
+		if p.TaskId == "" {
+			externalId, err := r.generateExternalId()
+			if err != nil {
+				return err
+			}
+
+			p.TaskId = externalId
+		}
+
+		qb = qb.Values(p.TaskId, p.ContainerId, p.WorkspaceId, p.StubId)
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (r *PostgresBackendRepository) UpdateTask(ctx context.Context, externalId string, updatedTask types.Task) (*types.Task, error) {
 	query := `
 	UPDATE task
@@ -389,6 +424,25 @@ func (r *PostgresBackendRepository) UpdateTask(ctx context.Context, externalId s
 	return &task, nil
 }
 
+// AppendTaskRetry adds a failed attempt to the retry history of a task
+func (r *PostgresBackendRepository) AppendTaskRetry(ctx context.Context, externalId string, retry types.TaskRetry) error {
+	entry, err := json.Marshal([]types.TaskRetry{retry})
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE task SET retry_history = retry_history || $2::jsonb, updated_at = CURRENT_TIMESTAMP WHERE external_id = $1;`
+	_, err = r.client.ExecContext(ctx, query, externalId, string(entry))
+	return err
+}
+
+// SetTaskCancellation records how a task was cancelled
+func (r *PostgresBackendRepository) SetTaskCancellation(ctx context.Context, externalId string, cancellation types.TaskCancellation) error {
+	query := `UPDATE task SET cancellation = $2, updated_at = CURRENT_TIMESTAMP WHERE external_id = $1;`
+	_, err := r.client.ExecContext(ctx, query, externalId, cancellation)
+	return err
+}
+
 func (r *PostgresBackendRepository) DeleteTask(ctx context.Context, externalId string) error {
 	query := `DELETE FROM task WHERE external_id = $1;`
 	_, err := r.client.ExecContext(ctx, query, externalId)
@@ -397,7 +451,7 @@ func (r *PostgresBackendRepository) DeleteTask(ctx context.Context, externalId s
 
 func (r *PostgresBackendRepository) GetTask(ctx context.Context, externalId string) (*types.Task, error) {
 	var task types.Task
-	query := `SELECT id, external_id, status, container_id, started_at, ended_at, workspace_id, stub_id, created_at, updated_at FROM task WHERE external_id = $1;`
+	query := `SELECT id, external_id, status, container_id, started_at, ended_at, workspace_id, stub_id, created_at, updated_at, retry_history, cancellation FROM task WHERE external_id = $1;`
 	err := r.client.GetContext(ctx, &task, query, externalId)
 	if err != nil {
 		return &types.Task{}, err
@@ -454,6 +508,108 @@ func (r *PostgresBackendRepository) GetTaskByWorkspace(ctx context.Context, exte
 	return &taskWithRelated, nil
 }
 
+// GetTasksByWorkspace returns the tasks with the given ids in a workspace. Ids that don't match a task are skipped.
+func (r *PostgresBackendRepository) GetTasksByWorkspace(ctx context.Context, externalIds []string, workspace *types.Workspace) ([]types.TaskWithRelated, error) {
+	tasks := []types.TaskWithRelated{}
+	if len(externalIds) == 0 {
+		return tasks, nil
+	}
+
+	query := `
+	SELECT
+		w.external_id AS "workspace.external_id", w.name AS "workspace.name",
+		s.external_id AS "stub.external_id", s.name AS "stub.name", s.config AS "stub.config", t.*
+	FROM task t
+	JOIN workspace w ON t.workspace_id = w.id
+	JOIN stub s ON t.stub_id = s.id
+	WHERE
+		t.external_id::text = ANY($1)
+		AND w.id = $2;
+	`
+	err := r.client.SelectContext(ctx, &tasks, query, pq.Array(externalIds), workspace.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *PostgresBackendRepository) CreateTaskDependencies(ctx context.Context, externalId string, dependsOn []string) error {
+	query := `
+	INSERT INTO task_dependency (task_id, depends_on_task_id)
+	SELECT t.id, p.id
+	FROM task t, task p
+	WHERE t.external_id = $1 AND p.external_id::text = ANY($2)
+	ON CONFLICT DO NOTHING;
+	`
+	_, err := r.client.ExecContext(ctx, query, externalId, pq.Array(dependsOn))
+	return err
+}
+
+// GetTaskDependencies returns the tasks that a task depends on
+func (r *PostgresBackendRepository) GetTaskDependencies(ctx context.Context, externalId string) ([]types.Task, error) {
+	var tasks []types.Task
+	query := `
+	SELECT p.id, p.external_id, p.status, p.container_id, p.started_at, p.ended_at, p.workspace_id, p.stub_id, p.created_at, p.updated_at
+	FROM task_dependency d
+	JOIN task t ON d.task_id = t.id
+	JOIN task p ON d.depends_on_task_id = p.id
+	WHERE t.external_id = $1;
+	`
+	err := r.client.SelectContext(ctx, &tasks, query, externalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// GetTaskGraph returns every task connected to a task through its dependencies, in either direction
+func (r *PostgresBackendRepository) GetTaskGraph(ctx context.Context, externalId string) (*types.TaskGraph, error) {
+	graph := &types.TaskGraph{Tasks: []types.Task{}, Dependencies: []types.TaskDependency{}}
+
+	query := `
+	WITH RECURSIVE graph(id) AS (
+		SELECT id FROM task WHERE external_id = $1
+		UNION
+		SELECT CASE WHEN d.task_id = g.id THEN d.depends_on_task_id ELSE d.task_id END
+		FROM task_dependency d
+		JOIN graph g ON d.task_id = g.id OR d.depends_on_task_id = g.id
+	)
+	SELECT t.id, t.external_id, t.status, t.container_id, t.started_at, t.ended_at, t.workspace_id, t.stub_id, t.created_at, t.updated_at
+	FROM task t
+	WHERE t.id IN (SELECT id FROM graph)
+	ORDER BY t.created_at;
+	`
+	err := r.client.SelectContext(ctx, &graph.Tasks, query, externalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(graph.Tasks) == 0 {
+		return graph, nil
+	}
+
+	taskIds := make([]int64, len(graph.Tasks))
+	for i, task := range graph.Tasks {
+		taskIds[i] = int64(task.Id)
+	}
+
+	query = `
+	SELECT t.external_id AS task_id, p.external_id AS depends_on_task_id
+	FROM task_dependency d
+	JOIN task t ON d.task_id = t.id
+	JOIN task p ON d.depends_on_task_id = p.id
+	WHERE d.task_id = ANY($1);
+	`
+	err = r.client.SelectContext(ctx, &graph.Dependencies, query, pq.Array(taskIds))
+	if err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
 func (r *PostgresBackendRepository) ListTasks(ctx context.Context) ([]types.Task, error) {
 	var tasks []types.Task
 	query := `SELECT id, external_id, status, container_id, started_at, ended_at, workspace_id, stub_id, created_at, updated_at FROM task;`
@@ -962,6 +1118,26 @@ func (c *PostgresBackendRepository) ListDeploymentsWithRelated(ctx context.Conte
 	return deployments, nil
 }
 
+// ListActiveDeploymentsByStubType lists the active deployments of a stub type across all workspaces
+func (c *PostgresBackendRepository) ListActiveDeploymentsByStubType(ctx context.Context, stubType string) ([]types.DeploymentWithRelated, error) {
+	query := `
+	SELECT d.id, d.external_id, d.name, d.active, d.workspace_id, d.stub_id, d.stub_type, d.version, d.created_at, d.updated_at,
+		w.external_id AS "workspace.external_id", w.name AS "workspace.name",
+		s.external_id AS "stub.external_id", s.name AS "stub.name", s.config AS "stub.config"
+	FROM deployment d
+	JOIN workspace w ON d.workspace_id = w.id
+	JOIN stub s ON d.stub_id = s.id
+	WHERE d.deleted_at IS NULL AND d.active = true AND d.stub_type = $1;
+	`
+
+	var deployments []types.DeploymentWithRelated
+	if err := c.client.SelectContext(ctx, &deployments, query, stubType); err != nil {
+		return nil, err
+	}
+
+	return deployments, nil
+}
+
 func (c *PostgresBackendRepository) ListDeploymentsPaginated(ctx context.Context, filters types.DeploymentFilter) (common.CursorPaginationInfo[types.DeploymentWithRelated], error) {
 	qb := c.listDeploymentsQueryBuilder(filters)
 
@@ -1097,7 +1273,7 @@ func (r *PostgresBackendRepository) DeleteDeployment(ctx context.Context, deploy
 func (r *PostgresBackendRepository) GetConcurrencyLimit(ctx context.Context, concurrencyLimitId uint) (*types.ConcurrencyLimit, error) {
 	var limit types.ConcurrencyLimit
 
-	query := `SELECT gpu_limit, cpu_millicore_limit, created_at, updated_at FROM concurrency_limit WHERE id = $1;`
+	query := `SELECT gpu_limit, cpu_millicore_limit, priority, fair_share_weight, max_replicas, created_at, updated_at FROM concurrency_limit WHERE id = $1;`
 	err := r.client.GetContext(ctx, &limit, query, concurrencyLimitId)
 	if err != nil {
 		return nil, err
@@ -1106,15 +1282,15 @@ func (r *PostgresBackendRepository) GetConcurrencyLimit(ctx context.Context, con
 	return &limit, nil
 }
 
-func (r *PostgresBackendRepository) CreateConcurrencyLimit(ctx context.Context, workspaceId uint, gpuLimit uint32, cpuMillicoreLimit uint32) (*types.ConcurrencyLimit, error) {
+func (r *PostgresBackendRepository) CreateConcurrencyLimit(ctx context.Context, workspaceId uint, gpuLimit uint32, cpuMillicoreLimit uint32, maxReplicas uint32, priority int32, fairShareWeight uint32) (*types.ConcurrencyLimit, error) {
 	query := `
-	INSERT INTO concurrency_limit (gpu_limit, cpu_millicore_limit)
-	VALUES ($1, $2)
-	RETURNING id, gpu_limit, cpu_millicore_limit, created_at, updated_at;
+	INSERT INTO concurrency_limit (gpu_limit, cpu_millicore_limit, max_replicas, priority, fair_share_weight)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, gpu_limit, cpu_millicore_limit, priority, fair_share_weight, max_replicas, created_at, updated_at;
 	`
 
 	var limit types.ConcurrencyLimit
-	if err := r.client.GetContext(ctx, &limit, query, gpuLimit, cpuMillicoreLimit); err != nil {
+	if err := r.client.GetContext(ctx, &limit, query, gpuLimit, cpuMillicoreLimit, maxReplicas, priority, fairShareWeight); err != nil {
 		return nil, err
 	}
 
@@ -1132,16 +1308,16 @@ func (r *PostgresBackendRepository) CreateConcurrencyLimit(ctx context.Context,
 	return &limit, nil
 }
 
-func (r *PostgresBackendRepository) UpdateConcurrencyLimit(ctx context.Context, concurrencyLimitId uint, gpuLimit uint32, cpuMillicoreLimit uint32) (*types.ConcurrencyLimit, error) {
+func (r *PostgresBackendRepository) UpdateConcurrencyLimit(ctx context.Context, concurrencyLimitId uint, gpuLimit uint32, cpuMillicoreLimit uint32, maxReplicas uint32, priority int32, fairShareWeight uint32) (*types.ConcurrencyLimit, error) {
 	query := `
 	UPDATE concurrency_limit
-	SET gpu_limit = $2, cpu_millicore_limit = $3, updated_at = CURRENT_TIMESTAMP
+	SET gpu_limit = $2, cpu_millicore_limit = $3, max_replicas = $4, priority = $5, fair_share_weight = $6, updated_at = CURRENT_TIMESTAMP
 	WHERE id = $1
-	RETURNING id, gpu_limit, cpu_millicore_limit, created_at, updated_at;
+	RETURNING id, gpu_limit, cpu_millicore_limit, priority, fair_share_weight, max_replicas, created_at, updated_at;
 	`
 
 	var limit types.ConcurrencyLimit
-	if err := r.client.GetContext(ctx, &limit, query, concurrencyLimitId, gpuLimit, cpuMillicoreLimit); err != nil {
+	if err := r.client.GetContext(ctx, &limit, query, concurrencyLimitId, gpuLimit, cpuMillicoreLimit, maxReplicas, priority, fairShareWeight); err != nil {
 		return nil, err
 	}
 
@@ -1151,7 +1327,7 @@ func (r *PostgresBackendRepository) UpdateConcurrencyLimit(ctx context.Context,
 func (r *PostgresBackendRepository) GetConcurrencyLimitByWorkspaceId(ctx context.Context, workspaceId string) (*types.ConcurrencyLimit, error) {
 	var limit types.ConcurrencyLimit
 
-	query := `SELECT cl.id, cl.gpu_limit, cl.cpu_millicore_limit, cl.created_at, cl.updated_at FROM concurrency_limit cl join workspace w on cl.id = w.concurrency_limit_id WHERE w.external_id = $1;`
+	query := `SELECT cl.id, cl.gpu_limit, cl.cpu_millicore_limit, cl.priority, cl.fair_share_weight, cl.max_replicas, cl.created_at, cl.updated_at FROM concurrency_limit cl join workspace w on cl.id = w.concurrency_limit_id WHERE w.external_id = $1;`
 	err := r.client.GetContext(ctx, &limit, query, workspaceId)
 	if err != nil {
 		return nil, err
@@ -1310,3 +1486,142 @@ func (r *PostgresBackendRepository) UpdateSecret(ctx context.Context, workspace
 
 	return &secret, nil
 }
+
+// Schedule
+
+const scheduleWithRelatedQuery = `
+	SELECT ts.id, ts.external_id, ts.cron_expression, ts.timezone, ts.payload, ts.active, ts.next_run_at, ts.last_run_at,
+		ts.workspace_id, ts.deployment_id, ts.created_at, ts.updated_at,
+		w.id AS "workspace.id", w.external_id AS "workspace.external_id", w.name AS "workspace.name",
+		d.id AS "deployment.id", d.external_id AS "deployment.external_id", d.name AS "deployment.name",
+		d.active AS "deployment.active", d.version AS "deployment.version", d.stub_type AS "deployment.stub_type",
+		s.id AS "stub.id", s.external_id AS "stub.external_id", s.name AS "stub.name", s.type AS "stub.type", s.config AS "stub.config"
+	FROM task_schedule ts
+	JOIN workspace w ON ts.workspace_id = w.id
+	JOIN deployment d ON ts.deployment_id = d.id
+	JOIN stub s ON d.stub_id = s.id
+`
+
+func (r *PostgresBackendRepository) CreateSchedule(ctx context.Context, workspaceId uint, deploymentId uint, cronExpression string, timezone string, payload []byte, nextRunAt time.Time) (*types.Schedule, error) {
+	query := `
+	INSERT INTO task_schedule (workspace_id, deployment_id, cron_expression, timezone, payload, next_run_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, external_id, cron_expression, timezone, payload, active, next_run_at, last_run_at, workspace_id, deployment_id, created_at, updated_at;
+	`
+
+	var schedule types.Schedule
+	err := r.client.GetContext(ctx, &schedule, query, workspaceId, deploymentId, cronExpression, timezone, string(payload), nextRunAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *PostgresBackendRepository) GetSchedule(ctx context.Context, workspaceId uint, externalId string) (*types.ScheduleWithRelated, error) {
+	var schedule types.ScheduleWithRelated
+	query := scheduleWithRelatedQuery + `WHERE ts.workspace_id = $1 AND ts.external_id = $2;`
+
+	err := r.client.GetContext(ctx, &schedule, query, workspaceId, externalId)
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok && err.Code.Class() == PostgresDataError {
+			return nil, nil
+		}
+
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *PostgresBackendRepository) ListSchedules(ctx context.Context, workspaceId uint) ([]types.ScheduleWithRelated, error) {
+	var schedules []types.ScheduleWithRelated
+	query := scheduleWithRelatedQuery + `WHERE ts.workspace_id = $1 ORDER BY ts.created_at DESC;`
+
+	err := r.client.SelectContext(ctx, &schedules, query, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// ListDueSchedules returns the active schedules of active deployments that are due to run
+func (r *PostgresBackendRepository) ListDueSchedules(ctx context.Context, now time.Time) ([]types.ScheduleWithRelated, error) {
+	var schedules []types.ScheduleWithRelated
+	query := scheduleWithRelatedQuery + `
+	WHERE ts.active AND ts.next_run_at <= $1 AND d.active AND d.deleted_at IS NULL
+	ORDER BY ts.next_run_at;
+	`
+
+	err := r.client.SelectContext(ctx, &schedules, query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (r *PostgresBackendRepository) UpdateSchedule(ctx context.Context, schedule types.Schedule) (*types.Schedule, error) {
+	query := `
+	UPDATE task_schedule
+	SET cron_expression = $2, timezone = $3, payload = $4, active = $5, next_run_at = $6, updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1
+	RETURNING id, external_id, cron_expression, timezone, payload, active, next_run_at, last_run_at, workspace_id, deployment_id, created_at, updated_at;
+	`
+
+	var updatedSchedule types.Schedule
+	err := r.client.GetContext(ctx, &updatedSchedule, query, schedule.Id, schedule.CronExpression, schedule.Timezone, string(schedule.Payload), schedule.Active, schedule.NextRunAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedSchedule, nil
+}
+
+// ClaimScheduleRun moves a schedule's next run from runAt to nextRunAt, and reports whether it was still
+// due at runAt. Only the caller that moves it runs the schedule.
+func (r *PostgresBackendRepository) ClaimScheduleRun(ctx context.Context, scheduleId uint, runAt time.Time, nextRunAt time.Time) (bool, error) {
+	query := `
+	UPDATE task_schedule
+	SET last_run_at = CURRENT_TIMESTAMP, next_run_at = $3
+	WHERE id = $1 AND next_run_at = $2;
+	`
+
+	res, err := r.client.ExecContext(ctx, query, scheduleId, runAt, nextRunAt)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// DeleteSchedule deletes a workspace's schedule, and returns sql.ErrNoRows if it has no such schedule
+func (r *PostgresBackendRepository) DeleteSchedule(ctx context.Context, workspaceId uint, externalId string) error {
+	query := `DELETE FROM task_schedule WHERE workspace_id = $1 AND external_id = $2;`
+
+	res, err := r.client.ExecContext(ctx, query, workspaceId, externalId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
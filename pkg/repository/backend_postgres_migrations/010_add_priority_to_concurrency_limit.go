@@ -0,0 +1,21 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddPriorityToConcurrencyLimit, downDropPriorityFromConcurrencyLimit)
+}
+
+func upAddPriorityToConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit ADD COLUMN priority INTEGER NOT NULL DEFAULT 0`)
+	return err
+}
+
+func downDropPriorityFromConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit DROP COLUMN priority`)
+	return err
+}
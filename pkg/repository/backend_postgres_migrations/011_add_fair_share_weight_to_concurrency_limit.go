@@ -0,0 +1,21 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddFairShareWeightToConcurrencyLimit, downDropFairShareWeightFromConcurrencyLimit)
+}
+
+func upAddFairShareWeightToConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit ADD COLUMN fair_share_weight INTEGER NOT NULL DEFAULT 1 CHECK (fair_share_weight > 0)`)
+	return err
+}
+
+func downDropFairShareWeightFromConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit DROP COLUMN fair_share_weight`)
+	return err
+}
@@ -0,0 +1,50 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddTaskDependency, downDropTaskDependency)
+}
+
+func upAddTaskDependency(tx *sql.Tx) error {
+	statements := []string{
+		`
+DO $$
+BEGIN
+   IF NOT EXISTS (
+      SELECT 1 FROM pg_type t
+      JOIN pg_enum e ON t.oid = e.enumtypid
+      JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+      WHERE n.nspname = 'public' AND t.typname = 'task_status' AND e.enumlabel = 'WAITING'
+   ) THEN
+      EXECUTE 'ALTER TYPE task_status ADD VALUE ' || quote_literal('WAITING');
+   END IF;
+END
+$$;`,
+		`CREATE TABLE IF NOT EXISTS task_dependency (
+            task_id INT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+            depends_on_task_id INT NOT NULL REFERENCES task(id) ON DELETE CASCADE,
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            PRIMARY KEY (task_id, depends_on_task_id)
+        );`,
+		`CREATE INDEX IF NOT EXISTS task_dependency_depends_on_task_id_idx ON task_dependency (depends_on_task_id);`,
+	}
+
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func downDropTaskDependency(tx *sql.Tx) error {
+	// PostgreSQL doesn't support removing values from an ENUM directly
+	_, err := tx.Exec(`DROP TABLE IF EXISTS task_dependency;`)
+	return err
+}
@@ -0,0 +1,44 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddTaskSchedule, downDropTaskSchedule)
+}
+
+func upAddTaskSchedule(tx *sql.Tx) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS task_schedule (
+            id SERIAL PRIMARY KEY,
+            external_id UUID DEFAULT uuid_generate_v4() UNIQUE NOT NULL,
+            cron_expression VARCHAR(255) NOT NULL,
+            timezone VARCHAR(255) NOT NULL DEFAULT '',
+            payload JSONB NOT NULL DEFAULT '{}',
+            active BOOLEAN NOT NULL DEFAULT true,
+            next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+            last_run_at TIMESTAMP WITH TIME ZONE,
+            workspace_id INT NOT NULL REFERENCES workspace(id),
+            deployment_id INT NOT NULL REFERENCES deployment(id) ON DELETE CASCADE,
+            created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+        );`,
+		`CREATE INDEX IF NOT EXISTS task_schedule_next_run_at_idx ON task_schedule (next_run_at) WHERE active;`,
+	}
+
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func downDropTaskSchedule(tx *sql.Tx) error {
+	_, err := tx.Exec(`DROP TABLE IF EXISTS task_schedule;`)
+	return err
+}
@@ -0,0 +1,21 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddTaskRetryHistory, downDropTaskRetryHistory)
+}
+
+func upAddTaskRetryHistory(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE task ADD COLUMN IF NOT EXISTS retry_history JSONB NOT NULL DEFAULT '[]';`)
+	return err
+}
+
+func downDropTaskRetryHistory(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE task DROP COLUMN IF EXISTS retry_history;`)
+	return err
+}
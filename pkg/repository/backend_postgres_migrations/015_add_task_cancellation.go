@@ -0,0 +1,21 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddTaskCancellation, downDropTaskCancellation)
+}
+
+func upAddTaskCancellation(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE task ADD COLUMN IF NOT EXISTS cancellation JSONB;`)
+	return err
+}
+
+func downDropTaskCancellation(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE task DROP COLUMN IF EXISTS cancellation;`)
+	return err
+}
@@ -0,0 +1,21 @@
+package backend_postgres_migrations
+
+import (
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigration(upAddMaxReplicasToConcurrencyLimit, downDropMaxReplicasFromConcurrencyLimit)
+}
+
+func upAddMaxReplicasToConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit ADD COLUMN max_replicas INTEGER NOT NULL DEFAULT 0`)
+	return err
+}
+
+func downDropMaxReplicasFromConcurrencyLimit(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE concurrency_limit DROP COLUMN max_replicas`)
+	return err
+}
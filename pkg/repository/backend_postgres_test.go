@@ -1 +1,149 @@
 package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/tj/assert"
+)
+
+func TestCreateTasks(t *testing.T) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+
+	params := []*types.TaskParams{
+		{TaskId: "task1", WorkspaceId: 1, StubId: 2},
+		{TaskId: "task2", WorkspaceId: 1, StubId: 2},
+	}
+
+	// Every task is inserted with a single statement
+	mock.ExpectExec(`INSERT INTO task \(external_id,container_id,workspace_id,stub_id\) VALUES \(\$1,\$2,\$3,\$4\),\(\$5,\$6,\$7,\$8\)`).
+		WithArgs("task1", "", uint(1), uint(2), "task2", "", uint(1), uint(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.CreateTasks(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+
+	err = repo.CreateTasks(context.Background(), nil)
+	assert.Nil(t, err)
+}
+
+const benchmarkTaskCount = 100
+
+func benchmarkTaskParams() []*types.TaskParams {
+	params := make([]*types.TaskParams, 0, benchmarkTaskCount)
+	for i := 0; i < benchmarkTaskCount; i++ {
+		params = append(params, &types.TaskParams{TaskId: fmt.Sprintf("task%d", i), WorkspaceId: 1, StubId: 2})
+	}
+
+	return params
+}
+
+func BenchmarkCreateTask(b *testing.B) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+	ctx := context.Background()
+	params := benchmarkTaskParams()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, p := range params {
+			mock.ExpectQuery("INSERT INTO task").WillReturnRows(sqlmock.NewRows([]string{"external_id"}).AddRow(p.TaskId))
+		}
+		b.StartTimer()
+
+		for _, p := range params {
+			_, err := repo.CreateTask(ctx, p)
+			assert.Nil(b, err)
+		}
+	}
+}
+
+func BenchmarkCreateTasks(b *testing.B) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+	ctx := context.Background()
+	params := benchmarkTaskParams()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		mock.ExpectExec("INSERT INTO task").WillReturnResult(sqlmock.NewResult(0, benchmarkTaskCount))
+		b.StartTimer()
+
+		err := repo.CreateTasks(ctx, params)
+		assert.Nil(b, err)
+	}
+}
+
+func TestUpdateConcurrencyLimit(t *testing.T) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+
+	mock.ExpectQuery("UPDATE concurrency_limit").
+		WithArgs(uint(1), uint32(2), uint32(4000), uint32(10), int32(50), uint32(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "gpu_limit", "cpu_millicore_limit", "priority", "fair_share_weight", "max_replicas"}).AddRow(1, 2, 4000, 50, 3, 10))
+
+	limit, err := repo.UpdateConcurrencyLimit(context.Background(), 1, 2, 4000, 10, 50, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(50), limit.Priority)
+	assert.Equal(t, uint32(3), limit.FairShareWeight)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestListDueSchedules(t *testing.T) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+	now := time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)
+
+	// Inactive schedules, and schedules of inactive or deleted deployments, are never due
+	mock.ExpectQuery(`WHERE ts\.active AND ts\.next_run_at <= \$1 AND d\.active AND d\.deleted_at IS NULL\s+ORDER BY ts\.next_run_at`).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "active", "next_run_at", "deployment.external_id", "deployment.active"}).
+			AddRow(1, "schedule1", true, now.Add(-time.Minute), "deployment1", true))
+
+	schedules, err := repo.ListDueSchedules(context.Background(), now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(schedules))
+	assert.Equal(t, "schedule1", schedules[0].ExternalId)
+	assert.Equal(t, "deployment1", schedules[0].Deployment.ExternalId)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimScheduleRun(t *testing.T) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+	runAt := time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)
+	nextRunAt := runAt.Add(time.Hour)
+
+	// The first claim moves the schedule's next run, so a second claim of the same run finds nothing to update
+	claimQuery := `UPDATE task_schedule\s+SET last_run_at = CURRENT_TIMESTAMP, next_run_at = \$3\s+WHERE id = \$1 AND next_run_at = \$2`
+	mock.ExpectExec(claimQuery).WithArgs(uint(1), runAt, nextRunAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(claimQuery).WithArgs(uint(1), runAt, nextRunAt).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	claimed, err := repo.ClaimScheduleRun(context.Background(), 1, runAt, nextRunAt)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.ClaimScheduleRun(context.Background(), 1, runAt, nextRunAt)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	repo, mock := NewBackendPostgresRepositoryForTest()
+
+	mock.ExpectExec(`DELETE FROM task_schedule WHERE workspace_id = \$1 AND external_id = \$2`).
+		WithArgs(uint(1), "schedule1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM task_schedule WHERE workspace_id = \$1 AND external_id = \$2`).
+		WithArgs(uint(1), "missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeleteSchedule(context.Background(), 1, "schedule1")
+	assert.Nil(t, err)
+
+	err = repo.DeleteSchedule(context.Background(), 1, "missing")
+	assert.Equal(t, sql.ErrNoRows, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
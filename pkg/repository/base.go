@@ -18,10 +18,12 @@ type WorkerRepository interface {
 	GetAllWorkersOnMachine(machineId string) ([]*types.Worker, error)
 	AddWorker(w *types.Worker) error
 	ToggleWorkerAvailable(workerId string) error
+	DrainWorker(workerId string) error
 	RemoveWorker(w *types.Worker) error
 	SetWorkerKeepAlive(workerId string) error
 	UpdateWorkerCapacity(w *types.Worker, cr *types.ContainerRequest, ut types.CapacityUpdateType) error
 	ScheduleContainerRequest(worker *types.Worker, request *types.ContainerRequest) error
+	ScheduleContainerGroup(workers []*types.Worker, requests []*types.ContainerRequest) error
 	GetNextContainerRequest(workerId string) (*types.ContainerRequest, error)
 	AddContainerToWorker(workerId string, containerId string) error
 	RemoveContainerFromWorker(workerId string, containerId string) error
@@ -35,6 +37,8 @@ type ContainerRepository interface {
 	SetContainerState(string, *types.ContainerState) error
 	SetContainerExitCode(string, int) error
 	GetContainerExitCode(string) (int, error)
+	SetContainerSchedulingFailure(containerId string, failure *types.ContainerSchedulingFailure) error
+	GetContainerSchedulingFailure(containerId string) (*types.ContainerSchedulingFailure, error)
 	SetContainerAddress(containerId string, addr string) error
 	GetContainerAddress(containerId string) (string, error)
 	UpdateContainerStatus(string, types.ContainerStatus, time.Duration) error
@@ -71,9 +75,16 @@ type BackendRepository interface {
 	GetTask(ctx context.Context, externalId string) (*types.Task, error)
 	GetTaskWithRelated(ctx context.Context, externalId string) (*types.TaskWithRelated, error)
 	GetTaskByWorkspace(ctx context.Context, externalId string, workspace *types.Workspace) (*types.TaskWithRelated, error)
+	GetTasksByWorkspace(ctx context.Context, externalIds []string, workspace *types.Workspace) ([]types.TaskWithRelated, error)
 	CreateTask(ctx context.Context, params *types.TaskParams) (*types.Task, error)
+	CreateTasks(ctx context.Context, params []*types.TaskParams) error
 	UpdateTask(ctx context.Context, externalId string, updatedTask types.Task) (*types.Task, error)
 	DeleteTask(ctx context.Context, externalId string) error
+	AppendTaskRetry(ctx context.Context, externalId string, retry types.TaskRetry) error
+	SetTaskCancellation(ctx context.Context, externalId string, cancellation types.TaskCancellation) error
+	CreateTaskDependencies(ctx context.Context, externalId string, dependsOn []string) error
+	GetTaskDependencies(ctx context.Context, externalId string) ([]types.Task, error)
+	GetTaskGraph(ctx context.Context, externalId string) (*types.TaskGraph, error)
 	ListTasks(ctx context.Context) ([]types.Task, error)
 	ListTasksWithRelated(ctx context.Context, filters types.TaskFilter) ([]types.TaskWithRelated, error)
 	ListTasksWithRelatedPaginated(ctx context.Context, filters types.TaskFilter) (common.CursorPaginationInfo[types.TaskWithRelated], error)
@@ -85,6 +96,7 @@ type BackendRepository interface {
 	GetOrCreateVolume(ctx context.Context, workspaceId uint, name string) (*types.Volume, error)
 	ListVolumesWithRelated(ctx context.Context, workspaceId uint) ([]types.VolumeWithRelated, error)
 	ListDeploymentsWithRelated(ctx context.Context, filters types.DeploymentFilter) ([]types.DeploymentWithRelated, error)
+	ListActiveDeploymentsByStubType(ctx context.Context, stubType string) ([]types.DeploymentWithRelated, error)
 	ListLatestDeploymentsWithRelatedPaginated(ctx context.Context, filters types.DeploymentFilter) (common.CursorPaginationInfo[types.DeploymentWithRelated], error)
 	ListDeploymentsPaginated(ctx context.Context, filters types.DeploymentFilter) (common.CursorPaginationInfo[types.DeploymentWithRelated], error)
 	GetLatestDeploymentByName(ctx context.Context, workspaceId uint, name string, stubType string, filterDeleted bool) (*types.DeploymentWithRelated, error)
@@ -98,23 +110,53 @@ type BackendRepository interface {
 	GetConcurrencyLimit(ctx context.Context, concurrenyLimitId uint) (*types.ConcurrencyLimit, error)
 	GetConcurrencyLimitByWorkspaceId(ctx context.Context, workspaceId string) (*types.ConcurrencyLimit, error)
 	DeleteConcurrencyLimit(ctx context.Context, workspaceId types.Workspace) error
-	CreateConcurrencyLimit(ctx context.Context, workspaceId uint, gpuLimit uint32, cpuMillicoreLimit uint32) (*types.ConcurrencyLimit, error)
-	UpdateConcurrencyLimit(ctx context.Context, concurrencyLimitId uint, gpuLimit uint32, cpuMillicoreLimit uint32) (*types.ConcurrencyLimit, error)
+	CreateConcurrencyLimit(ctx context.Context, workspaceId uint, gpuLimit uint32, cpuMillicoreLimit uint32, maxReplicas uint32, priority int32, fairShareWeight uint32) (*types.ConcurrencyLimit, error)
+	UpdateConcurrencyLimit(ctx context.Context, concurrencyLimitId uint, gpuLimit uint32, cpuMillicoreLimit uint32, maxReplicas uint32, priority int32, fairShareWeight uint32) (*types.ConcurrencyLimit, error)
 	CreateSecret(ctx context.Context, workspace *types.Workspace, tokenId uint, name string, value string) (*types.Secret, error)
 	GetSecretByName(ctx context.Context, workspace *types.Workspace, name string) (*types.Secret, error)
 	GetSecretByNameDecrypted(ctx context.Context, workspace *types.Workspace, name string) (*types.Secret, error)
 	ListSecrets(ctx context.Context, workspace *types.Workspace) ([]types.Secret, error)
 	UpdateSecret(ctx context.Context, workspace *types.Workspace, tokenId uint, secretId string, value string) (*types.Secret, error)
 	DeleteSecret(ctx context.Context, workspace *types.Workspace, secretName string) error
+	CreateSchedule(ctx context.Context, workspaceId uint, deploymentId uint, cronExpression string, timezone string, payload []byte, nextRunAt time.Time) (*types.Schedule, error)
+	GetSchedule(ctx context.Context, workspaceId uint, externalId string) (*types.ScheduleWithRelated, error)
+	ListSchedules(ctx context.Context, workspaceId uint) ([]types.ScheduleWithRelated, error)
+	ListDueSchedules(ctx context.Context, now time.Time) ([]types.ScheduleWithRelated, error)
+	UpdateSchedule(ctx context.Context, schedule types.Schedule) (*types.Schedule, error)
+	ClaimScheduleRun(ctx context.Context, scheduleId uint, runAt time.Time, nextRunAt time.Time) (bool, error)
+	DeleteSchedule(ctx context.Context, workspaceId uint, externalId string) error
 }
 
 type TaskRepository interface {
 	SetTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte) error
+	SetTaskStates(ctx context.Context, workspaceName, stubId string, msgs map[string][]byte) error
+	GetTaskState(ctx context.Context, workspaceName, stubId, taskId string) (*types.TaskMessage, error)
 	DeleteTaskState(ctx context.Context, workspaceName, stubId, taskId string) error
-	GetTasksInFlight(ctx context.Context) ([]*types.TaskMessage, error)
+	ScanTasksInFlight(ctx context.Context, shard int, cursor uint64, count int64) ([]*types.TaskMessage, uint64, error)
+	MigrateTaskIndex(ctx context.Context) (int, error)
+	AcquireTaskShardLease(ctx context.Context, shard int, ownerId string, ttl time.Duration) (bool, error)
+	ReleaseTaskShardLease(ctx context.Context, shard int, ownerId string) error
+	RegisterTaskMonitor(ctx context.Context, ownerId string, ttl time.Duration) (int, error)
+	DeregisterTaskMonitor(ctx context.Context, ownerId string) error
+	SetWaitingTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte) error
+	DeleteWaitingTaskState(ctx context.Context, workspaceName, stubId, taskId string) error
+	GetWaitingTasks(ctx context.Context) ([]*types.TaskMessage, error)
+	SetDelayedTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte, runAt time.Time) error
+	DeleteDelayedTaskState(ctx context.Context, workspaceName, stubId, taskId string) (bool, error)
+	GetDueTasks(ctx context.Context, now time.Time) ([]*types.TaskMessage, error)
+	ReserveIdempotencyKey(ctx context.Context, workspaceName, stubId, idempotencyKey, taskId string, ttl time.Duration) (string, error)
+	DeleteIdempotencyKey(ctx context.Context, workspaceName, stubId, idempotencyKey string) error
+	AddDeadLetterTask(ctx context.Context, workspaceName, stubId string, task *types.DeadLetterTask) error
+	GetDeadLetterTask(ctx context.Context, workspaceName, stubId, taskId string) (*types.DeadLetterTask, error)
+	ListDeadLetterTasks(ctx context.Context, workspaceName, stubId string, offset, limit int) ([]types.DeadLetterTask, int, error)
+	DeleteDeadLetterTask(ctx context.Context, workspaceName, stubId, taskId string) (bool, error)
+	PurgeDeadLetterTasks(ctx context.Context, workspaceName, stubId string) (int, error)
 	ClaimTask(ctx context.Context, workspaceName, stubId, taskId, containerId string) error
 	IsClaimed(ctx context.Context, workspaceName, stubId, taskId string) (bool, error)
+	GetTaskClaim(ctx context.Context, workspaceName, stubId, taskId string) (*types.TaskClaim, error)
 	TasksClaimed(ctx context.Context, workspaceName, stubId string) (int, error)
+	CancelTask(ctx context.Context, workspaceName, stubId, taskId string, reason types.TaskCancellationReason, ttl time.Duration) error
+	GetTaskCancellation(ctx context.Context, workspaceName, stubId, taskId string) (types.TaskCancellationReason, error)
 	TasksInFlight(ctx context.Context, workspaceName, stubId string) (int, error)
 }
 
@@ -142,6 +184,8 @@ type EventRepository interface {
 	PushContainerScheduledEvent(containerID string, workerID string, request *types.ContainerRequest)
 	PushContainerStartedEvent(containerID string, workerID string, request *types.ContainerRequest)
 	PushContainerStoppedEvent(containerID string, workerID string, request *types.ContainerRequest)
+	PushContainerEvictedEvent(containerID string, workerID string, evictedBy string, request *types.ContainerRequest)
+	PushContainerFailedEvent(containerID string, request *types.ContainerRequest, failure *types.ContainerSchedulingFailure)
 	PushContainerResourceMetricsEvent(workerID string, request *types.ContainerRequest, metrics types.EventContainerMetricsData)
 	PushWorkerStartedEvent(workerID string)
 	PushWorkerStoppedEvent(workerID string)
@@ -68,6 +68,7 @@ func (cr *ContainerRedisRepository) SetContainerState(containerId string, info *
 		"gpu_count", info.GpuCount,
 		"cpu", info.Cpu,
 		"memory", info.Memory,
+		"priority", info.Priority,
 	).Err()
 	if err != nil {
 		return fmt.Errorf("failed to set container state <%v>: %w", stateKey, err)
@@ -115,6 +116,41 @@ func (cr *ContainerRedisRepository) GetContainerExitCode(containerId string) (in
 	return exitCode, nil
 }
 
+func (cr *ContainerRedisRepository) SetContainerSchedulingFailure(containerId string, failure *types.ContainerSchedulingFailure) error {
+	failureKey := common.RedisKeys.SchedulerContainerFailure(containerId)
+	err := cr.rdb.HSet(context.TODO(), failureKey, common.ToSlice(failure)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set scheduling failure <%v> for container <%v>: %w", failureKey, containerId, err)
+	}
+
+	err = cr.rdb.Expire(context.TODO(), failureKey, time.Duration(types.ContainerSchedulingFailureTtlS)*time.Second).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set scheduling failure ttl <%v>: %w", failureKey, err)
+	}
+
+	return nil
+}
+
+// GetContainerSchedulingFailure returns the reason a container could not be scheduled, or nil if it hasn't failed
+func (cr *ContainerRedisRepository) GetContainerSchedulingFailure(containerId string) (*types.ContainerSchedulingFailure, error) {
+	failureKey := common.RedisKeys.SchedulerContainerFailure(containerId)
+	res, err := cr.rdb.HGetAll(context.TODO(), failureKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	failure := &types.ContainerSchedulingFailure{}
+	if err = common.ToStruct(res, failure); err != nil {
+		return nil, fmt.Errorf("failed to deserialize scheduling failure <%v>: %v", failureKey, err)
+	}
+
+	return failure, nil
+}
+
 func (cr *ContainerRedisRepository) UpdateContainerStatus(containerId string, status types.ContainerStatus, expiry time.Duration) error {
 	switch status {
 	case types.ContainerStatusPending, types.ContainerStatusRunning, types.ContainerStatusStopping:
@@ -216,6 +252,12 @@ func (cr *ContainerRedisRepository) GetWorkerAddress(containerId string) (string
 			if err == nil {
 				return hostname, nil
 			}
+
+			// Stop waiting if the scheduler gave up on the container
+			failure, err := cr.GetContainerSchedulingFailure(containerId)
+			if err == nil && failure != nil {
+				return "", &types.ErrContainerSchedulingFailed{Failure: failure}
+			}
 		}
 	}
 }
@@ -343,25 +385,9 @@ func (c *ContainerRedisRepository) SetContainerStateWithConcurrencyLimit(quota *
 			return err
 		}
 
-		totalGpuCount := 0
-		totalCpu := 0
-		totalMemory := int64(0)
-		for _, container := range containers {
-			totalGpuCount += int(container.GpuCount)
-			totalCpu += int(container.Cpu)
-			totalMemory += container.Memory
-		}
-
-		if totalGpuCount+int(request.GpuCount) > int(quota.GPULimit) {
-			return &types.ThrottledByConcurrencyLimitError{
-				Reason: "gpu quota exceeded",
-			}
-		}
-
-		if totalCpu+int(request.Cpu) > int(quota.CPUMillicoreLimit) {
-			return &types.ThrottledByConcurrencyLimitError{
-				Reason: "cpu quota exceeded",
-			}
+		err = quota.Check(containers, request)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -375,6 +401,7 @@ func (c *ContainerRedisRepository) SetContainerStateWithConcurrencyLimit(quota *
 		GpuCount:    request.GpuCount,
 		Cpu:         request.Cpu,
 		Memory:      request.Memory,
+		Priority:    request.Priority,
 	})
 	if err != nil {
 		return err
@@ -155,6 +155,34 @@ func (t *TCPEventClientRepo) PushContainerStoppedEvent(containerID string, worke
 	)
 }
 
+func (t *TCPEventClientRepo) PushContainerEvictedEvent(containerID string, workerID string, evictedBy string, request *types.ContainerRequest) {
+	t.pushEvent(
+		types.EventContainerLifecycle,
+		types.EventContainerEvictedSchemaVersion,
+		types.EventContainerEvictedSchema{
+			ContainerID: containerID,
+			WorkerID:    workerID,
+			EvictedBy:   evictedBy,
+			Request:     *request,
+			Status:      types.EventContainerLifecycleEvicted,
+		},
+	)
+}
+
+func (t *TCPEventClientRepo) PushContainerFailedEvent(containerID string, request *types.ContainerRequest, failure *types.ContainerSchedulingFailure) {
+	t.pushEvent(
+		types.EventContainerLifecycle,
+		types.EventContainerFailedSchemaVersion,
+		types.EventContainerFailedSchema{
+			ContainerID: containerID,
+			Request:     *request,
+			Status:      types.EventContainerLifecycleFailed,
+			Reason:      failure.Reason,
+			Message:     failure.Message,
+		},
+	)
+}
+
 func (t *TCPEventClientRepo) PushWorkerStartedEvent(workerID string) {
 	t.pushEvent(
 		types.EventWorkerLifecycle,
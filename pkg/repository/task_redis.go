@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/beam-cloud/beta9/pkg/common"
 	"github.com/beam-cloud/beta9/pkg/types"
@@ -23,7 +27,7 @@ func (r *TaskRedisRepository) ClaimTask(ctx context.Context, workspaceName, stub
 	claimKey := common.RedisKeys.TaskClaim(workspaceName, stubId, taskId)
 	claimIndexKey := common.RedisKeys.TaskClaimIndex(workspaceName, stubId)
 
-	err := r.rdb.Set(ctx, claimKey, containerId, 0).Err()
+	err := r.rdb.HSet(ctx, claimKey, "container_id", containerId, "claimed_at", time.Now().UnixMilli()).Err()
 	if err != nil {
 		return fmt.Errorf("failed to claim task <%v>: %w", claimKey, err)
 	}
@@ -36,6 +40,29 @@ func (r *TaskRedisRepository) ClaimTask(ctx context.Context, workspaceName, stub
 	return nil
 }
 
+// GetTaskClaim returns the claim on a task, or nil if the task hasn't been claimed
+func (r *TaskRedisRepository) GetTaskClaim(ctx context.Context, workspaceName, stubId, taskId string) (*types.TaskClaim, error) {
+	claimKey := common.RedisKeys.TaskClaim(workspaceName, stubId, taskId)
+	values, err := r.rdb.HGetAll(ctx, claimKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve task claim <%v>: %w", claimKey, err)
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	claimedAt, err := strconv.ParseInt(values["claimed_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task claim <%v>: %w", claimKey, err)
+	}
+
+	return &types.TaskClaim{
+		ContainerId: values["container_id"],
+		ClaimedAt:   time.UnixMilli(claimedAt),
+	}, nil
+}
+
 func (r *TaskRedisRepository) TasksClaimed(ctx context.Context, workspaceName, stubId string) (int, error) {
 	tasks, err := r.rdb.SMembers(ctx, common.RedisKeys.TaskClaimIndex(workspaceName, stubId)).Result()
 	if err != nil {
@@ -45,6 +72,30 @@ func (r *TaskRedisRepository) TasksClaimed(ctx context.Context, workspaceName, s
 	return len(tasks), nil
 }
 
+// CancelTask marks a task as cancelled and notifies anyone watching its cancel channel. The mark outlives the
+// notification, so runners that start watching late still see the cancellation.
+func (r *TaskRedisRepository) CancelTask(ctx context.Context, workspaceName, stubId, taskId string, reason types.TaskCancellationReason, ttl time.Duration) error {
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, common.RedisKeys.TaskCancelled(workspaceName, stubId, taskId), string(reason), ttl)
+		pipe.Publish(ctx, common.RedisKeys.TaskCancel(workspaceName, stubId, taskId), taskId)
+		return nil
+	})
+	return err
+}
+
+// GetTaskCancellation returns why a task was cancelled, or an empty reason if it wasn't
+func (r *TaskRedisRepository) GetTaskCancellation(ctx context.Context, workspaceName, stubId, taskId string) (types.TaskCancellationReason, error) {
+	reason, err := r.rdb.Get(ctx, common.RedisKeys.TaskCancelled(workspaceName, stubId, taskId)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return types.TaskCancellationReason(reason), nil
+}
+
 func (r *TaskRedisRepository) IsClaimed(ctx context.Context, workspaceName, stubId, taskId string) (bool, error) {
 	claimKey := common.RedisKeys.TaskClaim(workspaceName, stubId, taskId)
 	exists, err := r.rdb.Exists(ctx, claimKey).Result()
@@ -56,7 +107,7 @@ func (r *TaskRedisRepository) IsClaimed(ctx context.Context, workspaceName, stub
 }
 
 func (r *TaskRedisRepository) SetTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte) error {
-	indexKey := common.RedisKeys.TaskIndex()
+	indexKey := common.RedisKeys.TaskIndexShard(types.TaskStateShard(taskId))
 	stubIndexKey := common.RedisKeys.TaskIndexByStub(workspaceName, stubId)
 	entryKey := common.RedisKeys.TaskEntry(workspaceName, stubId, taskId)
 
@@ -79,6 +130,53 @@ func (r *TaskRedisRepository) SetTaskState(ctx context.Context, workspaceName, s
 	return nil
 }
 
+// SetTaskStates stores the state of several tasks, keyed by task id, in a single round trip
+func (r *TaskRedisRepository) SetTaskStates(ctx context.Context, workspaceName, stubId string, msgs map[string][]byte) error {
+	stubIndexKey := common.RedisKeys.TaskIndexByStub(workspaceName, stubId)
+
+	entryKeys := make(map[int][]interface{})
+	taskIds := make([]interface{}, 0, len(msgs))
+	for taskId := range msgs {
+		shard := types.TaskStateShard(taskId)
+		entryKeys[shard] = append(entryKeys[shard], common.RedisKeys.TaskEntry(workspaceName, stubId, taskId))
+		taskIds = append(taskIds, taskId)
+	}
+
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for shard, keys := range entryKeys {
+			pipe.SAdd(ctx, common.RedisKeys.TaskIndexShard(shard), keys...)
+		}
+		pipe.SAdd(ctx, stubIndexKey, taskIds...)
+		for taskId, msg := range msgs {
+			pipe.Set(ctx, common.RedisKeys.TaskEntry(workspaceName, stubId, taskId), msg, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		for taskId := range msgs {
+			r.DeleteTaskState(ctx, workspaceName, stubId, taskId)
+		}
+		return fmt.Errorf("failed to set task states: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TaskRedisRepository) GetTaskState(ctx context.Context, workspaceName, stubId, taskId string) (*types.TaskMessage, error) {
+	msg, err := r.rdb.Get(ctx, common.RedisKeys.TaskEntry(workspaceName, stubId, taskId)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	taskMessage := &types.TaskMessage{}
+	err = taskMessage.Decode(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return taskMessage, nil
+}
+
 func (r *TaskRedisRepository) TasksInFlight(ctx context.Context, workspaceName, stubId string) (int, error) {
 	tasks, err := r.rdb.SMembers(ctx, common.RedisKeys.TaskIndexByStub(workspaceName, stubId)).Result()
 	if err != nil {
@@ -89,7 +187,7 @@ func (r *TaskRedisRepository) TasksInFlight(ctx context.Context, workspaceName,
 }
 
 func (r *TaskRedisRepository) DeleteTaskState(ctx context.Context, workspaceName, stubId, taskId string) error {
-	indexKey := common.RedisKeys.TaskIndex()
+	indexKey := common.RedisKeys.TaskIndexShard(types.TaskStateShard(taskId))
 	entryKey := common.RedisKeys.TaskEntry(workspaceName, stubId, taskId)
 	claimKey := common.RedisKeys.TaskClaim(workspaceName, stubId, taskId)
 	claimIndexKey := common.RedisKeys.TaskClaimIndex(workspaceName, stubId)
@@ -107,9 +205,172 @@ func (r *TaskRedisRepository) DeleteTaskState(ctx context.Context, workspaceName
 	return err
 }
 
-func (r *TaskRedisRepository) GetTasksInFlight(ctx context.Context) ([]*types.TaskMessage, error) {
+// ScanTasksInFlight returns a page of the in-flight tasks in a shard, and the cursor to pass to get the next
+// page. A returned cursor of zero means the scan of the shard is complete. Index entries whose task state
+// no longer exists are removed as they are found.
+func (r *TaskRedisRepository) ScanTasksInFlight(ctx context.Context, shard int, cursor uint64, count int64) ([]*types.TaskMessage, uint64, error) {
+	indexKey := common.RedisKeys.TaskIndexShard(shard)
+
+	taskKeys, cursor, err := r.rdb.SScan(ctx, indexKey, cursor, "", count).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cmds, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, taskKey := range taskKeys {
+			pipe.Get(ctx, taskKey)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, 0, err
+	}
+
+	taskMessages := make([]*types.TaskMessage, 0, len(taskKeys))
+	for i, cmd := range cmds {
+		msg, err := cmd.(*redis.StringCmd).Bytes()
+		if err == redis.Nil {
+			r.rdb.SRem(ctx, indexKey, taskKeys[i])
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		taskMessage := &types.TaskMessage{}
+		if err := taskMessage.Decode(msg); err != nil {
+			continue
+		}
+
+		taskMessages = append(taskMessages, taskMessage)
+	}
+
+	return taskMessages, cursor, nil
+}
+
+// MigrateTaskIndex moves tasks from the unsharded index used by older gateways into their shards
+func (r *TaskRedisRepository) MigrateTaskIndex(ctx context.Context) (int, error) {
+	migrated := 0
+
+	for {
+		taskKeys, err := r.rdb.SPopN(ctx, common.RedisKeys.TaskIndex(), 500).Result()
+		if err != nil {
+			return migrated, err
+		}
+
+		if len(taskKeys) == 0 {
+			return migrated, nil
+		}
+
+		_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, taskKey := range taskKeys {
+				taskId := taskKey[strings.LastIndex(taskKey, ":")+1:]
+				pipe.SAdd(ctx, common.RedisKeys.TaskIndexShard(types.TaskStateShard(taskId)), taskKey)
+			}
+			return nil
+		})
+		if err != nil {
+			return migrated, err
+		}
+
+		migrated += len(taskKeys)
+	}
+}
+
+// acquireLeaseScript takes a lease that is free, or extends one that the owner already holds
+var acquireLeaseScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+if not owner then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// AcquireTaskShardLease takes or renews the lease on a shard of in-flight tasks. It reports whether the
+// owner holds the lease.
+func (r *TaskRedisRepository) AcquireTaskShardLease(ctx context.Context, shard int, ownerId string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireLeaseScript.Run(ctx, r.rdb, []string{common.RedisKeys.TaskMonitorLease(shard)}, ownerId, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return acquired == 1, nil
+}
+
+func (r *TaskRedisRepository) ReleaseTaskShardLease(ctx context.Context, shard int, ownerId string) error {
+	return releaseLeaseScript.Run(ctx, r.rdb, []string{common.RedisKeys.TaskMonitorLease(shard)}, ownerId).Err()
+}
+
+// RegisterTaskMonitor records that a task monitor is alive, and returns the number of live monitors.
+// Monitors that haven't registered within the ttl are dropped.
+func (r *TaskRedisRepository) RegisterTaskMonitor(ctx context.Context, ownerId string, ttl time.Duration) (int, error) {
+	indexKey := common.RedisKeys.TaskMonitorIndex()
+	now := time.Now()
+
+	var count *redis.IntCmd
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(now.UnixMilli()), Member: ownerId})
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(now.Add(-ttl).UnixMilli(), 10))
+		count = pipe.ZCard(ctx, indexKey)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count.Val()), nil
+}
+
+func (r *TaskRedisRepository) DeregisterTaskMonitor(ctx context.Context, ownerId string) error {
+	return r.rdb.ZRem(ctx, common.RedisKeys.TaskMonitorIndex(), ownerId).Err()
+}
+
+// SetWaitingTaskState stores a task that is waiting on its dependencies. Waiting tasks aren't in flight,
+// so they don't count towards a stub's pending tasks and can't expire before they are released.
+func (r *TaskRedisRepository) SetWaitingTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte) error {
+	indexKey := common.RedisKeys.TaskWaitIndex()
+	entryKey := common.RedisKeys.TaskWaitEntry(workspaceName, stubId, taskId)
+
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, entryKey, msg, 0)
+		pipe.SAdd(ctx, indexKey, entryKey)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set waiting task state <%v>: %w", entryKey, err)
+	}
+
+	return nil
+}
+
+func (r *TaskRedisRepository) DeleteWaitingTaskState(ctx context.Context, workspaceName, stubId, taskId string) error {
+	indexKey := common.RedisKeys.TaskWaitIndex()
+	entryKey := common.RedisKeys.TaskWaitEntry(workspaceName, stubId, taskId)
+
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, indexKey, entryKey)
+		pipe.Del(ctx, entryKey)
+		return nil
+	})
+
+	return err
+}
+
+func (r *TaskRedisRepository) GetWaitingTasks(ctx context.Context) ([]*types.TaskMessage, error) {
 	taskMessages := []*types.TaskMessage{}
-	tasks, err := r.rdb.SMembers(ctx, common.RedisKeys.TaskIndex()).Result()
+	tasks, err := r.rdb.SMembers(ctx, common.RedisKeys.TaskWaitIndex()).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -127,3 +388,204 @@ func (r *TaskRedisRepository) GetTasksInFlight(ctx context.Context) ([]*types.Ta
 
 	return taskMessages, nil
 }
+
+// SetDelayedTaskState stores a task that can't run until runAt, in a sorted set scored by when it's due
+func (r *TaskRedisRepository) SetDelayedTaskState(ctx context.Context, workspaceName, stubId, taskId string, msg []byte, runAt time.Time) error {
+	indexKey := common.RedisKeys.TaskDelayIndex()
+	entryKey := common.RedisKeys.TaskDelayEntry(workspaceName, stubId, taskId)
+
+	_, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, entryKey, msg, 0)
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(runAt.Unix()), Member: entryKey})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set delayed task state <%v>: %w", entryKey, err)
+	}
+
+	return nil
+}
+
+// DeleteDelayedTaskState removes a delayed task, and reports whether it was still delayed. Removing a due
+// task claims it, so it's only released by one gateway.
+func (r *TaskRedisRepository) DeleteDelayedTaskState(ctx context.Context, workspaceName, stubId, taskId string) (bool, error) {
+	indexKey := common.RedisKeys.TaskDelayIndex()
+	entryKey := common.RedisKeys.TaskDelayEntry(workspaceName, stubId, taskId)
+
+	removed, err := r.rdb.ZRem(ctx, indexKey, entryKey).Result()
+	if err != nil {
+		return false, err
+	}
+
+	err = r.rdb.Del(ctx, entryKey).Err()
+	if err != nil {
+		return false, err
+	}
+
+	return removed > 0, nil
+}
+
+// GetDueTasks returns the delayed tasks that are due to run
+func (r *TaskRedisRepository) GetDueTasks(ctx context.Context, now time.Time) ([]*types.TaskMessage, error) {
+	taskMessages := []*types.TaskMessage{}
+	tasks, err := r.rdb.ZRangeByScore(ctx, common.RedisKeys.TaskDelayIndex(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(now.Unix(), 10),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, taskKey := range tasks {
+		msg, err := r.rdb.Get(ctx, taskKey).Bytes()
+		if err != nil {
+			continue
+		}
+
+		taskMessage := &types.TaskMessage{}
+		taskMessage.Decode(msg)
+		taskMessages = append(taskMessages, taskMessage)
+	}
+
+	return taskMessages, nil
+}
+
+// ReserveIdempotencyKey reserves an idempotency key for a task until the ttl expires, and returns the id of
+// the task holding the key. That's taskId unless the key was already reserved by another task.
+func (r *TaskRedisRepository) ReserveIdempotencyKey(ctx context.Context, workspaceName, stubId, idempotencyKey, taskId string, ttl time.Duration) (string, error) {
+	key := common.RedisKeys.TaskIdempotencyKey(workspaceName, stubId, idempotencyKey)
+
+	// The key can expire between reserving and reading it, in which case it's reserved again
+	for attempt := 0; attempt < 3; attempt++ {
+		reserved, err := r.rdb.SetNX(ctx, key, taskId, ttl).Result()
+		if err != nil {
+			return "", err
+		}
+
+		if reserved {
+			return taskId, nil
+		}
+
+		existingTaskId, err := r.rdb.Get(ctx, key).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return existingTaskId, nil
+	}
+
+	return "", fmt.Errorf("unable to reserve idempotency key <%v>", key)
+}
+
+func (r *TaskRedisRepository) DeleteIdempotencyKey(ctx context.Context, workspaceName, stubId, idempotencyKey string) error {
+	return r.rdb.Del(ctx, common.RedisKeys.TaskIdempotencyKey(workspaceName, stubId, idempotencyKey)).Err()
+}
+
+// AddDeadLetterTask moves a task to its stub's dead-letter queue, a sorted set scored by when it was added
+func (r *TaskRedisRepository) AddDeadLetterTask(ctx context.Context, workspaceName, stubId string, task *types.DeadLetterTask) error {
+	indexKey := common.RedisKeys.TaskDeadLetterIndex(workspaceName, stubId)
+	entryKey := common.RedisKeys.TaskDeadLetterEntry(workspaceName, stubId, task.TaskMessage.TaskId)
+
+	entry, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, entryKey, entry, 0)
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(task.DeadLetteredAt.UnixMilli()), Member: task.TaskMessage.TaskId})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add dead-letter task <%v>: %w", entryKey, err)
+	}
+
+	return nil
+}
+
+// GetDeadLetterTask returns a task in a stub's dead-letter queue, or nil if it isn't there
+func (r *TaskRedisRepository) GetDeadLetterTask(ctx context.Context, workspaceName, stubId, taskId string) (*types.DeadLetterTask, error) {
+	entry, err := r.rdb.Get(ctx, common.RedisKeys.TaskDeadLetterEntry(workspaceName, stubId, taskId)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	task := &types.DeadLetterTask{}
+	err = json.Unmarshal(entry, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// ListDeadLetterTasks returns a page of a stub's dead-letter queue, most recent first, and the size of the queue
+func (r *TaskRedisRepository) ListDeadLetterTasks(ctx context.Context, workspaceName, stubId string, offset, limit int) ([]types.DeadLetterTask, int, error) {
+	indexKey := common.RedisKeys.TaskDeadLetterIndex(workspaceName, stubId)
+
+	total, err := r.rdb.ZCard(ctx, indexKey).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	taskIds, err := r.rdb.ZRevRange(ctx, indexKey, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tasks := []types.DeadLetterTask{}
+	for _, taskId := range taskIds {
+		task, err := r.GetDeadLetterTask(ctx, workspaceName, stubId, taskId)
+		if err != nil || task == nil {
+			continue
+		}
+
+		tasks = append(tasks, *task)
+	}
+
+	return tasks, int(total), nil
+}
+
+// DeleteDeadLetterTask removes a task from a stub's dead-letter queue, and reports whether it was there.
+// Removing a task claims it, so a task is only re-driven once.
+func (r *TaskRedisRepository) DeleteDeadLetterTask(ctx context.Context, workspaceName, stubId, taskId string) (bool, error) {
+	removed, err := r.rdb.ZRem(ctx, common.RedisKeys.TaskDeadLetterIndex(workspaceName, stubId), taskId).Result()
+	if err != nil {
+		return false, err
+	}
+
+	err = r.rdb.Del(ctx, common.RedisKeys.TaskDeadLetterEntry(workspaceName, stubId, taskId)).Err()
+	if err != nil {
+		return false, err
+	}
+
+	return removed > 0, nil
+}
+
+// PurgeDeadLetterTasks empties a stub's dead-letter queue, and returns the number of tasks removed
+func (r *TaskRedisRepository) PurgeDeadLetterTasks(ctx context.Context, workspaceName, stubId string) (int, error) {
+	taskIds, err := r.rdb.ZRange(ctx, common.RedisKeys.TaskDeadLetterIndex(workspaceName, stubId), 0, -1).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, taskId := range taskIds {
+		removed, err := r.DeleteDeadLetterTask(ctx, workspaceName, stubId, taskId)
+		if err != nil {
+			return purged, err
+		}
+
+		if removed {
+			purged++
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,415 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/tj/assert"
+)
+
+func TestReserveIdempotencyKey(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	taskId, err := repo.ReserveIdempotencyKey(ctx, "workspace", "stub", "key", "task1", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "task1", taskId)
+
+	// The same key returns the task that reserved it
+	taskId, err = repo.ReserveIdempotencyKey(ctx, "workspace", "stub", "key", "task2", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "task1", taskId)
+
+	// Keys are scoped per stub
+	taskId, err = repo.ReserveIdempotencyKey(ctx, "workspace", "stub2", "key", "task3", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "task3", taskId)
+
+	err = repo.DeleteIdempotencyKey(ctx, "workspace", "stub", "key")
+	assert.Nil(t, err)
+
+	taskId, err = repo.ReserveIdempotencyKey(ctx, "workspace", "stub", "key", "task4", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "task4", taskId)
+}
+
+func TestGetDueTasks(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+	now := time.Now()
+
+	err = repo.SetDelayedTaskState(ctx, "workspace", "stub", "task1", []byte(`{"task_id": "task1"}`), now.Add(-time.Minute))
+	assert.Nil(t, err)
+
+	err = repo.SetDelayedTaskState(ctx, "workspace", "stub", "task2", []byte(`{"task_id": "task2"}`), now.Add(time.Hour))
+	assert.Nil(t, err)
+
+	tasks, err := repo.GetDueTasks(ctx, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, "task1", tasks[0].TaskId)
+
+	// Only the first delete claims the task
+	claimed, err := repo.DeleteDelayedTaskState(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.DeleteDelayedTaskState(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	tasks, err = repo.GetDueTasks(ctx, now.Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, "task2", tasks[0].TaskId)
+}
+
+func TestDeadLetterTasks(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+	now := time.Now()
+
+	for i, taskId := range []string{"task1", "task2", "task3"} {
+		err = repo.AddDeadLetterTask(ctx, "workspace", "stub", &types.DeadLetterTask{
+			TaskMessage:    types.TaskMessage{TaskId: taskId, StubId: "stub", Retries: 3},
+			Reason:         types.TaskExceededRetryLimit,
+			Error:          "missing heartbeat",
+			DeadLetteredAt: now.Add(time.Duration(i) * time.Second),
+		})
+		assert.Nil(t, err)
+	}
+
+	// Most recent first
+	tasks, total, err := repo.ListDeadLetterTasks(ctx, "workspace", "stub", 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, "task3", tasks[0].TaskMessage.TaskId)
+	assert.Equal(t, "task2", tasks[1].TaskMessage.TaskId)
+
+	task, err := repo.GetDeadLetterTask(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint(3), task.TaskMessage.Retries)
+	assert.Equal(t, "missing heartbeat", task.Error)
+
+	// Only the first delete claims the task
+	claimed, err := repo.DeleteDeadLetterTask(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.DeleteDeadLetterTask(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	task, err = repo.GetDeadLetterTask(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Nil(t, task)
+
+	purged, err := repo.PurgeDeadLetterTasks(ctx, "workspace", "stub")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, purged)
+
+	_, total, err = repo.ListDeadLetterTasks(ctx, "workspace", "stub", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestSetTaskStates(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	msgs := map[string][]byte{}
+	for _, taskId := range []string{"task1", "task2", "task3"} {
+		msg, err := (&types.TaskMessage{TaskId: taskId, StubId: "stub", WorkspaceName: "workspace"}).Encode()
+		assert.Nil(t, err)
+		msgs[taskId] = msg
+	}
+
+	err = repo.SetTaskStates(ctx, "workspace", "stub", msgs)
+	assert.Nil(t, err)
+
+	inFlight, err := repo.TasksInFlight(ctx, "workspace", "stub")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, inFlight)
+
+	assert.Len(t, scanAllTasksInFlight(t, repo), 3)
+
+	state, err := repo.GetTaskState(ctx, "workspace", "stub", "task2")
+	assert.Nil(t, err)
+	assert.Equal(t, "task2", state.TaskId)
+}
+
+const benchmarkTaskBatchSize = 1000
+
+func benchmarkTaskMessages(b *testing.B) map[string][]byte {
+	msgs := make(map[string][]byte, benchmarkTaskBatchSize)
+	for i := 0; i < benchmarkTaskBatchSize; i++ {
+		taskId := fmt.Sprintf("task%d", i)
+		msg, err := (&types.TaskMessage{TaskId: taskId, StubId: "stub", WorkspaceName: "workspace"}).Encode()
+		assert.Nil(b, err)
+		msgs[taskId] = msg
+	}
+
+	return msgs
+}
+
+func BenchmarkSetTaskState(b *testing.B) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(b, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+	msgs := benchmarkTaskMessages(b)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for taskId, msg := range msgs {
+			err := repo.SetTaskState(ctx, "workspace", "stub", taskId, msg)
+			assert.Nil(b, err)
+		}
+	}
+}
+
+func BenchmarkSetTaskStates(b *testing.B) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(b, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+	msgs := benchmarkTaskMessages(b)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := repo.SetTaskStates(ctx, "workspace", "stub", msgs)
+		assert.Nil(b, err)
+	}
+}
+
+func TestGetTaskClaim(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	claim, err := repo.GetTaskClaim(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Nil(t, claim)
+
+	before := time.Now().Truncate(time.Millisecond)
+	err = repo.ClaimTask(ctx, "workspace", "stub", "task1", "container1")
+	assert.Nil(t, err)
+
+	claimed, err := repo.IsClaimed(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	claim, err = repo.GetTaskClaim(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, "container1", claim.ContainerId)
+	assert.False(t, claim.ClaimedAt.Before(before))
+
+	err = repo.DeleteTaskState(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+
+	claim, err = repo.GetTaskClaim(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Nil(t, claim)
+}
+
+func scanAllTasksInFlight(t *testing.T, repo TaskRepository) []*types.TaskMessage {
+	ctx := context.Background()
+	tasks := []*types.TaskMessage{}
+
+	for shard := 0; shard < types.TaskStateShards; shard++ {
+		var cursor uint64
+		for {
+			page, next, err := repo.ScanTasksInFlight(ctx, shard, cursor, 2)
+			assert.Nil(t, err)
+			tasks = append(tasks, page...)
+
+			cursor = next
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+
+	return tasks
+}
+
+func TestScanTasksInFlight(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	for i := 0; i < 20; i++ {
+		taskId := fmt.Sprintf("task%d", i)
+		msg, err := (&types.TaskMessage{TaskId: taskId, StubId: "stub", WorkspaceName: "workspace"}).Encode()
+		assert.Nil(t, err)
+
+		err = repo.SetTaskState(ctx, "workspace", "stub", taskId, msg)
+		assert.Nil(t, err)
+	}
+
+	tasks := scanAllTasksInFlight(t, repo)
+	assert.Len(t, tasks, 20)
+
+	// Index entries without task state are skipped and removed
+	shard := types.TaskStateShard("task0")
+	err = rdb.Del(ctx, common.RedisKeys.TaskEntry("workspace", "stub", "task0")).Err()
+	assert.Nil(t, err)
+
+	assert.Len(t, scanAllTasksInFlight(t, repo), 19)
+
+	isMember, err := rdb.SIsMember(ctx, common.RedisKeys.TaskIndexShard(shard), common.RedisKeys.TaskEntry("workspace", "stub", "task0")).Result()
+	assert.Nil(t, err)
+	assert.False(t, isMember)
+}
+
+func TestMigrateTaskIndex(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		taskId := fmt.Sprintf("task%d", i)
+		msg, err := (&types.TaskMessage{TaskId: taskId, StubId: "stub", WorkspaceName: "workspace"}).Encode()
+		assert.Nil(t, err)
+
+		entryKey := common.RedisKeys.TaskEntry("workspace", "stub", taskId)
+		assert.Nil(t, rdb.Set(ctx, entryKey, msg, 0).Err())
+		assert.Nil(t, rdb.SAdd(ctx, common.RedisKeys.TaskIndex(), entryKey).Err())
+	}
+
+	migrated, err := repo.MigrateTaskIndex(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, migrated)
+	assert.Len(t, scanAllTasksInFlight(t, repo), 5)
+
+	exists, err := rdb.Exists(ctx, common.RedisKeys.TaskIndex()).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), exists)
+
+	// Migrating again is a no-op
+	migrated, err = repo.MigrateTaskIndex(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, migrated)
+}
+
+func TestTaskShardLease(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	acquired, err := repo.AcquireTaskShardLease(ctx, 1, "monitor1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	// The owner can renew its lease, but other monitors can't take it
+	acquired, err = repo.AcquireTaskShardLease(ctx, 1, "monitor1", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = repo.AcquireTaskShardLease(ctx, 1, "monitor2", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	// Only the owner can release its lease
+	err = repo.ReleaseTaskShardLease(ctx, 1, "monitor2")
+	assert.Nil(t, err)
+
+	acquired, err = repo.AcquireTaskShardLease(ctx, 1, "monitor2", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	err = repo.ReleaseTaskShardLease(ctx, 1, "monitor1")
+	assert.Nil(t, err)
+
+	acquired, err = repo.AcquireTaskShardLease(ctx, 1, "monitor2", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+}
+
+func TestRegisterTaskMonitor(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx := context.Background()
+
+	monitors, err := repo.RegisterTaskMonitor(ctx, "monitor1", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, monitors)
+
+	monitors, err = repo.RegisterTaskMonitor(ctx, "monitor2", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, monitors)
+
+	// Registering again doesn't add a monitor
+	monitors, err = repo.RegisterTaskMonitor(ctx, "monitor1", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, monitors)
+
+	err = repo.DeregisterTaskMonitor(ctx, "monitor2")
+	assert.Nil(t, err)
+
+	monitors, err = repo.RegisterTaskMonitor(ctx, "monitor1", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, monitors)
+}
+
+func TestCancelTask(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.Nil(t, err)
+
+	repo := NewTaskRedisRepository(rdb)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reason, err := repo.GetTaskCancellation(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.TaskCancellationReason(""), reason)
+
+	messages, _ := rdb.Subscribe(ctx, common.RedisKeys.TaskCancel("workspace", "stub", "task1"))
+	time.Sleep(100 * time.Millisecond) // Subscriptions are made asynchronously
+
+	err = repo.CancelTask(ctx, "workspace", "stub", "task1", types.TaskCancelledByUser, time.Minute)
+	assert.Nil(t, err)
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, "task1", msg.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("cancellation wasn't published")
+	}
+
+	// The cancellation outlives the notification
+	reason, err = repo.GetTaskCancellation(ctx, "workspace", "stub", "task1")
+	assert.Nil(t, err)
+	assert.Equal(t, types.TaskCancelledByUser, reason)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -138,6 +139,35 @@ func (r *WorkerRedisRepository) ToggleWorkerAvailable(workerId string) error {
 	return nil
 }
 
+// DrainWorker stops a worker from accepting new containers. The worker shuts down once its containers have exited.
+func (r *WorkerRedisRepository) DrainWorker(workerId string) error {
+	err := r.lock.Acquire(context.TODO(), common.RedisKeys.SchedulerWorkerLock(workerId), common.RedisLockOptions{TtlS: 10, Retries: 3})
+	if err != nil {
+		return err
+	}
+	defer r.lock.Release(common.RedisKeys.SchedulerWorkerLock(workerId))
+
+	stateKey := common.RedisKeys.SchedulerWorkerState(workerId)
+	worker, err := r.getWorkerFromKey(stateKey)
+	if err != nil {
+		return err
+	}
+
+	if worker.Status != types.WorkerStatusAvailable {
+		return &types.ErrInvalidWorkerStatus{}
+	}
+
+	// Bumping the resource version makes any in-flight scheduling decision for this worker fail and retry elsewhere
+	worker.ResourceVersion++
+	worker.Status = types.WorkerStatusDraining
+	err = r.rdb.HSet(context.TODO(), stateKey, common.ToSlice(worker)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to drain worker <%s>: %v", stateKey, err)
+	}
+
+	return nil
+}
+
 // getWorkers retrieves a list of worker objects from the Redis store that match a given pattern.
 // If useLock is set to true, a lock will be acquired for each worker and released after retrieval.
 // If you can afford to not have the most up-to-date worker information, you can set useLock to false.
@@ -324,24 +354,52 @@ func (r *WorkerRedisRepository) UpdateWorkerCapacity(worker *types.Worker, reque
 		return errors.New("invalid worker resource version")
 	}
 
+	err = applyCapacityUpdate(updatedWorker, request, CapacityUpdateType)
+	if err != nil {
+		return err
+	}
+
+	// Update the worker capacity with the new values
+	updatedWorker.ResourceVersion++
+	err = r.rdb.HSet(context.TODO(), key, common.ToSlice(updatedWorker)).Err()
+	if err != nil {
+		return fmt.Errorf("failed to update worker capacity <%s>: %v", key, err)
+	}
+
+	return nil
+}
+
+func applyCapacityUpdate(worker *types.Worker, request *types.ContainerRequest, CapacityUpdateType types.CapacityUpdateType) error {
 	switch CapacityUpdateType {
 	case types.AddCapacity:
-		updatedWorker.FreeCpu = updatedWorker.FreeCpu + request.Cpu
-		updatedWorker.FreeMemory = updatedWorker.FreeMemory + request.Memory
-
-		if request.Gpu != "" {
-			updatedWorker.FreeGpuCount += request.GpuCount
+		worker.FreeCpu = worker.FreeCpu + request.Cpu
+		worker.FreeMemory = worker.FreeMemory + request.Memory
+
+		if request.SharesGpu() {
+			if request.GpuSlice != nil {
+				worker.ReleaseGpuSlices(request.GpuSlice.Device, request.GpuSlice.Slices)
+			}
+		} else if request.Gpu != "" {
+			worker.FreeGpuCount += request.GpuCount
 		}
 
 	case types.RemoveCapacity:
-		updatedWorker.FreeCpu = updatedWorker.FreeCpu - request.Cpu
-		updatedWorker.FreeMemory = updatedWorker.FreeMemory - request.Memory
-
-		if request.Gpu != "" {
-			updatedWorker.FreeGpuCount -= request.GpuCount
+		if request.SharesGpu() {
+			if err := reserveGpuSlice(worker, request); err != nil {
+				return err
+			}
+		} else if request.Gpu != "" {
+			if worker.FreeGpuCount < request.GpuCount {
+				return errors.New("unable to schedule container, worker out of cpu, memory, or gpu")
+			}
+
+			worker.FreeGpuCount -= request.GpuCount
 		}
 
-		if updatedWorker.FreeCpu < 0 || updatedWorker.FreeMemory < 0 || updatedWorker.FreeGpuCount < 0 {
+		worker.FreeCpu = worker.FreeCpu - request.Cpu
+		worker.FreeMemory = worker.FreeMemory - request.Memory
+
+		if worker.FreeCpu < 0 || worker.FreeMemory < 0 {
 			return errors.New("unable to schedule container, worker out of cpu, memory, or gpu")
 		}
 
@@ -349,27 +407,43 @@ func (r *WorkerRedisRepository) UpdateWorkerCapacity(worker *types.Worker, reque
 		return errors.New("invalid capacity update type")
 	}
 
-	// Update the worker capacity with the new values
-	updatedWorker.ResourceVersion++
-	err = r.rdb.HSet(context.TODO(), key, common.ToSlice(updatedWorker)).Err()
-	if err != nil {
-		return fmt.Errorf("failed to update worker capacity <%s>: %v", key, err)
+	return nil
+}
+
+// reserveGpuSlice takes enough slices to cover the request's gpu memory from a single device, and records the
+// assignment on the request so the worker can enforce it and the slices can be released later
+func reserveGpuSlice(worker *types.Worker, request *types.ContainerRequest) error {
+	if !worker.SharesGpus() {
+		return errors.New("unable to schedule container, worker does not share gpus")
+	}
+
+	slices := worker.GpuSlicesNeeded(request)
+	device, ok := worker.ReserveGpuSlices(slices)
+	if !ok {
+		return errors.New("unable to schedule container, worker out of gpu slices")
+	}
+
+	request.GpuSlice = &types.GpuSlice{
+		Device:         device,
+		Slices:         slices,
+		SlicesPerGpu:   worker.GpuSlicesPerDevice,
+		MemoryLimitMiB: int64(slices) * worker.GpuSliceMemory,
 	}
 
 	return nil
 }
 
 func (r *WorkerRedisRepository) ScheduleContainerRequest(worker *types.Worker, request *types.ContainerRequest) error {
-	// Serialize the ContainerRequest -> JSON
-	requestJSON, err := json.Marshal(request)
+	// Update the worker capacity first, this also assigns a gpu slice for shared gpu requests
+	err := r.UpdateWorkerCapacity(worker, request, types.RemoveCapacity)
 	if err != nil {
-		return fmt.Errorf("failed to serialize request: %w", err)
+		return err
 	}
 
-	// Update the worker capacity first
-	err = r.UpdateWorkerCapacity(worker, request, types.RemoveCapacity)
+	// Serialize the ContainerRequest -> JSON
+	requestJSON, err := json.Marshal(request)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to serialize request: %w", err)
 	}
 
 	// Push the serialized ContainerRequest
@@ -383,6 +457,88 @@ func (r *WorkerRedisRepository) ScheduleContainerRequest(worker *types.Worker, r
 	return nil
 }
 
+// ScheduleContainerGroup reserves capacity for every request in a group and queues each request on its worker.
+// workers[i] is the worker selected for requests[i]. Either the whole group is scheduled, or none of it is.
+func (r *WorkerRedisRepository) ScheduleContainerGroup(workers []*types.Worker, requests []*types.ContainerRequest) error {
+	if len(workers) != len(requests) {
+		return errors.New("each request in a container group must have a worker")
+	}
+
+	workersById := map[string]*types.Worker{}
+	for _, worker := range workers {
+		workersById[worker.Id] = worker
+	}
+
+	workerIds := make([]string, 0, len(workersById))
+	for workerId := range workersById {
+		workerIds = append(workerIds, workerId)
+	}
+
+	// Lock workers in a consistent order so that concurrent groups can't deadlock each other
+	sort.Strings(workerIds)
+	for _, workerId := range workerIds {
+		err := r.lock.Acquire(context.TODO(), common.RedisKeys.SchedulerWorkerLock(workerId), common.RedisLockOptions{TtlS: 10, Retries: 3})
+		if err != nil {
+			return err
+		}
+		defer r.lock.Release(common.RedisKeys.SchedulerWorkerLock(workerId))
+	}
+
+	updatedWorkers := map[string]*types.Worker{}
+	for _, workerId := range workerIds {
+		key := common.RedisKeys.SchedulerWorkerState(workerId)
+
+		w, err := r.getWorkerFromKey(key)
+		if err != nil {
+			return fmt.Errorf("failed to get worker state <%v>: %v", key, err)
+		}
+
+		if w.ResourceVersion != workersById[workerId].ResourceVersion {
+			return errors.New("invalid worker resource version")
+		}
+
+		updatedWorkers[workerId] = w
+	}
+
+	// Reserve capacity in memory first, so nothing is written unless every request fits
+	requestsJSON := make([][]byte, len(requests))
+	for i, request := range requests {
+		err := applyCapacityUpdate(updatedWorkers[workers[i].Id], request, types.RemoveCapacity)
+		if err != nil {
+			return err
+		}
+
+		requestsJSON[i], err = json.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("failed to serialize request: %w", err)
+		}
+	}
+
+	// Capacity for every member is written in a single transaction, so a failure can't leave part of the group reserved
+	_, err := r.rdb.TxPipelined(context.TODO(), func(pipe redis.Pipeliner) error {
+		for _, workerId := range workerIds {
+			updatedWorker := updatedWorkers[workerId]
+			updatedWorker.ResourceVersion++
+			pipe.HSet(context.TODO(), common.RedisKeys.SchedulerWorkerState(workerId), common.ToSlice(updatedWorker))
+		}
+
+		for i, requestJSON := range requestsJSON {
+			pipe.RPush(context.TODO(), common.RedisKeys.SchedulerWorkerRequests(workers[i].Id), requestJSON)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule container group: %w", err)
+	}
+
+	for i, request := range requests {
+		log.Printf("Request for container %s added to worker: %s\n", request.ContainerId, workers[i].Id)
+	}
+
+	return nil
+}
+
 func (r *WorkerRedisRepository) AddContainerToWorker(workerId string, containerId string) error {
 	containerStateKey := common.RedisKeys.SchedulerContainerState(containerId)
 
@@ -118,6 +118,46 @@ func TestToggleWorkerAvailable(t *testing.T) {
 	assert.Equal(t, types.WorkerStatusAvailable, worker.Status)
 }
 
+func TestDrainWorker(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.NotNil(t, rdb)
+	assert.Nil(t, err)
+
+	repo := NewWorkerRedisRepositoryForTest(rdb)
+
+	newWorker := &types.Worker{
+		Id:         "worker1",
+		Status:     types.WorkerStatusPending,
+		FreeCpu:    1000,
+		FreeMemory: 1000,
+	}
+
+	err = repo.AddWorker(newWorker)
+	assert.Nil(t, err)
+
+	// Pending workers can't be drained
+	err = repo.DrainWorker(newWorker.Id)
+	assert.Error(t, err)
+
+	err = repo.ToggleWorkerAvailable(newWorker.Id)
+	assert.Nil(t, err)
+
+	worker, err := repo.GetWorkerById(newWorker.Id)
+	assert.Nil(t, err)
+
+	err = repo.DrainWorker(worker.Id)
+	assert.Nil(t, err)
+
+	drainedWorker, err := repo.GetWorkerById(worker.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, types.WorkerStatusDraining, drainedWorker.Status)
+	assert.Equal(t, worker.ResourceVersion+1, drainedWorker.ResourceVersion)
+
+	// Requests placed with the worker's state from before it was drained are rejected
+	err = repo.ScheduleContainerRequest(worker, &types.ContainerRequest{ContainerId: "container1", Cpu: 100, Memory: 100})
+	assert.Error(t, err)
+}
+
 func TestUpdateWorkerCapacityForGPUWorker(t *testing.T) {
 	rdb, err := NewRedisClientForTest()
 	assert.NotNil(t, rdb)
@@ -357,3 +397,149 @@ func TestGetId(t *testing.T) {
 	id := repo.GetId()
 	assert.Len(t, id, 8)
 }
+
+func TestScheduleContainerGroup(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.NotNil(t, rdb)
+	assert.Nil(t, err)
+
+	repo := NewWorkerRedisRepositoryForTest(rdb)
+
+	workers := []*types.Worker{
+		{Id: "worker1", Status: types.WorkerStatusAvailable, FreeCpu: 2000, FreeMemory: 2000, Gpu: "A10G", FreeGpuCount: 2},
+		{Id: "worker2", Status: types.WorkerStatusAvailable, FreeCpu: 1000, FreeMemory: 1000, Gpu: "A10G", FreeGpuCount: 1},
+	}
+
+	for _, worker := range workers {
+		err = repo.AddWorker(worker)
+		assert.Nil(t, err)
+	}
+
+	newRequest := func(containerId string) *types.ContainerRequest {
+		return &types.ContainerRequest{ContainerId: containerId, Cpu: 1000, Memory: 1000, Gpu: "A10G", GpuCount: 1}
+	}
+
+	// The last member doesn't fit on worker2, so nothing in the group should be scheduled
+	err = repo.ScheduleContainerGroup(
+		[]*types.Worker{workers[0], workers[1], workers[1]},
+		[]*types.ContainerRequest{newRequest("container1"), newRequest("container2"), newRequest("container3")},
+	)
+	assert.Error(t, err)
+
+	for _, worker := range workers {
+		updatedWorker, err := repo.GetWorkerById(worker.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, worker.FreeCpu, updatedWorker.FreeCpu)
+		assert.Equal(t, worker.FreeGpuCount, updatedWorker.FreeGpuCount)
+		assert.Equal(t, int64(0), updatedWorker.ResourceVersion)
+
+		request, err := repo.GetNextContainerRequest(worker.Id)
+		assert.Nil(t, err)
+		assert.Nil(t, request)
+	}
+
+	// Every member fits, so the whole group is scheduled
+	err = repo.ScheduleContainerGroup(
+		[]*types.Worker{workers[0], workers[0], workers[1]},
+		[]*types.ContainerRequest{newRequest("container1"), newRequest("container2"), newRequest("container3")},
+	)
+	assert.Nil(t, err)
+
+	for _, worker := range workers {
+		updatedWorker, err := repo.GetWorkerById(worker.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), updatedWorker.FreeCpu)
+		assert.Equal(t, uint32(0), updatedWorker.FreeGpuCount)
+		assert.Equal(t, int64(1), updatedWorker.ResourceVersion)
+	}
+
+	request, err := repo.GetNextContainerRequest("worker2")
+	assert.Nil(t, err)
+	assert.Equal(t, "container3", request.ContainerId)
+
+	// Stale resource versions are rejected
+	err = repo.ScheduleContainerGroup([]*types.Worker{workers[0]}, []*types.ContainerRequest{newRequest("container4")})
+	assert.Error(t, err)
+}
+
+func TestScheduleContainerRequestWithGpuSlices(t *testing.T) {
+	rdb, err := NewRedisClientForTest()
+	assert.NotNil(t, rdb)
+	assert.Nil(t, err)
+
+	repo := NewWorkerRedisRepositoryForTest(rdb)
+
+	err = repo.AddWorker(&types.Worker{
+		Id:                 "worker1",
+		Status:             types.WorkerStatusAvailable,
+		FreeCpu:            10000,
+		FreeMemory:         10000,
+		Gpu:                "A100-80",
+		TotalGpuCount:      2,
+		GpuSliceMemory:     10240,
+		GpuSlicesPerDevice: 4,
+		FreeGpuSlices:      "4,4",
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		containerId   string
+		gpuMemory     int64
+		expectErr     bool
+		device        int
+		slices        uint32
+		freeGpuSlices string
+	}{
+		{containerId: "container1", gpuMemory: 15000, device: 0, slices: 2, freeGpuSlices: "2,4"},
+		{containerId: "container2", gpuMemory: 30720, device: 1, slices: 3, freeGpuSlices: "2,1"},
+		{containerId: "container3", gpuMemory: 20000, device: 0, slices: 2, freeGpuSlices: "0,1"},
+		{containerId: "container4", gpuMemory: 20000, expectErr: true, freeGpuSlices: "0,1"},
+	}
+
+	for _, tt := range tests {
+		worker, err := repo.GetWorkerById("worker1")
+		assert.Nil(t, err)
+
+		request := &types.ContainerRequest{ContainerId: tt.containerId, Cpu: 100, Memory: 100, Gpu: "A100-80", GpuCount: 1, GpuMemory: tt.gpuMemory}
+		err = repo.ScheduleContainerRequest(worker, request)
+
+		updatedWorker, getErr := repo.GetWorkerById("worker1")
+		assert.Nil(t, getErr)
+		assert.Equal(t, tt.freeGpuSlices, updatedWorker.FreeGpuSlices)
+
+		if tt.expectErr {
+			assert.Error(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		// The slice assignment is sent to the worker with the request
+		queuedRequest, err := repo.GetNextContainerRequest("worker1")
+		assert.Nil(t, err)
+		assert.NotNil(t, queuedRequest.GpuSlice)
+		assert.Equal(t, tt.device, queuedRequest.GpuSlice.Device)
+		assert.Equal(t, tt.slices, queuedRequest.GpuSlice.Slices)
+		assert.Equal(t, int64(tt.slices)*10240, queuedRequest.GpuSlice.MemoryLimitMiB)
+
+		// Releasing the capacity returns the slices to the same device
+		if tt.containerId == "container1" {
+			err = repo.UpdateWorkerCapacity(updatedWorker, queuedRequest, types.AddCapacity)
+			assert.Nil(t, err)
+
+			updatedWorker, err = repo.GetWorkerById("worker1")
+			assert.Nil(t, err)
+			assert.Equal(t, "4,4", updatedWorker.FreeGpuSlices)
+
+			err = repo.UpdateWorkerCapacity(updatedWorker, queuedRequest, types.RemoveCapacity)
+			assert.Nil(t, err)
+		}
+	}
+
+	// Sharing workers don't accept whole gpu requests
+	worker, err := repo.GetWorkerById("worker1")
+	assert.Nil(t, err)
+
+	err = repo.ScheduleContainerRequest(worker, &types.ContainerRequest{ContainerId: "container5", Cpu: 100, Memory: 100, Gpu: "A100-80", GpuCount: 1})
+	assert.Error(t, err)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"log"
+	"math"
+	"strconv"
 	"sync"
 
 	"github.com/beam-cloud/beta9/pkg/common"
@@ -11,16 +14,21 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+const defaultWorkspaceWeight float64 = 1
+
+// RequestBacklog keeps a sorted set of pending container requests per workspace. Pop picks a workspace
+// with the configured FairShareSelector, then takes that workspace's next request.
 type RequestBacklog struct {
-	rdb *common.RedisClient
-	mu  sync.Mutex
+	rdb      *common.RedisClient
+	mu       sync.Mutex
+	selector FairShareSelector
 }
 
-func NewRequestBacklog(rdb *common.RedisClient) *RequestBacklog {
-	return &RequestBacklog{rdb: rdb}
+func NewRequestBacklog(rdb *common.RedisClient, selector FairShareSelector) *RequestBacklog {
+	return &RequestBacklog{rdb: rdb, selector: selector}
 }
 
-// Pushes a new container request into the sorted set
+// Pushes a new container request into its workspace's sorted set
 func (rb *RequestBacklog) Push(request *types.ContainerRequest) error {
 	rb.mu.Lock()
 	defer rb.mu.Unlock()
@@ -30,17 +38,116 @@ func (rb *RequestBacklog) Push(request *types.ContainerRequest) error {
 		return err
 	}
 
-	// Use the timestamp as the score for sorting
-	timestamp := float64(request.Timestamp.UnixNano())
-	return rb.rdb.ZAdd(context.TODO(), common.RedisKeys.SchedulerContainerRequests(), redis.Z{Score: timestamp, Member: jsonData}).Err()
+	err = rb.rdb.ZAdd(context.TODO(), common.RedisKeys.SchedulerContainerRequests(request.WorkspaceId), redis.Z{Score: backlogScore(request), Member: jsonData}).Err()
+	if err != nil {
+		return err
+	}
+
+	return rb.rdb.SAdd(context.TODO(), common.RedisKeys.SchedulerContainerRequestsIndex(), request.WorkspaceId).Err()
+}
+
+// MigrateLegacyRequests moves requests from the single backlog used by older schedulers into their workspace's
+// backlog, and returns how many were moved
+func (rb *RequestBacklog) MigrateLegacyRequests() (int, error) {
+	ctx := context.TODO()
+	legacyKey := common.RedisKeys.SchedulerLegacyContainerRequests()
+	migrated := 0
+
+	for {
+		result, err := rb.rdb.ZPopMin(ctx, legacyKey, 500).Result()
+		if err != nil {
+			return migrated, err
+		}
+
+		if len(result) == 0 {
+			return migrated, nil
+		}
+
+		for i, member := range result {
+			request := &types.ContainerRequest{}
+			if err := json.Unmarshal([]byte(member.Member.(string)), request); err != nil {
+				log.Printf("Unable to migrate invalid container request: %v\n", err)
+				continue
+			}
+
+			if err := rb.Push(request); err != nil {
+				// Put back what hasn't been moved, so that it can be migrated later
+				rb.rdb.ZAdd(ctx, legacyKey, result[i:]...)
+				return migrated, err
+			}
+
+			migrated++
+		}
+	}
+}
+
+// SetWorkspaceWeight sets a workspace's share of the cluster relative to other workspaces
+func (rb *RequestBacklog) SetWorkspaceWeight(workspaceId string, weight uint32) error {
+	return rb.rdb.HSet(context.TODO(), common.RedisKeys.SchedulerWorkspaceWeights(), workspaceId, weight).Err()
+}
+
+// Each priority level is offset by more than the range of unix timestamps (in milliseconds),
+// so higher priority requests always sort first and requests of equal priority stay FIFO.
+// Scores stay well below 2^53, so they remain exact as float64.
+const backlogPriorityScoreOffset float64 = 1e13
+
+// backlogScore orders requests by priority first, then by the timestamp they were received at
+func backlogScore(request *types.ContainerRequest) float64 {
+	priority := request.Priority
+	if priority < types.ContainerPriorityMin {
+		priority = types.ContainerPriorityMin
+	} else if priority > types.ContainerPriorityMax {
+		priority = types.ContainerPriorityMax
+	}
+
+	// Requests without a timestamp sort first within their priority
+	timestamp := max(request.Timestamp.UnixMilli(), 0)
+
+	return float64(timestamp) - float64(priority)*backlogPriorityScoreOffset
 }
 
-// Pops the oldest container request from the sorted set
+// backlogScorePriority recovers the priority a backlog score was computed with
+func backlogScorePriority(score float64) int32 {
+	return int32(-math.Floor(score / backlogPriorityScoreOffset))
+}
+
+// Pops the next container request. Only workspaces whose next request has the highest pending priority
+// are considered, and the selector decides between them.
 func (rb *RequestBacklog) Pop() (*types.ContainerRequest, error) {
 	rb.mu.Lock()
 	defer rb.mu.Unlock()
 
-	result, err := rb.rdb.ZPopMin(context.TODO(), common.RedisKeys.SchedulerContainerRequests(), 1).Result()
+	workspaces, err := rb.workspaces()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workspaces) == 0 {
+		return nil, errors.New("backlog empty")
+	}
+
+	topPriority := int32(math.MinInt32)
+	for _, workspace := range workspaces {
+		if priority := backlogScorePriority(workspace.HeadScore); priority > topPriority {
+			topPriority = priority
+		}
+	}
+
+	candidates := []BacklogWorkspace{}
+	for _, workspace := range workspaces {
+		if backlogScorePriority(workspace.HeadScore) == topPriority {
+			candidates = append(candidates, workspace)
+		}
+	}
+
+	var selected BacklogWorkspace
+	if rb.selector != nil {
+		selected = rb.selector.Select(candidates)
+	} else {
+		selected = oldestWorkspace(candidates)
+	}
+
+	result, err := rb.rdb.ZPopMin(context.TODO(), common.RedisKeys.SchedulerContainerRequests(selected.WorkspaceId), 1).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -55,13 +162,123 @@ func (rb *RequestBacklog) Pop() (*types.ContainerRequest, error) {
 		return nil, err
 	}
 
+	if rb.selector != nil {
+		rb.selector.Popped(&poppedItem)
+	}
+
 	return &poppedItem, nil
 }
 
-// Gets the length of the sorted set
+// workspaces lists every workspace with pending requests, along with its weight and next request's score.
+// Workspaces with empty queues are removed from the index.
+func (rb *RequestBacklog) workspaces() ([]BacklogWorkspace, error) {
+	ctx := context.TODO()
+	indexKey := common.RedisKeys.SchedulerContainerRequestsIndex()
+
+	workspaceIds, err := rb.rdb.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	weights, err := rb.rdb.HGetAll(ctx, common.RedisKeys.SchedulerWorkspaceWeights()).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make([]*redis.ZSliceCmd, len(workspaceIds))
+	lengths := make([]*redis.IntCmd, len(workspaceIds))
+	_, err = rb.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, workspaceId := range workspaceIds {
+			heads[i] = pipe.ZRangeWithScores(ctx, common.RedisKeys.SchedulerContainerRequests(workspaceId), 0, 0)
+			lengths[i] = pipe.ZCard(ctx, common.RedisKeys.SchedulerContainerRequests(workspaceId))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := []BacklogWorkspace{}
+	for i, workspaceId := range workspaceIds {
+		head := heads[i].Val()
+		if len(head) == 0 {
+			rb.removeEmptyWorkspace(workspaceId)
+			continue
+		}
+
+		weight := defaultWorkspaceWeight
+		if value, ok := weights[workspaceId]; ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil && parsed > 0 {
+				weight = parsed
+			}
+		}
+
+		workspaces = append(workspaces, BacklogWorkspace{
+			WorkspaceId: workspaceId,
+			Weight:      weight,
+			HeadScore:   head[0].Score,
+			Length:      lengths[i].Val(),
+		})
+	}
+
+	return workspaces, nil
+}
+
+// removeEmptyWorkspace drops a workspace from the index, re-adding it if a request was pushed concurrently
+func (rb *RequestBacklog) removeEmptyWorkspace(workspaceId string) {
+	ctx := context.TODO()
+	indexKey := common.RedisKeys.SchedulerContainerRequestsIndex()
+
+	rb.rdb.SRem(ctx, indexKey, workspaceId)
+	if rb.rdb.ZCard(ctx, common.RedisKeys.SchedulerContainerRequests(workspaceId)).Val() > 0 {
+		rb.rdb.SAdd(ctx, indexKey, workspaceId)
+	}
+}
+
+// Gets the total number of pending requests across all workspaces
 func (rb *RequestBacklog) Len() int64 {
 	rb.mu.Lock()
 	defer rb.mu.Unlock()
 
-	return rb.rdb.ZCard(context.TODO(), common.RedisKeys.SchedulerContainerRequests()).Val()
+	ctx := context.TODO()
+	workspaceIds, err := rb.rdb.SMembers(ctx, common.RedisKeys.SchedulerContainerRequestsIndex()).Result()
+	if err != nil || len(workspaceIds) == 0 {
+		return 0
+	}
+
+	lengths := make([]*redis.IntCmd, len(workspaceIds))
+	rb.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, workspaceId := range workspaceIds {
+			lengths[i] = pipe.ZCard(ctx, common.RedisKeys.SchedulerContainerRequests(workspaceId))
+		}
+		return nil
+	})
+
+	total := int64(0)
+	for _, length := range lengths {
+		total += length.Val()
+	}
+
+	return total
+}
+
+// Get returns a workspace's pending request for a container, or nil if the container isn't in the backlog
+func (rb *RequestBacklog) Get(workspaceId string, containerId string) (*types.ContainerRequest, error) {
+	members, err := rb.rdb.ZRange(context.TODO(), common.RedisKeys.SchedulerContainerRequests(workspaceId), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		var request types.ContainerRequest
+		if err := json.Unmarshal([]byte(member), &request); err != nil {
+			continue
+		}
+
+		if request.ContainerId == containerId {
+			return &request, nil
+		}
+	}
+
+	return nil, nil
 }
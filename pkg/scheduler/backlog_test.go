@@ -1,12 +1,17 @@
 package scheduler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/beam-cloud/beta9/pkg/common"
+	repo "github.com/beam-cloud/beta9/pkg/repository"
 	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/redis/go-redis/v9"
 	"github.com/tj/assert"
 )
 
@@ -68,3 +73,149 @@ func TestRequestBacklogOrdering(t *testing.T) {
 		t.Errorf("Expected timestamp %v, got %v", req3.Timestamp.Unix(), poppedReq.Timestamp.Unix())
 	}
 }
+
+func TestRequestBacklogPriorityOrdering(t *testing.T) {
+	s, err := miniredis.Run()
+	assert.NotNil(t, s)
+	assert.NoError(t, err)
+
+	redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+	assert.NotNil(t, redisClient)
+	assert.NoError(t, err)
+
+	rb := NewRequestBacklogForTest(redisClient)
+
+	now := time.Now()
+	requests := []*types.ContainerRequest{
+		{ContainerId: "low-old", Timestamp: now.Add(-time.Hour), Priority: -10},
+		{ContainerId: "default-new", Timestamp: now.Add(time.Second)},
+		{ContainerId: "default-old", Timestamp: now},
+		{ContainerId: "high-new", Timestamp: now.Add(time.Minute), Priority: 50},
+		{ContainerId: "max", Timestamp: now.Add(time.Hour), Priority: types.ContainerPriorityMax + 1000},
+	}
+
+	for _, request := range requests {
+		assert.NoError(t, rb.Push(request))
+	}
+
+	expectedOrder := []string{"max", "high-new", "default-old", "default-new", "low-old"}
+	for _, containerId := range expectedOrder {
+		poppedReq, err := rb.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, containerId, poppedReq.ContainerId)
+	}
+}
+
+func TestRequestBacklogFairShare(t *testing.T) {
+	s, err := miniredis.Run()
+	assert.NotNil(t, s)
+	assert.NoError(t, err)
+
+	redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+	assert.NotNil(t, redisClient)
+	assert.NoError(t, err)
+
+	workerRepo := repo.NewWorkerRedisRepositoryForTest(redisClient)
+	containerRepo := repo.NewContainerRedisRepositoryForTest(redisClient)
+
+	err = workerRepo.AddWorker(&types.Worker{Id: "worker-1", TotalCpu: 10000, TotalMemory: 10000, FreeCpu: 10000, FreeMemory: 10000})
+	assert.NoError(t, err)
+
+	rb := NewRequestBacklog(redisClient, NewDominantResourceFairness(workerRepo, containerRepo, SchedulerMetrics{}))
+
+	// Workspace "busy" enqueues a burst before workspace "quiet" enqueues anything
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		err = rb.Push(&types.ContainerRequest{ContainerId: fmt.Sprintf("busy-%d", i), WorkspaceId: "busy", Cpu: 1000, Memory: 1000, Timestamp: now.Add(time.Duration(i) * time.Millisecond)})
+		assert.NoError(t, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		err = rb.Push(&types.ContainerRequest{ContainerId: fmt.Sprintf("quiet-%d", i), WorkspaceId: "quiet", Cpu: 1000, Memory: 1000, Timestamp: now.Add(time.Second + time.Duration(i)*time.Millisecond)})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(6), rb.Len())
+
+	// Workspaces are interleaved instead of draining the oldest workspace first
+	expectedOrder := []string{"busy-0", "quiet-0", "busy-1", "quiet-1", "busy-2", "busy-3"}
+	for _, containerId := range expectedOrder {
+		request, err := rb.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, containerId, request.ContainerId)
+	}
+
+	assert.Equal(t, int64(0), rb.Len())
+}
+
+func TestRequestBacklogFairShareWeights(t *testing.T) {
+	s, err := miniredis.Run()
+	assert.NotNil(t, s)
+	assert.NoError(t, err)
+
+	redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+	assert.NotNil(t, redisClient)
+	assert.NoError(t, err)
+
+	workerRepo := repo.NewWorkerRedisRepositoryForTest(redisClient)
+	containerRepo := repo.NewContainerRedisRepositoryForTest(redisClient)
+
+	err = workerRepo.AddWorker(&types.Worker{Id: "worker-1", TotalCpu: 10000, TotalMemory: 10000, FreeCpu: 10000, FreeMemory: 10000})
+	assert.NoError(t, err)
+
+	rb := NewRequestBacklog(redisClient, NewDominantResourceFairness(workerRepo, containerRepo, SchedulerMetrics{}))
+	assert.NoError(t, rb.SetWorkspaceWeight("heavy", 2))
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, rb.Push(&types.ContainerRequest{ContainerId: fmt.Sprintf("light-%d", i), WorkspaceId: "light", Cpu: 1000, Memory: 1000, Timestamp: now.Add(time.Duration(i) * time.Millisecond)}))
+		assert.NoError(t, rb.Push(&types.ContainerRequest{ContainerId: fmt.Sprintf("heavy-%d", i), WorkspaceId: "heavy", Cpu: 1000, Memory: 1000, Timestamp: now.Add(time.Second + time.Duration(i)*time.Millisecond)}))
+	}
+
+	// A workspace with twice the weight gets two requests for every one of the other workspace's
+	expectedOrder := []string{"light-0", "heavy-0", "heavy-1", "light-1", "heavy-2", "light-2"}
+	for _, containerId := range expectedOrder {
+		request, err := rb.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, containerId, request.ContainerId)
+	}
+}
+
+func TestRequestBacklogMigrateLegacyRequests(t *testing.T) {
+	s, err := miniredis.Run()
+	assert.NotNil(t, s)
+	assert.NoError(t, err)
+
+	redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+	assert.NotNil(t, redisClient)
+	assert.NoError(t, err)
+
+	rb := NewRequestBacklogForTest(redisClient)
+
+	// Requests queued by an older scheduler in the single backlog
+	legacyKey := common.RedisKeys.SchedulerLegacyContainerRequests()
+	for i, workspaceId := range []string{"workspace-1", "workspace-2", "workspace-1"} {
+		request := &types.ContainerRequest{ContainerId: fmt.Sprintf("container-%d", i), WorkspaceId: workspaceId, Timestamp: time.Unix(int64(i+1), 0)}
+		member, err := json.Marshal(request)
+		assert.NoError(t, err)
+		assert.NoError(t, redisClient.ZAdd(context.TODO(), legacyKey, redis.Z{Score: float64(request.Timestamp.UnixNano()), Member: member}).Err())
+	}
+	assert.NoError(t, redisClient.ZAdd(context.TODO(), legacyKey, redis.Z{Score: 0, Member: "invalid"}).Err())
+
+	migrated, err := rb.MigrateLegacyRequests()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, migrated)
+	assert.Equal(t, int64(3), rb.Len())
+
+	count, err := redisClient.ZCard(context.TODO(), legacyKey).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	containerIds := []string{}
+	for i := 0; i < 3; i++ {
+		request, err := rb.Pop()
+		assert.NoError(t, err)
+		containerIds = append(containerIds, request.ContainerId)
+	}
+	assert.ElementsMatch(t, []string{"container-0", "container-1", "container-2"}, containerIds)
+}
@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+// Explain reports how a container request would be scheduled right now, without reserving anything.
+// It uses the same worker predicates, placement strategy, pool lookup and concurrency limit check as Run.
+func (s *Scheduler) Explain(request *types.ContainerRequest) (*types.SchedulingExplanation, error) {
+	requestCopy := *request
+	request = &requestCopy
+
+	quota, err := s.GetConcurrencyLimit(request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizeRequest(request, quota)
+
+	explanation := &types.SchedulingExplanation{
+		Request: request,
+		Workers: []types.WorkerCandidate{},
+	}
+
+	if quota != nil {
+		containers, err := s.containerRepo.GetActiveContainersByWorkspaceId(request.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		// An existing container is already counted against the limit, so leave it out
+		otherContainers := []types.ContainerState{}
+		for _, container := range containers {
+			if container.ContainerId != request.ContainerId {
+				otherContainers = append(otherContainers, container)
+			}
+		}
+
+		if err := quota.Check(otherContainers, request); err != nil {
+			explanation.ThrottledReason = err.Error()
+		}
+	}
+
+	workers, err := s.workerRepo.GetAllWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(workers, func(i, j int) bool { return workers[i].Id < workers[j].Id })
+
+	candidateWorkers := []*types.Worker{}
+	for _, worker := range workers {
+		rejections := []types.WorkerRejectionReason{}
+		if rejection := eligibilityRejection(worker, request); rejection != "" {
+			rejections = append(rejections, rejection)
+		}
+		rejections = append(rejections, capacityRejections(worker, request)...)
+
+		if len(rejections) == 0 {
+			candidateWorkers = append(candidateWorkers, worker)
+		}
+
+		explanation.Workers = append(explanation.Workers, types.WorkerCandidate{Worker: worker, Rejections: rejections})
+	}
+
+	if len(candidateWorkers) > 0 {
+		s.getPlacementStrategy(request).Rank(request, candidateWorkers)
+		explanation.SelectedWorkerId = candidateWorkers[0].Id
+	}
+
+	// The controller is asked for a new worker when no existing worker fits
+	if workerPool, ok := s.getPool(request); ok {
+		explanation.PoolName = workerPool.Name
+	}
+
+	controller, err := s.getController(request)
+	if err != nil {
+		explanation.ControllerError = err.Error()
+	} else {
+		explanation.ControllerName = controller.Name()
+	}
+
+	return explanation, nil
+}
+
+// ExplainContainer explains an existing container. Pending containers are explained using the request
+// waiting in the backlog; otherwise the request is rebuilt from the container's state.
+func (s *Scheduler) ExplainContainer(containerId string) (*types.SchedulingExplanation, error) {
+	containerState, err := s.containerRepo.GetContainerState(containerId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.requestBacklog.Get(containerState.WorkspaceId, containerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		request = &types.ContainerRequest{
+			ContainerId: containerState.ContainerId,
+			StubId:      containerState.StubId,
+			WorkspaceId: containerState.WorkspaceId,
+			Cpu:         containerState.Cpu,
+			Memory:      containerState.Memory,
+			Gpu:         containerState.Gpu,
+			GpuCount:    containerState.GpuCount,
+			Priority:    containerState.Priority,
+		}
+	}
+
+	return s.Explain(request)
+}
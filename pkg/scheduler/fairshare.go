@@ -0,0 +1,188 @@
+package scheduler
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	repo "github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+const fairShareRefreshInterval time.Duration = 5 * time.Second
+
+// BacklogWorkspace describes a workspace with pending requests in the backlog
+type BacklogWorkspace struct {
+	WorkspaceId string
+	Weight      float64
+	HeadScore   float64 // Score of the workspace's next request
+	Length      int64
+}
+
+// FairShareSelector decides which workspace the backlog pops its next request from
+type FairShareSelector interface {
+	Select(workspaces []BacklogWorkspace) BacklogWorkspace
+	Popped(request *types.ContainerRequest)
+}
+
+// oldestWorkspace returns the workspace with the oldest pending request, which keeps the backlog in global FIFO order
+func oldestWorkspace(workspaces []BacklogWorkspace) BacklogWorkspace {
+	selected := workspaces[0]
+	for _, workspace := range workspaces[1:] {
+		if workspace.HeadScore < selected.HeadScore {
+			selected = workspace
+		}
+	}
+
+	return selected
+}
+
+type fairShareResources struct {
+	Cpu      float64
+	Memory   float64
+	GpuCount float64
+}
+
+func (r *fairShareResources) add(cpu int64, memory int64, gpuCount uint32) {
+	r.Cpu += float64(cpu)
+	r.Memory += float64(memory)
+	r.GpuCount += float64(gpuCount)
+}
+
+// DominantResourceFairness selects the workspace with the smallest weighted dominant share, where a workspace's
+// dominant share is the largest fraction of cluster cpu, memory or gpus its active containers are using.
+// Usage is refreshed periodically, and requests popped in between are counted against their workspace right away
+// so that workspaces interleave even before their containers start.
+type DominantResourceFairness struct {
+	workerRepo       repo.WorkerRepository
+	containerRepo    repo.ContainerRepository
+	schedulerMetrics SchedulerMetrics
+	mu               sync.Mutex
+	capacity         fairShareResources
+	usage            map[string]*fairShareResources
+	refreshedAt      time.Time
+}
+
+func NewDominantResourceFairness(workerRepo repo.WorkerRepository, containerRepo repo.ContainerRepository, schedulerMetrics SchedulerMetrics) *DominantResourceFairness {
+	return &DominantResourceFairness{
+		workerRepo:       workerRepo,
+		containerRepo:    containerRepo,
+		schedulerMetrics: schedulerMetrics,
+		usage:            map[string]*fairShareResources{},
+	}
+}
+
+func (d *DominantResourceFairness) Select(workspaces []BacklogWorkspace) BacklogWorkspace {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if time.Since(d.refreshedAt) > fairShareRefreshInterval {
+		d.refresh(workspaces)
+	}
+
+	selected := workspaces[0]
+	selectedShare := d.dominantShare(selected.WorkspaceId) / selected.Weight
+
+	for _, workspace := range workspaces[1:] {
+		share := d.dominantShare(workspace.WorkspaceId) / workspace.Weight
+		if share < selectedShare || (share == selectedShare && workspace.HeadScore < selected.HeadScore) {
+			selected = workspace
+			selectedShare = share
+		}
+	}
+
+	return selected
+}
+
+func (d *DominantResourceFairness) Popped(request *types.ContainerRequest) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	usage, ok := d.usage[request.WorkspaceId]
+	if !ok {
+		usage = &fairShareResources{}
+		d.usage[request.WorkspaceId] = usage
+	}
+
+	usage.add(request.Cpu, request.Memory, request.GpuCount)
+}
+
+// refresh reloads cluster capacity from the worker repository and the usage of every workspace with pending requests
+func (d *DominantResourceFairness) refresh(workspaces []BacklogWorkspace) {
+	workers, err := d.workerRepo.GetAllWorkers()
+	if err != nil {
+		log.Printf("Unable to refresh fair share capacity: %v\n", err)
+		return
+	}
+
+	capacity := fairShareResources{}
+	for _, worker := range workers {
+		// Workers that don't report their totals only contribute what is free
+		if worker.TotalCpu > 0 {
+			capacity.add(worker.TotalCpu, worker.TotalMemory, worker.TotalGpuCount)
+		} else {
+			capacity.add(worker.FreeCpu, worker.FreeMemory, worker.FreeGpuCount)
+		}
+	}
+
+	usage := map[string]*fairShareResources{}
+	for _, workspace := range workspaces {
+		containers, err := d.containerRepo.GetActiveContainersByWorkspaceId(workspace.WorkspaceId)
+		if err != nil {
+			log.Printf("Unable to refresh fair share usage for workspace <%s>: %v\n", workspace.WorkspaceId, err)
+			continue
+		}
+
+		workspaceUsage := &fairShareResources{}
+		for _, container := range containers {
+			workspaceUsage.add(container.Cpu, container.Memory, container.GpuCount)
+		}
+
+		usage[workspace.WorkspaceId] = workspaceUsage
+	}
+
+	d.capacity = capacity
+	d.usage = usage
+	d.refreshedAt = time.Now()
+
+	for _, workspace := range workspaces {
+		go d.schedulerMetrics.GaugeWorkspaceDominantShare(workspace.WorkspaceId, d.dominantShare(workspace.WorkspaceId))
+		go d.schedulerMetrics.GaugeWorkspaceBacklogLength(workspace.WorkspaceId, workspace.Length)
+	}
+}
+
+// dominantShare returns the largest fraction of any resource a workspace is using. Resources the cluster
+// has no capacity for yet (e.g. every worker is still pending) are measured against total usage instead.
+func (d *DominantResourceFairness) dominantShare(workspaceId string) float64 {
+	usage, ok := d.usage[workspaceId]
+	if !ok {
+		return 0
+	}
+
+	total := fairShareResources{}
+	for _, u := range d.usage {
+		total.Cpu += u.Cpu
+		total.Memory += u.Memory
+		total.GpuCount += u.GpuCount
+	}
+
+	share := 0.0
+	for _, r := range []struct{ used, capacity, total float64 }{
+		{usage.Cpu, d.capacity.Cpu, total.Cpu},
+		{usage.Memory, d.capacity.Memory, total.Memory},
+		{usage.GpuCount, d.capacity.GpuCount, total.GpuCount},
+	} {
+		capacity := r.capacity
+		if capacity <= 0 {
+			capacity = r.total
+		}
+
+		if capacity <= 0 {
+			continue
+		}
+
+		share = max(share, r.used/capacity)
+	}
+
+	return share
+}
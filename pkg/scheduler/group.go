@@ -0,0 +1,220 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/google/uuid"
+)
+
+const groupScheduleAttempts int = 3
+
+// groupWorker is a worker added for a group, along with the controller that added it
+type groupWorker struct {
+	worker     *types.Worker
+	controller WorkerPoolController
+}
+
+// RunGroup gang schedules a group of containers. Every member is reserved capacity and queued on a worker,
+// or none of them are. Members that don't fit on an existing worker get a new worker from their pool.
+func (s *Scheduler) RunGroup(group *types.ContainerGroupRequest) error {
+	if len(group.Requests) == 0 {
+		return errors.New("container group has no requests")
+	}
+
+	if group.GroupId == "" {
+		group.GroupId = uuid.New().String()
+	}
+
+	log.Printf("Received RUN GROUP request <%s> with %d containers\n", group.GroupId, len(group.Requests))
+
+	peers := make([]string, len(group.Requests))
+	for rank, request := range group.Requests {
+		peers[rank] = request.ContainerId
+	}
+
+	timestamp := time.Now()
+	for rank, request := range group.Requests {
+		request.Timestamp = timestamp
+		request.GroupId = group.GroupId
+		request.GroupRank = rank
+		request.GroupPeers = peers
+		request.Env = append(request.Env,
+			fmt.Sprintf("GROUP_ID=%s", group.GroupId),
+			fmt.Sprintf("GROUP_RANK=%d", rank),
+			fmt.Sprintf("GROUP_SIZE=%d", len(peers)),
+			fmt.Sprintf("GROUP_PEERS=%s", strings.Join(peers, ",")),
+		)
+	}
+
+	// Release container state for any members already registered if the group can't be scheduled,
+	// along with any workers that were added for it
+	registered := []*types.ContainerRequest{}
+	addedWorkers := map[string]*groupWorker{}
+	rollback := func() {
+		for _, request := range registered {
+			s.containerRepo.DeleteContainerState(request)
+		}
+
+		s.removeGroupWorkers(group, addedWorkers, nil)
+	}
+
+	for _, request := range group.Requests {
+		containerState, err := s.containerRepo.GetContainerState(request.ContainerId)
+		if err == nil {
+			switch types.ContainerStatus(containerState.Status) {
+			case types.ContainerStatusPending, types.ContainerStatusRunning:
+				rollback()
+				return &types.ContainerAlreadyScheduledError{Msg: "a container with this id is already running or pending"}
+			default:
+				// Do nothing
+			}
+		}
+
+		go s.schedulerMetrics.CounterIncContainerRequested(request)
+		go s.eventRepo.PushContainerRequestedEvent(request)
+
+		quota, err := s.GetConcurrencyLimit(request.WorkspaceId)
+		if err != nil {
+			rollback()
+			return err
+		}
+
+		normalizeRequest(request, quota)
+
+		err = s.containerRepo.SetContainerStateWithConcurrencyLimit(quota, request)
+		if err != nil {
+			rollback()
+			return err
+		}
+
+		registered = append(registered, request)
+	}
+
+	var err error = nil
+	for attempt := 1; attempt <= groupScheduleAttempts; attempt++ {
+		var workers []*types.Worker
+		workers, err = s.placeGroup(group, addedWorkers)
+		if err != nil {
+			break
+		}
+
+		// Worker capacity may have changed since placement, in which case we place the group again
+		err = s.workerRepo.ScheduleContainerGroup(workers, group.Requests)
+		if err != nil {
+			log.Printf("Unable to schedule group <%s> (attempt %d): %v\n", group.GroupId, attempt, err)
+			continue
+		}
+
+		for i, request := range group.Requests {
+			go s.schedulerMetrics.CounterIncContainerScheduled(request)
+			go s.eventRepo.PushContainerScheduledEvent(request.ContainerId, workers[i].Id, request)
+		}
+
+		// Workers added on an earlier attempt may have gone unused once the group was placed again
+		s.removeGroupWorkers(group, addedWorkers, workers)
+		return nil
+	}
+
+	log.Printf("Giving up on group <%s>: %v\n", group.GroupId, err)
+	rollback()
+	return err
+}
+
+// placeGroup picks a worker for every member of a group. Capacity used by earlier members is tracked
+// on copies of the workers, so that members don't double book a worker before anything is reserved.
+// Workers added for the group are recorded in addedWorkers, and preferred when the group is placed again.
+func (s *Scheduler) placeGroup(group *types.ContainerGroupRequest, addedWorkers map[string]*groupWorker) ([]*types.Worker, error) {
+	workers, err := s.workerRepo.GetAllWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	availableWorkers := make([]*types.Worker, 0, len(workers))
+	for _, worker := range workers {
+		workerCopy := *worker
+		availableWorkers = append(availableWorkers, &workerCopy)
+	}
+
+	placements := make([]*types.Worker, len(group.Requests))
+	for i, request := range group.Requests {
+		candidateWorkers := []*types.Worker{}
+		for _, worker := range availableWorkers {
+			if workerIsEligible(worker, request) && workerFitsRequest(worker, request) {
+				candidateWorkers = append(candidateWorkers, worker)
+			}
+		}
+
+		var worker *types.Worker = nil
+		if len(candidateWorkers) > 0 {
+			s.getPlacementStrategy(request).Rank(request, candidateWorkers)
+			worker = candidateWorkers[0]
+
+			// Use a worker added on an earlier attempt rather than leave it idle
+			for _, candidate := range candidateWorkers {
+				if _, ok := addedWorkers[candidate.Id]; ok {
+					worker = candidate
+					break
+				}
+			}
+		} else {
+			controller, err := s.getController(request)
+			if err != nil {
+				return nil, err
+			}
+
+			newWorker, err := controller.AddWorker(request.Cpu, request.Memory, request.Gpu, request.GpuCount)
+			if err != nil {
+				return nil, err
+			}
+
+			log.Printf("Added new worker <%s> for container %s in group <%s>\n", newWorker.Id, request.ContainerId, group.GroupId)
+			addedWorkers[newWorker.Id] = &groupWorker{worker: newWorker, controller: controller}
+
+			workerCopy := *newWorker
+			worker = &workerCopy
+			availableWorkers = append(availableWorkers, worker)
+		}
+
+		worker.FreeCpu -= request.Cpu
+		worker.FreeMemory -= request.Memory
+		if request.SharesGpu() {
+			worker.ReserveGpuSlices(worker.GpuSlicesNeeded(request))
+		} else if request.Gpu != "" {
+			worker.FreeGpuCount -= request.GpuCount
+		}
+
+		placements[i] = worker
+	}
+
+	return placements, nil
+}
+
+// removeGroupWorkers removes workers added for a group that didn't end up with a member on them, through the
+// controller that added them so the job (and machine) backing the worker is released too. Workers that
+// have since been given other containers are left alone.
+func (s *Scheduler) removeGroupWorkers(group *types.ContainerGroupRequest, addedWorkers map[string]*groupWorker, placements []*types.Worker) {
+	for _, worker := range placements {
+		delete(addedWorkers, worker.Id)
+	}
+
+	for workerId, addedWorker := range addedWorkers {
+		worker, err := s.workerRepo.GetWorkerById(workerId)
+		if err != nil || worker.FreeCpu != addedWorker.worker.FreeCpu || worker.FreeMemory != addedWorker.worker.FreeMemory || worker.FreeGpuCount != addedWorker.worker.FreeGpuCount {
+			continue
+		}
+
+		err = addedWorker.controller.RemoveWorker(worker)
+		if err != nil {
+			log.Printf("Unable to remove worker <%s> added for group <%s>: %v\n", workerId, group.GroupId, err)
+			continue
+		}
+
+		log.Printf("Removed unused worker <%s> added for group <%s>\n", workerId, group.GroupId)
+		delete(addedWorkers, workerId)
+	}
+}
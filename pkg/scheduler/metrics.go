@@ -40,3 +40,23 @@ func (sm *SchedulerMetrics) CounterIncContainerRequested(request *types.Containe
 		"gpu":          request.Gpu,
 	}, 1.0)
 }
+
+func (sm *SchedulerMetrics) GaugeWorkspaceDominantShare(workspaceId string, share float64) {
+	if sm.metricsRepo == nil {
+		return
+	}
+
+	sm.metricsRepo.SetGauge(types.MetricsSchedulerWorkspaceShare, map[string]interface{}{
+		"workspace_id": workspaceId,
+	}, share)
+}
+
+func (sm *SchedulerMetrics) GaugeWorkspaceBacklogLength(workspaceId string, length int64) {
+	if sm.metricsRepo == nil {
+		return
+	}
+
+	sm.metricsRepo.SetGauge(types.MetricsSchedulerWorkspaceBacklog, map[string]interface{}{
+		"workspace_id": workspaceId,
+	}, float64(length))
+}
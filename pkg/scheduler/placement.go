@@ -0,0 +1,149 @@
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+// PlacementStrategy decides which of the workers that can fit a request should be preferred.
+// Candidates passed to Rank have already been filtered by pool selector and free capacity.
+type PlacementStrategy interface {
+	Name() types.PlacementStrategyType
+	Rank(request *types.ContainerRequest, workers []*types.Worker)
+}
+
+var placementStrategies = map[types.PlacementStrategyType]PlacementStrategy{
+	types.PlacementStrategyDefault:            &firstFitStrategy{},
+	types.PlacementStrategyBinpack:            &binpackStrategy{},
+	types.PlacementStrategySpread:             &spreadStrategy{},
+	types.PlacementStrategyLeastFragmentation: &leastFragmentationStrategy{},
+}
+
+// GetPlacementStrategy returns the strategy registered under the given name.
+// Unknown names fall back to the default first-fit strategy.
+func GetPlacementStrategy(name types.PlacementStrategyType) (PlacementStrategy, bool) {
+	strategy, ok := placementStrategies[name]
+	if !ok {
+		return placementStrategies[types.PlacementStrategyDefault], false
+	}
+
+	return strategy, true
+}
+
+// firstFitStrategy keeps available workers ahead of pending ones and otherwise preserves the order
+// returned by the worker repository.
+type firstFitStrategy struct{}
+
+func (s *firstFitStrategy) Name() types.PlacementStrategyType {
+	return types.PlacementStrategyDefault
+}
+
+func (s *firstFitStrategy) Rank(request *types.ContainerRequest, workers []*types.Worker) {
+	sort.SliceStable(workers, func(i, j int) bool {
+		return workers[i].Status < workers[j].Status
+	})
+}
+
+// binpackStrategy prefers the most allocated workers so that emptier workers can drain and shut down.
+type binpackStrategy struct{}
+
+func (s *binpackStrategy) Name() types.PlacementStrategyType {
+	return types.PlacementStrategyBinpack
+}
+
+func (s *binpackStrategy) Rank(request *types.ContainerRequest, workers []*types.Worker) {
+	sort.SliceStable(workers, func(i, j int) bool {
+		if workers[i].Status != workers[j].Status {
+			return workers[i].Status < workers[j].Status
+		}
+
+		ui, uj := workerUtilization(workers[i]), workerUtilization(workers[j])
+		if ui != uj {
+			return ui > uj
+		}
+
+		return workers[i].FreeCpu < workers[j].FreeCpu
+	})
+}
+
+// spreadStrategy prefers the least allocated workers to reduce noisy neighbours.
+type spreadStrategy struct{}
+
+func (s *spreadStrategy) Name() types.PlacementStrategyType {
+	return types.PlacementStrategySpread
+}
+
+func (s *spreadStrategy) Rank(request *types.ContainerRequest, workers []*types.Worker) {
+	sort.SliceStable(workers, func(i, j int) bool {
+		if workers[i].Status != workers[j].Status {
+			return workers[i].Status < workers[j].Status
+		}
+
+		ui, uj := workerUtilization(workers[i]), workerUtilization(workers[j])
+		if ui != uj {
+			return ui < uj
+		}
+
+		return workers[i].FreeCpu > workers[j].FreeCpu
+	})
+}
+
+// leastFragmentationStrategy prefers the workers that would have the fewest GPUs left over after
+// placing the request, so that large multi-GPU requests can still find a whole worker.
+// Requests without GPUs are binpacked.
+type leastFragmentationStrategy struct{}
+
+func (s *leastFragmentationStrategy) Name() types.PlacementStrategyType {
+	return types.PlacementStrategyLeastFragmentation
+}
+
+func (s *leastFragmentationStrategy) Rank(request *types.ContainerRequest, workers []*types.Worker) {
+	(&binpackStrategy{}).Rank(request, workers)
+
+	if request.Gpu == "" {
+		return
+	}
+
+	sort.SliceStable(workers, func(i, j int) bool {
+		if workers[i].Status != workers[j].Status {
+			return workers[i].Status < workers[j].Status
+		}
+
+		li := workers[i].FreeGpuCount - request.GpuCount
+		lj := workers[j].FreeGpuCount - request.GpuCount
+		if li != lj {
+			return li < lj
+		}
+
+		return workers[i].TotalGpuCount < workers[j].TotalGpuCount
+	})
+}
+
+// workerUtilization returns the average allocated fraction of a worker's cpu, memory and gpus.
+// Workers that don't report their totals are treated as empty.
+func workerUtilization(worker *types.Worker) float64 {
+	fractions := []float64{
+		allocatedFraction(worker.FreeCpu, worker.TotalCpu),
+		allocatedFraction(worker.FreeMemory, worker.TotalMemory),
+	}
+
+	if worker.TotalGpuCount > 0 {
+		fractions = append(fractions, allocatedFraction(int64(worker.FreeGpuCount), int64(worker.TotalGpuCount)))
+	}
+
+	total := 0.0
+	for _, f := range fractions {
+		total += f
+	}
+
+	return total / float64(len(fractions))
+}
+
+func allocatedFraction(free, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(total-free) / float64(total)
+}
@@ -1,6 +1,8 @@
 package scheduler
 
 import (
+	"log"
+
 	"github.com/beam-cloud/beta9/pkg/repository"
 	"github.com/beam-cloud/beta9/pkg/types"
 	"github.com/google/uuid"
@@ -26,6 +28,7 @@ const (
 type WorkerPoolController interface {
 	AddWorker(cpu int64, memory int64, gpuType string, gpuCount uint32) (*types.Worker, error)
 	AddWorkerToMachine(cpu int64, memory int64, gpuType string, gpuCount uint32, machineId string) (*types.Worker, error)
+	RemoveWorker(worker *types.Worker) error
 	Name() string
 	FreeCapacity() (*WorkerPoolCapacity, error)
 }
@@ -71,13 +74,65 @@ func freePoolCapacity(workerRepo repository.WorkerRepository, wpc WorkerPoolCont
 	}
 
 	for _, worker := range workers {
-		capacity.FreeCpu += worker.FreeCpu
-		capacity.FreeMemory += worker.FreeMemory
-
-		if worker.Gpu != "" && (worker.FreeCpu > 0 && worker.FreeMemory > 0) {
-			capacity.FreeGpu += uint(worker.FreeGpuCount)
+		// Draining workers are being retired, so their free capacity can't be used
+		if worker.Status == types.WorkerStatusDraining {
+			continue
 		}
+
+		workerCapacity := workerFreeCapacity(worker)
+		capacity.FreeCpu += workerCapacity.FreeCpu
+		capacity.FreeMemory += workerCapacity.FreeMemory
+		capacity.FreeGpu += workerCapacity.FreeGpu
 	}
 
 	return capacity, nil
 }
+
+// workerFreeCapacity returns what a worker contributes to its pool's free capacity
+func workerFreeCapacity(worker *types.Worker) WorkerPoolCapacity {
+	capacity := WorkerPoolCapacity{
+		FreeCpu:    worker.FreeCpu,
+		FreeMemory: worker.FreeMemory,
+	}
+
+	// Free gpus only count if there is cpu and memory left to use them with
+	if worker.Gpu != "" && (worker.FreeCpu > 0 && worker.FreeMemory > 0) {
+		capacity.FreeGpu = uint(worker.FreeGpuCount)
+
+		// On sharing workers, a gpu is only free if none of its slices are in use
+		if worker.SharesGpus() {
+			capacity.FreeGpu = 0
+			for _, free := range worker.FreeGpuSlicesPerDevice() {
+				if free == worker.GpuSlicesPerDevice {
+					capacity.FreeGpu++
+				}
+			}
+		}
+	}
+
+	return capacity
+}
+
+// applyGpuSharing advertises a new worker's gpus as slices if its pool shares gpus. Sharing workers
+// have no free whole gpus, but keep their total gpu count so machines aren't overcommitted.
+func applyGpuSharing(worker *types.Worker, config types.WorkerPoolGPUSharingConfig) {
+	if !config.Enabled || worker.TotalGpuCount == 0 {
+		return
+	}
+
+	sliceMemory, err := ParseMemory(config.SliceMemory)
+	if err != nil || sliceMemory <= 0 || config.SlicesPerGPU == 0 {
+		log.Printf("Invalid gpu sharing config for worker <%s>, gpus will not be shared: %+v\n", worker.Id, config)
+		return
+	}
+
+	freeSlices := make([]uint32, worker.TotalGpuCount)
+	for i := range freeSlices {
+		freeSlices[i] = config.SlicesPerGPU
+	}
+
+	worker.GpuSliceMemory = sliceMemory
+	worker.GpuSlicesPerDevice = config.SlicesPerGPU
+	worker.SetFreeGpuSlicesPerDevice(freeSlices)
+	worker.FreeGpuCount = 0
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/beam-cloud/beta9/pkg/types"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -168,6 +169,30 @@ func (wpc *ExternalWorkerPoolController) AddWorkerToMachine(cpu int64, memory in
 	return worker, nil
 }
 
+// RemoveWorker deletes a worker's job from its machine along with its state. A machine left without
+// workers is terminated by the provider once its consolidation period passes.
+func (wpc *ExternalWorkerPoolController) RemoveWorker(worker *types.Worker) error {
+	machine, err := wpc.providerRepo.GetMachine(wpc.provider.GetName(), wpc.name, worker.MachineId)
+	if err != nil {
+		return err
+	}
+
+	client, err := wpc.getProxiedClient(machine.State.HostName, machine.State.Token)
+	if err != nil {
+		return err
+	}
+
+	jobName := fmt.Sprintf("%s-%s-%s", Beta9WorkerJobPrefix, wpc.name, worker.Id)
+	err = client.BatchV1().Jobs(externalWorkerNamespace).Delete(wpc.ctx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return wpc.workerRepo.RemoveWorker(worker)
+}
+
 func (wpc *ExternalWorkerPoolController) attemptToAssignWorkerToMachine(workerId string, cpu int64, memory int64, gpuType string, gpuCount uint32, machine *types.ProviderMachine) (*types.Worker, error) {
 	err := wpc.providerRepo.SetMachineLock(wpc.provider.GetName(), wpc.name, machine.State.MachineId)
 	if err != nil {
@@ -232,6 +257,7 @@ func (wpc *ExternalWorkerPoolController) createWorkerOnMachine(workerId, machine
 	worker.PoolName = wpc.name
 	worker.MachineId = machineId
 	worker.RequiresPoolSelector = wpc.workerPool.RequiresPoolSelector
+	applyGpuSharing(worker, wpc.workerPool.GPUSharing)
 
 	// Create the job in the cluster
 	_, err = client.BatchV1().Jobs(externalWorkerNamespace).Create(wpc.ctx, job, metav1.CreateOptions{})
@@ -14,6 +14,7 @@ import (
 	"github.com/beam-cloud/beta9/pkg/types"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -81,6 +82,20 @@ func (wpc *LocalKubernetesWorkerPoolController) AddWorkerToMachine(cpu int64, me
 	return nil, errors.New("unimplemented")
 }
 
+// RemoveWorker deletes a worker's job from the cluster along with its state
+func (wpc *LocalKubernetesWorkerPoolController) RemoveWorker(worker *types.Worker) error {
+	jobName := fmt.Sprintf("%s-%s-%s", Beta9WorkerJobPrefix, wpc.name, worker.Id)
+
+	err := wpc.kubeClient.BatchV1().Jobs(wpc.config.Worker.Namespace).Delete(wpc.ctx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return wpc.workerRepo.RemoveWorker(worker)
+}
+
 func (wpc *LocalKubernetesWorkerPoolController) addWorkerWithId(workerId string, cpu int64, memory int64, gpuType string, gpuCount uint32) (*types.Worker, error) {
 	// Create a new worker job
 	job, worker := wpc.createWorkerJob(workerId, cpu, memory, gpuType, gpuCount)
@@ -92,6 +107,7 @@ func (wpc *LocalKubernetesWorkerPoolController) addWorkerWithId(workerId string,
 
 	worker.PoolName = wpc.name
 	worker.RequiresPoolSelector = wpc.workerPool.RequiresPoolSelector
+	applyGpuSharing(worker, wpc.workerPool.GPUSharing)
 
 	// Add the worker state
 	if err := wpc.workerRepo.AddWorker(worker); err != nil {
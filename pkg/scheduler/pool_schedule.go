@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/robfig/cron/v3"
+)
+
+// sizingSchedule is a parsed types.WorkerPoolSizingSchedule
+type sizingSchedule struct {
+	name          string
+	schedule      cron.Schedule
+	duration      time.Duration
+	location      *time.Location
+	minFreeCpu    int64
+	minFreeMemory int64
+	minFreeGpu    uint
+}
+
+// parseSizingSchedule parses a sizing schedule. Unlike the rest of the pool sizing config, a schedule
+// with an invalid cron expression, duration or timezone is rejected rather than defaulted.
+func parseSizingSchedule(config types.WorkerPoolSizingSchedule) (*sizingSchedule, error) {
+	schedule, err := cron.ParseStandard(config.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression <%s>: %v", config.Cron, err)
+	}
+
+	duration, err := time.ParseDuration(config.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration <%s>: %v", config.Duration, err)
+	}
+
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	// time.LoadLocation treats an empty timezone as UTC
+	location, err := time.LoadLocation(config.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone <%s>: %v", config.Timezone, err)
+	}
+
+	s := &sizingSchedule{
+		name:     config.Name,
+		schedule: schedule,
+		duration: duration,
+		location: location,
+	}
+
+	if minFreeCpu, err := ParseCPU(config.MinFreeCPU); err == nil {
+		s.minFreeCpu = minFreeCpu
+	}
+
+	if minFreeMemory, err := ParseMemory(config.MinFreeMemory); err == nil {
+		s.minFreeMemory = minFreeMemory
+	}
+
+	if minFreeGpu, err := ParseGPU(config.MinFreeGPU); err == nil {
+		s.minFreeGpu = minFreeGpu
+	}
+
+	return s, nil
+}
+
+// parseSizingSchedules parses every valid sizing schedule, and returns the errors for the rest
+func parseSizingSchedules(configs []types.WorkerPoolSizingSchedule) ([]*sizingSchedule, []error) {
+	schedules := []*sizingSchedule{}
+	errs := []error{}
+
+	for _, config := range configs {
+		schedule, err := parseSizingSchedule(config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("schedule <%s>: %v", config.Name, err))
+			continue
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, errs
+}
+
+// isActive checks if a window of the schedule is open at the given time
+func (s *sizingSchedule) isActive(now time.Time) bool {
+	// The most recent window is open if it started within the last duration. Next returns
+	// the zero time for expressions that never match (e.g. February 30th).
+	start := s.schedule.Next(now.Add(-s.duration).In(s.location))
+	return !start.IsZero() && !start.After(now)
+}
+
+// applySizingSchedules returns a copy of the sizing config with the minimums of every active schedule applied.
+// Schedules only ever raise the minimums, so overlapping windows use the largest value for each resource.
+func applySizingSchedules(config *types.WorkerPoolSizingConfig, schedules []*sizingSchedule, now time.Time) (*types.WorkerPoolSizingConfig, []string) {
+	configCopy := *config
+	active := []string{}
+
+	for _, schedule := range schedules {
+		if !schedule.isActive(now) {
+			continue
+		}
+
+		configCopy.MinFreeCpu = max(configCopy.MinFreeCpu, schedule.minFreeCpu)
+		configCopy.MinFreeMemory = max(configCopy.MinFreeMemory, schedule.minFreeMemory)
+		configCopy.MinFreeGpu = max(configCopy.MinFreeGpu, schedule.minFreeGpu)
+		active = append(active, schedule.name)
+	}
+
+	return &configCopy, active
+}
+
+// ActiveSizingSchedules returns the names of a pool's sizing schedules whose windows are open at the given time
+func ActiveSizingSchedules(config types.WorkerPoolJobSpecPoolSizingConfig, now time.Time) []string {
+	schedules, _ := parseSizingSchedules(config.Schedules)
+
+	active := []string{}
+	for _, schedule := range schedules {
+		if schedule.isActive(now) {
+			active = append(active, schedule.name)
+		}
+	}
+
+	return active
+}
@@ -0,0 +1,133 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSizingSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    types.WorkerPoolSizingSchedule
+		expectErr bool
+	}{
+		{
+			name:   "should parse a valid schedule",
+			config: types.WorkerPoolSizingSchedule{Name: "nightly", Cron: "0 2 * * *", Duration: "2h", Timezone: "Europe/Berlin"},
+		},
+		{
+			name:   "should default to utc",
+			config: types.WorkerPoolSizingSchedule{Name: "nightly", Cron: "@daily", Duration: "1h"},
+		},
+		{
+			name:      "should reject an invalid cron expression",
+			config:    types.WorkerPoolSizingSchedule{Name: "nightly", Cron: "every night", Duration: "1h"},
+			expectErr: true,
+		},
+		{
+			name:      "should reject a missing duration",
+			config:    types.WorkerPoolSizingSchedule{Name: "nightly", Cron: "0 2 * * *"},
+			expectErr: true,
+		},
+		{
+			name:      "should reject an unknown timezone",
+			config:    types.WorkerPoolSizingSchedule{Name: "nightly", Cron: "0 2 * * *", Duration: "1h", Timezone: "Mars/Olympus"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSizingSchedule(tt.config)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplySizingSchedules(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	schedules, errs := parseSizingSchedules([]types.WorkerPoolSizingSchedule{
+		{Name: "batch", Cron: "0 2 * * *", Duration: "2h", MinFreeCPU: "32000m", MinFreeGPU: "4"},
+		{Name: "business-hours", Cron: "0 9 * * 1-5", Duration: "9h", Timezone: "America/New_York", MinFreeCPU: "16000m", MinFreeMemory: "64Gi"},
+		{Name: "broken", Cron: "0 9 * * 1-5"},
+	})
+	assert.Len(t, errs, 1)
+	assert.Len(t, schedules, 2)
+
+	base := &types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MinFreeMemory: 1024, MinFreeGpu: 1}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		active   []string
+		expected types.WorkerPoolSizingConfig
+	}{
+		{
+			name:     "should use the base config outside every window",
+			now:      time.Date(2024, 6, 4, 5, 0, 0, 0, time.UTC),
+			active:   []string{},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MinFreeMemory: 1024, MinFreeGpu: 1},
+		},
+		{
+			name:     "should raise the minimums during a window",
+			now:      time.Date(2024, 6, 4, 2, 30, 0, 0, time.UTC),
+			active:   []string{"batch"},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 32000, MinFreeMemory: 1024, MinFreeGpu: 4},
+		},
+		{
+			name:     "should close a window once its duration has passed",
+			now:      time.Date(2024, 6, 4, 4, 0, 0, 0, time.UTC),
+			active:   []string{},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MinFreeMemory: 1024, MinFreeGpu: 1},
+		},
+		{
+			name:     "should evaluate windows in their timezone",
+			now:      time.Date(2024, 6, 4, 10, 0, 0, 0, newYork),
+			active:   []string{"business-hours"},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 16000, MinFreeMemory: 65536, MinFreeGpu: 1},
+		},
+		{
+			name:     "should not open weekday windows on weekends",
+			now:      time.Date(2024, 6, 8, 10, 0, 0, 0, newYork),
+			active:   []string{},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MinFreeMemory: 1024, MinFreeGpu: 1},
+		},
+		{
+			name:     "should not carry a window into the next day",
+			now:      time.Date(2024, 6, 5, 1, 30, 0, 0, newYork), // Tuesday's window closed at 18:00
+			active:   []string{},
+			expected: types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MinFreeMemory: 1024, MinFreeGpu: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, active := applySizingSchedules(base, schedules, tt.now)
+			assert.Equal(t, tt.active, active)
+			assert.Equal(t, tt.expected, *config)
+		})
+	}
+
+	// The base config is never modified
+	assert.Equal(t, int64(1000), base.MinFreeCpu)
+}
+
+func TestActiveSizingSchedules(t *testing.T) {
+	config := types.WorkerPoolJobSpecPoolSizingConfig{
+		Schedules: []types.WorkerPoolSizingSchedule{
+			{Name: "always", Cron: "* * * * *", Duration: "2m"},
+			{Name: "never", Cron: "0 0 30 2 *", Duration: "1h"},
+		},
+	}
+
+	assert.Equal(t, []string{"always"}, ActiveSizingSchedules(config, time.Now()))
+}
@@ -2,6 +2,9 @@ package scheduler
 
 import (
 	"log"
+	"slices"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/beam-cloud/beta9/pkg/repository"
@@ -9,13 +12,18 @@ import (
 )
 
 const poolMonitoringInterval = 1 * time.Second
+const defaultScaleDownCooldown = 5 * time.Minute
 
 type WorkerPoolSizer struct {
 	controller             WorkerPoolController
 	workerRepo             repository.WorkerRepository
 	providerRepo           repository.ProviderRepository
 	workerPoolConfig       *types.WorkerPoolConfig
-	workerPoolSizingConfig *types.WorkerPoolSizingConfig
+	workerPoolSizingConfig *types.WorkerPoolSizingConfig // Sizing config in effect, including active schedules
+	baseSizingConfig       *types.WorkerPoolSizingConfig
+	sizingSchedules        []*sizingSchedule
+	activeSchedules        []string
+	lastScaledAt           time.Time
 }
 
 func NewWorkerPoolSizer(controller WorkerPoolController,
@@ -27,21 +35,42 @@ func NewWorkerPoolSizer(controller WorkerPoolController,
 		return nil, err
 	}
 
+	sizingSchedules, errs := parseSizingSchedules(workerPoolConfig.PoolSizing.Schedules)
+	for _, err := range errs {
+		log.Printf("<pool %s> Ignoring invalid sizing schedule: %v\n", controller.Name(), err)
+	}
+
 	return &WorkerPoolSizer{
 		controller:             controller,
 		workerPoolConfig:       workerPoolConfig,
 		workerPoolSizingConfig: poolSizingConfig,
+		baseSizingConfig:       poolSizingConfig,
+		sizingSchedules:        sizingSchedules,
+		activeSchedules:        []string{},
 		workerRepo:             workerRepo,
 		providerRepo:           providerRepo,
 	}, nil
 }
 
+// applySizingSchedules updates the sizing config in effect with the schedules active at the given time
+func (s *WorkerPoolSizer) applySizingSchedules(now time.Time) {
+	config, active := applySizingSchedules(s.baseSizingConfig, s.sizingSchedules, now)
+	if !slices.Equal(active, s.activeSchedules) {
+		log.Printf("<pool %s> Active sizing schedules changed from %v to %v\n", s.controller.Name(), s.activeSchedules, active)
+	}
+
+	s.workerPoolSizingConfig = config
+	s.activeSchedules = active
+}
+
 func (s *WorkerPoolSizer) Start() {
 	ticker := time.NewTicker(poolMonitoringInterval)
 	defer ticker.Stop()
 
 	for range ticker.C {
 		func() {
+			s.applySizingSchedules(time.Now())
+
 			freeCapacity, err := s.controller.FreeCapacity()
 			if err != nil {
 				log.Printf("<pool %s> Error getting free capacity: %v\n", s.controller.Name(), err)
@@ -54,6 +83,14 @@ func (s *WorkerPoolSizer) Start() {
 				log.Printf("<pool %s> Error adding new worker: %v\n", s.controller.Name(), err)
 			} else if newWorker != nil {
 				log.Printf("<pool %s> Added new worker to maintain pool size: %+v\n", s.controller.Name(), newWorker)
+			} else {
+				// Handle case where bursts left the pool with more free capacity than it needs
+				drainedWorker, err := s.drainWorkerIfNeeded(freeCapacity)
+				if err != nil {
+					log.Printf("<pool %s> Error draining worker: %v\n", s.controller.Name(), err)
+				} else if drainedWorker != nil {
+					log.Printf("<pool %s> Draining worker to reduce pool size: %+v\n", s.controller.Name(), drainedWorker)
+				}
 			}
 
 			// Handle case where we want to make sure all available manually provisioned nodes have available workers
@@ -101,6 +138,19 @@ func (s *WorkerPoolSizer) addWorkerIfNeeded(freeCapacity *WorkerPoolCapacity) (*
 
 	// Check if the free capacity is below the configured minimum and add a worker if needed
 	if shouldAddWorker(freeCapacity, s.workerPoolSizingConfig) {
+		// Don't grow the pool past its configured size
+		if s.workerPoolSizingConfig.MaxWorkers > 0 {
+			var workers []*types.Worker
+			workers, err = s.activeWorkers()
+			if err != nil {
+				return nil, err
+			}
+
+			if len(workers) >= s.workerPoolSizingConfig.MaxWorkers {
+				return nil, nil
+			}
+		}
+
 		newWorker, err = s.controller.AddWorker(
 			s.workerPoolSizingConfig.DefaultWorkerCpu,
 			s.workerPoolSizingConfig.DefaultWorkerMemory,
@@ -111,11 +161,117 @@ func (s *WorkerPoolSizer) addWorkerIfNeeded(freeCapacity *WorkerPoolCapacity) (*
 			return nil, err
 		}
 
+		s.lastScaledAt = time.Now()
 	}
 
 	return newWorker, nil
 }
 
+// drainWorkerIfNeeded drains the least utilized worker when the pool has more free capacity than configured,
+// or more workers than it is allowed. At most one worker is drained per cooldown period.
+func (s *WorkerPoolSizer) drainWorkerIfNeeded(freeCapacity *WorkerPoolCapacity) (*types.Worker, error) {
+	config := s.workerPoolSizingConfig
+
+	cooldown := config.ScaleDownCooldown
+	if cooldown <= 0 {
+		cooldown = defaultScaleDownCooldown
+	}
+
+	if time.Since(s.lastScaledAt) < cooldown {
+		return nil, nil
+	}
+
+	workers, err := s.activeWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	overMaxWorkers := config.MaxWorkers > 0 && len(workers) > config.MaxWorkers
+	if !overMaxWorkers && !shouldDrainWorker(freeCapacity, config) {
+		return nil, nil
+	}
+
+	candidates, err := s.drainableWorkers(workers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain the emptiest workers first, so that as few containers as possible are waited on
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return workerUtilization(candidates[i]) < workerUtilization(candidates[j])
+	})
+
+	for _, worker := range candidates {
+		workerCapacity := workerFreeCapacity(worker)
+		remainingCapacity := &WorkerPoolCapacity{
+			FreeCpu:    freeCapacity.FreeCpu - workerCapacity.FreeCpu,
+			FreeMemory: freeCapacity.FreeMemory - workerCapacity.FreeMemory,
+			FreeGpu:    freeCapacity.FreeGpu - min(freeCapacity.FreeGpu, workerCapacity.FreeGpu),
+		}
+
+		// Don't drain a worker if the pool would immediately need another one to stay above its minimum
+		if !overMaxWorkers && shouldAddWorker(remainingCapacity, config) {
+			continue
+		}
+
+		err = s.workerRepo.DrainWorker(worker.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		s.lastScaledAt = time.Now()
+		return worker, nil
+	}
+
+	return nil, nil
+}
+
+// activeWorkers returns the workers in the pool that aren't being drained
+func (s *WorkerPoolSizer) activeWorkers() ([]*types.Worker, error) {
+	workers, err := s.workerRepo.GetAllWorkersInPool(s.controller.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	activeWorkers := []*types.Worker{}
+	for _, worker := range workers {
+		if worker.Status != types.WorkerStatusDraining {
+			activeWorkers = append(activeWorkers, worker)
+		}
+	}
+
+	return activeWorkers, nil
+}
+
+// drainableWorkers returns the available workers that may be retired. Workers on manually provisioned machines
+// are kept, since occupyAvailableMachines would replace them right away.
+func (s *WorkerPoolSizer) drainableWorkers(workers []*types.Worker) ([]*types.Worker, error) {
+	pinnedMachines := map[string]bool{}
+	if s.workerPoolConfig.Mode == types.PoolModeExternal {
+		machines, err := s.providerRepo.ListAllMachines(string(*s.workerPoolConfig.Provider), s.controller.Name(), true)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range machines {
+			if !m.State.AutoConsolidate {
+				pinnedMachines[m.State.MachineId] = true
+			}
+		}
+	}
+
+	drainableWorkers := []*types.Worker{}
+	for _, worker := range workers {
+		if worker.Status != types.WorkerStatusAvailable || pinnedMachines[worker.MachineId] {
+			continue
+		}
+
+		drainableWorkers = append(drainableWorkers, worker)
+	}
+
+	return drainableWorkers, nil
+}
+
 // shouldAddWorker checks if the conditions are met for a new worker to be added
 func shouldAddWorker(freeCapacity *WorkerPoolCapacity, config *types.WorkerPoolSizingConfig) bool {
 	return freeCapacity.FreeCpu < config.MinFreeCpu ||
@@ -123,6 +279,13 @@ func shouldAddWorker(freeCapacity *WorkerPoolCapacity, config *types.WorkerPoolS
 		(config.MinFreeGpu > 0 && freeCapacity.FreeGpu < config.MinFreeGpu)
 }
 
+// shouldDrainWorker checks if the pool has more free capacity than any configured maximum
+func shouldDrainWorker(freeCapacity *WorkerPoolCapacity, config *types.WorkerPoolSizingConfig) bool {
+	return (config.MaxFreeCpu > 0 && freeCapacity.FreeCpu > config.MaxFreeCpu) ||
+		(config.MaxFreeMemory > 0 && freeCapacity.FreeMemory > config.MaxFreeMemory) ||
+		(config.MaxFreeGpu > 0 && freeCapacity.FreeGpu > config.MaxFreeGpu)
+}
+
 // parsePoolSizingConfig converts a common.WorkerPoolJobSpecPoolSizingConfig to a types.WorkerPoolSizingConfig.
 // When a value is not parsable or is invalid, we ignore the error and set a default.
 func parsePoolSizingConfig(config types.WorkerPoolJobSpecPoolSizingConfig) (*types.WorkerPoolSizingConfig, error) {
@@ -157,6 +320,26 @@ func parsePoolSizingConfig(config types.WorkerPoolJobSpecPoolSizingConfig) (*typ
 		c.DefaultWorkerGpuCount = uint32(defaultWorkerGpuCount)
 	}
 
+	if maxFreeCpu, err := ParseCPU(config.MaxFreeCPU); err == nil {
+		c.MaxFreeCpu = maxFreeCpu
+	}
+
+	if maxFreeMemory, err := ParseMemory(config.MaxFreeMemory); err == nil {
+		c.MaxFreeMemory = maxFreeMemory
+	}
+
+	if maxFreeGpu, err := ParseGPU(config.MaxFreeGPU); err == nil {
+		c.MaxFreeGpu = maxFreeGpu
+	}
+
+	if maxWorkers, err := strconv.Atoi(config.MaxWorkers); err == nil && maxWorkers > 0 {
+		c.MaxWorkers = maxWorkers
+	}
+
+	if scaleDownCooldown, err := time.ParseDuration(config.ScaleDownCooldown); err == nil && scaleDownCooldown > 0 {
+		c.ScaleDownCooldown = scaleDownCooldown
+	}
+
 	// Don't allow creation of workers with no gpu count if there is a GPU type set
 	if c.DefaultWorkerGpuCount <= 0 && defaultWorkerGpuType != "" {
 		c.DefaultWorkerGpuCount = 1
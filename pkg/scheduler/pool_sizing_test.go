@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/beam-cloud/beta9/pkg/common"
@@ -100,6 +101,163 @@ func TestAddWorkerIfNeeded(t *testing.T) {
 	}
 }
 
+func TestAddWorkerIfNeededRespectsMaxWorkers(t *testing.T) {
+	s, err := miniredis.Run()
+	assert.NotNil(t, s)
+	assert.Nil(t, err)
+
+	redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+	assert.NotNil(t, redisClient)
+	assert.Nil(t, err)
+
+	workerRepo := repo.NewWorkerRedisRepositoryForTest(redisClient)
+	controller := &LocalWorkerPoolControllerForTest{
+		name:       "TestPool",
+		workerRepo: workerRepo,
+	}
+
+	sizer := &WorkerPoolSizer{
+		controller: controller,
+		workerRepo: workerRepo,
+		workerPoolSizingConfig: &types.WorkerPoolSizingConfig{
+			MinFreeCpu:          10000,
+			DefaultWorkerCpu:    2000,
+			DefaultWorkerMemory: 500,
+			MaxWorkers:          1,
+		},
+	}
+
+	err = workerRepo.AddWorker(&types.Worker{Id: "worker1", PoolName: "TestPool", Status: types.WorkerStatusAvailable, FreeCpu: 2000})
+	assert.Nil(t, err)
+
+	newWorker, err := sizer.addWorkerIfNeeded(&WorkerPoolCapacity{FreeCpu: 2000})
+	assert.NoError(t, err)
+	assert.Nil(t, newWorker, "New worker should not be added past the maximum pool size")
+
+	// Draining workers don't count towards the pool size
+	err = workerRepo.DrainWorker("worker1")
+	assert.NoError(t, err)
+
+	newWorker, err = sizer.addWorkerIfNeeded(&WorkerPoolCapacity{FreeCpu: 0})
+	assert.NoError(t, err)
+	assert.NotNil(t, newWorker, "New worker should be added")
+}
+
+func TestDrainWorkerIfNeeded(t *testing.T) {
+	workers := []*types.Worker{
+		{Id: "busy", Status: types.WorkerStatusAvailable, TotalCpu: 4000, FreeCpu: 1000, TotalMemory: 4000, FreeMemory: 1000},
+		{Id: "idle", Status: types.WorkerStatusAvailable, TotalCpu: 4000, FreeCpu: 4000, TotalMemory: 4000, FreeMemory: 4000},
+		{Id: "half", Status: types.WorkerStatusAvailable, TotalCpu: 4000, FreeCpu: 2000, TotalMemory: 4000, FreeMemory: 2000},
+	}
+
+	tests := []struct {
+		name           string
+		workers        []*types.Worker
+		config         *types.WorkerPoolSizingConfig
+		lastScaledAt   time.Time
+		drainedWorkers []string
+	}{
+		{
+			name:           "should drain the emptiest worker when free cpu is above the maximum",
+			workers:        workers,
+			config:         &types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MaxFreeCpu: 4000},
+			drainedWorkers: []string{"idle"},
+		},
+		{
+			name:           "should not drain a worker during the cooldown",
+			workers:        workers,
+			config:         &types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MaxFreeCpu: 4000},
+			lastScaledAt:   time.Now(),
+			drainedWorkers: []string{},
+		},
+		{
+			name:           "should not drain a worker when free capacity is within the maximum",
+			workers:        workers,
+			config:         &types.WorkerPoolSizingConfig{MinFreeCpu: 1000, MaxFreeCpu: 10000},
+			drainedWorkers: []string{},
+		},
+		{
+			name:           "should skip workers the pool can't lose without dropping below its minimum",
+			workers:        workers,
+			config:         &types.WorkerPoolSizingConfig{MinFreeCpu: 6000, MaxFreeCpu: 4000},
+			drainedWorkers: []string{"busy"},
+		},
+		{
+			name:           "should drain a worker when the pool is above its maximum size",
+			workers:        workers,
+			config:         &types.WorkerPoolSizingConfig{MinFreeCpu: 10000, MaxWorkers: 2},
+			drainedWorkers: []string{"idle"},
+		},
+		{
+			name: "should not drain pending workers",
+			workers: []*types.Worker{
+				{Id: "pending", Status: types.WorkerStatusPending, TotalCpu: 4000, FreeCpu: 4000},
+			},
+			config:         &types.WorkerPoolSizingConfig{MaxFreeCpu: 1000},
+			drainedWorkers: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := miniredis.Run()
+			assert.NotNil(t, s)
+			assert.Nil(t, err)
+
+			redisClient, err := common.NewRedisClient(types.RedisConfig{Addrs: []string{s.Addr()}, Mode: types.RedisModeSingle})
+			assert.NotNil(t, redisClient)
+			assert.Nil(t, err)
+
+			workerRepo := repo.NewWorkerRedisRepositoryForTest(redisClient)
+			controller := &LocalWorkerPoolControllerForTest{
+				name:       "TestPool",
+				workerRepo: workerRepo,
+			}
+
+			for _, worker := range tt.workers {
+				worker := *worker
+				worker.PoolName = controller.name
+				err = workerRepo.AddWorker(&worker)
+				assert.NoError(t, err)
+			}
+
+			sizer := &WorkerPoolSizer{
+				controller:             controller,
+				workerRepo:             workerRepo,
+				workerPoolConfig:       &types.WorkerPoolConfig{Mode: types.PoolModeLocal},
+				workerPoolSizingConfig: tt.config,
+				lastScaledAt:           tt.lastScaledAt,
+			}
+
+			freeCapacity, err := freePoolCapacity(workerRepo, controller)
+			assert.NoError(t, err)
+
+			_, err = sizer.drainWorkerIfNeeded(freeCapacity)
+			assert.NoError(t, err)
+
+			poolWorkers, err := workerRepo.GetAllWorkersInPool(controller.name)
+			assert.NoError(t, err)
+
+			drainedWorkers := []string{}
+			for _, worker := range poolWorkers {
+				if worker.Status == types.WorkerStatusDraining {
+					drainedWorkers = append(drainedWorkers, worker.Id)
+				}
+			}
+			assert.Equal(t, tt.drainedWorkers, drainedWorkers)
+
+			// Drained workers no longer count towards the pool's free capacity
+			updatedCapacity, err := freePoolCapacity(workerRepo, controller)
+			assert.NoError(t, err)
+			if len(drainedWorkers) > 0 {
+				assert.Less(t, updatedCapacity.FreeCpu, freeCapacity.FreeCpu)
+			} else {
+				assert.Equal(t, freeCapacity.FreeCpu, updatedCapacity.FreeCpu)
+			}
+		})
+	}
+}
+
 func TestParsePoolSizingConfig(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -152,6 +310,25 @@ func TestParsePoolSizingConfig(t *testing.T) {
 				DefaultWorkerMemory: 1024,
 			},
 		},
+		{
+			name: "should parse scale down settings",
+			sizingConfigHave: &types.WorkerPoolJobSpecPoolSizingConfig{
+				MaxFreeCPU:        "8000m",
+				MaxFreeMemory:     "16Gi",
+				MaxFreeGPU:        "2",
+				MaxWorkers:        "10",
+				ScaleDownCooldown: "10m",
+			},
+			sizingConfigWant: &types.WorkerPoolSizingConfig{
+				DefaultWorkerCpu:    1000,
+				DefaultWorkerMemory: 1024,
+				MaxFreeCpu:          8000,
+				MaxFreeMemory:       16384,
+				MaxFreeGpu:          2,
+				MaxWorkers:          10,
+				ScaleDownCooldown:   10 * time.Minute,
+			},
+		},
 		{
 			name:             "should parse defaultWorkerGpuType as T4",
 			sizingConfigHave: &types.WorkerPoolJobSpecPoolSizingConfig{DefaultWorkerGpuType: "T4"},
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+const preemptionRequeueDelay time.Duration = 1 * time.Second
+
+// preemptForRequest stops lower priority containers so that the request can fit on an existing worker.
+// It picks the worker that requires the fewest evictions and returns true if capacity is being freed.
+func (s *Scheduler) preemptForRequest(request *types.ContainerRequest) (bool, error) {
+	if request.Priority <= types.ContainerPriorityMin {
+		return false, nil
+	}
+
+	workers, err := s.workerRepo.GetAllWorkers()
+	if err != nil {
+		return false, err
+	}
+
+	var selectedWorker *types.Worker = nil
+	var selectedVictims []types.ContainerState = nil
+
+	for _, worker := range workers {
+		if !workerIsEligible(worker, request) || worker.Gpu != request.Gpu {
+			continue
+		}
+
+		containers, err := s.containerRepo.GetActiveContainersByWorkerId(worker.Id)
+		if err != nil {
+			log.Printf("Unable to list containers on worker <%s>: %v\n", worker.Id, err)
+			continue
+		}
+
+		victims, ok := selectPreemptionVictims(worker, containers, request)
+		if !ok {
+			continue
+		}
+
+		if selectedWorker == nil || len(victims) < len(selectedVictims) {
+			selectedWorker = worker
+			selectedVictims = victims
+		}
+	}
+
+	if selectedWorker == nil {
+		return false, nil
+	}
+
+	for _, victim := range selectedVictims {
+		log.Printf("Evicting container <%s> (priority %d) on worker <%s> for container <%s> (priority %d)\n",
+			victim.ContainerId, victim.Priority, selectedWorker.Id, request.ContainerId, request.Priority)
+
+		err := s.Stop(victim.ContainerId)
+		if err != nil {
+			return false, err
+		}
+
+		go s.eventRepo.PushContainerEvictedEvent(victim.ContainerId, selectedWorker.Id, request.ContainerId, &types.ContainerRequest{
+			ContainerId: victim.ContainerId,
+			StubId:      victim.StubId,
+			WorkspaceId: victim.WorkspaceId,
+			Cpu:         victim.Cpu,
+			Memory:      victim.Memory,
+			Gpu:         victim.Gpu,
+			GpuCount:    victim.GpuCount,
+			Priority:    victim.Priority,
+		})
+	}
+
+	return true, nil
+}
+
+// selectPreemptionVictims returns the containers that must be stopped for the request to fit on a worker.
+// Containers that are already stopping count as freed capacity. Lower priority containers are evicted first,
+// and among equal priorities the most recently scheduled ones go first since they have the least work to lose.
+func selectPreemptionVictims(worker *types.Worker, containers []types.ContainerState, request *types.ContainerRequest) ([]types.ContainerState, bool) {
+	// Container state doesn't record which gpu slices a container holds, so gpus on sharing workers are never freed by preemption
+	if request.SharesGpu() || (worker.SharesGpus() && request.Gpu != "") {
+		return nil, false
+	}
+
+	freeCpu := worker.FreeCpu
+	freeMemory := worker.FreeMemory
+	freeGpuCount := worker.FreeGpuCount
+
+	fits := func() bool {
+		return freeCpu >= request.Cpu && freeMemory >= request.Memory && freeGpuCount >= request.GpuCount
+	}
+
+	candidates := []types.ContainerState{}
+	for _, container := range containers {
+		if container.Status == types.ContainerStatusStopping {
+			freeCpu += container.Cpu
+			freeMemory += container.Memory
+			freeGpuCount += container.GpuCount
+			continue
+		}
+
+		if container.Priority < request.Priority {
+			candidates = append(candidates, container)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority < candidates[j].Priority
+		}
+
+		return candidates[i].ScheduledAt > candidates[j].ScheduledAt
+	})
+
+	victims := []types.ContainerState{}
+	for _, candidate := range candidates {
+		if fits() {
+			break
+		}
+
+		freeCpu += candidate.Cpu
+		freeMemory += candidate.Memory
+		freeGpuCount += candidate.GpuCount
+		victims = append(victims, candidate)
+	}
+
+	if !fits() {
+		return nil, false
+	}
+
+	return victims, true
+}
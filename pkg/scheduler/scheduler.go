@@ -6,7 +6,6 @@ import (
 	"errors"
 	"log"
 	"math"
-	"sort"
 	"time"
 
 	"github.com/beam-cloud/beta9/pkg/common"
@@ -36,10 +35,10 @@ func NewScheduler(ctx context.Context, config types.AppConfig, redisClient *comm
 	eventBus := common.NewEventBus(redisClient)
 	workerRepo := repo.NewWorkerRedisRepository(redisClient, config.Worker)
 	providerRepo := repo.NewProviderRedisRepository(redisClient)
-	requestBacklog := NewRequestBacklog(redisClient)
 	containerRepo := repo.NewContainerRedisRepository(redisClient)
 
 	schedulerMetrics := NewSchedulerMetrics(metricsRepo)
+	requestBacklog := NewRequestBacklog(redisClient, NewDominantResourceFairness(workerRepo, containerRepo, schedulerMetrics))
 	eventRepo := repo.NewTCPEventClientRepo(config.Monitoring.FluentBit.Events)
 
 	// Load worker pools
@@ -63,6 +62,10 @@ func NewScheduler(ctx context.Context, config types.AppConfig, redisClient *comm
 			continue
 		}
 
+		if _, ok := GetPlacementStrategy(pool.PlacementStrategy); !ok {
+			log.Printf("unknown placement strategy <%s> for pool <%s>, using default\n", pool.PlacementStrategy, name)
+		}
+
 		workerPoolManager.SetPool(name, pool, controller)
 		log.Printf("loaded controller for pool <%s> with mode: %s and GPU type: %s\n", name, pool.Mode, pool.GPUType)
 	}
@@ -99,11 +102,20 @@ func (s *Scheduler) Run(request *types.ContainerRequest) error {
 	go s.schedulerMetrics.CounterIncContainerRequested(request)
 	go s.eventRepo.PushContainerRequestedEvent(request)
 
-	quota, err := s.getConcurrencyLimit(request)
+	quota, err := s.GetConcurrencyLimit(request.WorkspaceId)
 	if err != nil {
 		return err
 	}
 
+	normalizeRequest(request, quota)
+
+	if quota != nil && quota.FairShareWeight > 0 {
+		err = s.requestBacklog.SetWorkspaceWeight(request.WorkspaceId, quota.FairShareWeight)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = s.containerRepo.SetContainerStateWithConcurrencyLimit(quota, request)
 	if err != nil {
 		return err
@@ -112,16 +124,17 @@ func (s *Scheduler) Run(request *types.ContainerRequest) error {
 	return s.addRequestToBacklog(request)
 }
 
-func (s *Scheduler) getConcurrencyLimit(request *types.ContainerRequest) (*types.ConcurrencyLimit, error) {
+// GetConcurrencyLimit returns a workspace's concurrency limit, or nil if it doesn't have one
+func (s *Scheduler) GetConcurrencyLimit(workspaceId string) (*types.ConcurrencyLimit, error) {
 	// First try to get the cached quota
 	var quota *types.ConcurrencyLimit
-	quota, err := s.workspaceRepo.GetConcurrencyLimitByWorkspaceId(request.WorkspaceId)
+	quota, err := s.workspaceRepo.GetConcurrencyLimitByWorkspaceId(workspaceId)
 	if err != nil {
 		return nil, err
 	}
 
 	if quota == nil {
-		quota, err = s.backendRepo.GetConcurrencyLimitByWorkspaceId(s.ctx, request.WorkspaceId)
+		quota, err = s.backendRepo.GetConcurrencyLimitByWorkspaceId(s.ctx, workspaceId)
 		if err != nil && err == sql.ErrNoRows {
 			return nil, nil // No quota set for this workspace
 		}
@@ -129,7 +142,7 @@ func (s *Scheduler) getConcurrencyLimit(request *types.ContainerRequest) (*types
 			return nil, err
 		}
 
-		err = s.workspaceRepo.SetConcurrencyLimitByWorkspaceId(request.WorkspaceId, quota)
+		err = s.workspaceRepo.SetConcurrencyLimitByWorkspaceId(workspaceId, quota)
 		if err != nil {
 			return nil, err
 		}
@@ -162,25 +175,44 @@ func (s *Scheduler) Stop(containerId string) error {
 }
 
 func (s *Scheduler) getController(request *types.ContainerRequest) (WorkerPoolController, error) {
-	var ok bool
-	var workerPool *WorkerPool
+	workerPool, ok := s.getPool(request)
+	if !ok {
+		return nil, errors.New("no controller found for request")
+	}
 
+	return workerPool.Controller, nil
+}
+
+// getPool returns the worker pool a request would be placed in
+func (s *Scheduler) getPool(request *types.ContainerRequest) (*WorkerPool, bool) {
 	if request.PoolSelector != "" {
-		workerPool, ok = s.workerPoolManager.GetPool(request.PoolSelector)
+		return s.workerPoolManager.GetPool(request.PoolSelector)
 	} else if request.Gpu == "" {
-		workerPool, ok = s.workerPoolManager.GetPool("default")
-	} else {
-		workerPool, ok = s.workerPoolManager.GetPoolByGPU(request.Gpu)
+		return s.workerPoolManager.GetPool("default")
 	}
 
-	if !ok {
-		return nil, errors.New("no controller found for request")
+	return s.workerPoolManager.GetPoolByGPU(request.Gpu)
+}
+
+// getPlacementStrategy returns the placement strategy configured on the pool a request targets
+func (s *Scheduler) getPlacementStrategy(request *types.ContainerRequest) PlacementStrategy {
+	strategyName := types.PlacementStrategyDefault
+	if workerPool, ok := s.getPool(request); ok {
+		strategyName = workerPool.Config.PlacementStrategy
 	}
 
-	return workerPool.Controller, nil
+	strategy, _ := GetPlacementStrategy(strategyName)
+	return strategy
 }
 
 func (s *Scheduler) StartProcessingRequests() {
+	migrated, err := s.requestBacklog.MigrateLegacyRequests()
+	if err != nil {
+		log.Printf("Unable to migrate container requests: %v\n", err)
+	} else if migrated > 0 {
+		log.Printf("Migrated %d container requests to workspace backlogs\n", migrated)
+	}
+
 	for {
 		if s.requestBacklog.Len() == 0 {
 			time.Sleep(requestProcessingInterval)
@@ -201,14 +233,28 @@ func (s *Scheduler) StartProcessingRequests() {
 			controller, err := s.getController(request)
 			if err != nil {
 				log.Printf("No controller found for request: %+v, error: %v\n", request, err)
+				s.failRequest(request, types.ContainerSchedulingFailureNoController, err)
 				continue
 			}
 
 			go func() {
-				newWorker, err := controller.AddWorker(request.Cpu, request.Memory, request.Gpu, request.GpuCount)
-				if err != nil {
-					log.Printf("Unable to add worker job for container <%s>: %+v\n", request.ContainerId, err)
-					s.addRequestToBacklog(request)
+				newWorker, addWorkerErr := controller.AddWorker(request.Cpu, request.Memory, request.Gpu, request.GpuCount)
+				if addWorkerErr != nil {
+					log.Printf("Unable to add worker job for container <%s>: %+v\n", request.ContainerId, addWorkerErr)
+
+					// The pool can't grow, so try to make room by evicting lower priority containers
+					preempted, err := s.preemptForRequest(request)
+					if err != nil {
+						log.Printf("Unable to preempt containers for container <%s>: %v\n", request.ContainerId, err)
+					}
+
+					// Evicted containers free their capacity shortly, so there's no need to back off any further
+					if preempted {
+						s.retryRequestAfter(request, preemptionRequeueDelay, types.ContainerSchedulingFailureProviderError, addWorkerErr)
+						return
+					}
+
+					s.retryRequest(request, types.ContainerSchedulingFailureProviderError, addWorkerErr)
 					return
 				}
 
@@ -216,7 +262,7 @@ func (s *Scheduler) StartProcessingRequests() {
 				err = s.scheduleRequest(newWorker, request)
 				if err != nil {
 					log.Printf("Unable to schedule request for container<%s>: %v\n", request.ContainerId, err)
-					s.addRequestToBacklog(request)
+					s.retryRequest(request, types.ContainerSchedulingFailureNoCapacity, err)
 				}
 			}()
 
@@ -227,7 +273,7 @@ func (s *Scheduler) StartProcessingRequests() {
 		// on that worker.
 		err = s.scheduleRequest(worker, request)
 		if err != nil {
-			s.addRequestToBacklog(request)
+			s.retryRequest(request, types.ContainerSchedulingFailureNoCapacity, err)
 		}
 
 	}
@@ -246,50 +292,128 @@ func (s *Scheduler) selectWorker(request *types.ContainerRequest) (*types.Worker
 		return nil, err
 	}
 
-	// Filter workers by pool selector
+	// Filter out draining workers and filter by pool selector
 	filteredWorkers := []*types.Worker{}
 	for _, worker := range workers {
-		// If pool selector is specified, and the worker has that pool name, include the worker
-		if (request.PoolSelector != "" && worker.PoolName == request.PoolSelector) ||
-			// If pool selector is not specified, and worker does not require a pool selector, include the worker
-			(request.PoolSelector == "" && !worker.RequiresPoolSelector) {
+		if workerIsEligible(worker, request) {
 			filteredWorkers = append(filteredWorkers, worker)
 		}
 	}
 
-	workers = filteredWorkers
+	// Filter workers by free capacity
+	candidateWorkers := []*types.Worker{}
+	for _, worker := range filteredWorkers {
+		if workerFitsRequest(worker, request) {
+			candidateWorkers = append(candidateWorkers, worker)
+		}
+	}
 
-	// Sort workers: available first, then pending
-	sort.Slice(workers, func(i, j int) bool {
-		return workers[i].Status < workers[j].Status
-	})
+	if len(candidateWorkers) == 0 {
+		return nil, &types.ErrNoSuitableWorkerFound{}
+	}
 
-	for _, worker := range workers {
-		if worker.FreeCpu >= int64(request.Cpu) && worker.FreeMemory >= int64(request.Memory) && worker.Gpu == request.Gpu && worker.FreeGpuCount >= request.GpuCount {
-			return worker, nil
+	// Order the remaining workers by the pool's placement strategy
+	s.getPlacementStrategy(request).Rank(request, candidateWorkers)
+
+	return candidateWorkers[0], nil
+}
+
+func workerIsEligible(worker *types.Worker, request *types.ContainerRequest) bool {
+	return eligibilityRejection(worker, request) == ""
+}
+
+func workerFitsRequest(worker *types.Worker, request *types.ContainerRequest) bool {
+	return len(capacityRejections(worker, request)) == 0
+}
+
+// eligibilityRejection returns why a worker can't take the request regardless of its free capacity, if it can't
+func eligibilityRejection(worker *types.Worker, request *types.ContainerRequest) types.WorkerRejectionReason {
+	// Draining workers are being retired and don't accept new containers
+	if worker.Status == types.WorkerStatusDraining {
+		return types.WorkerRejectionDraining
+	}
+
+	// If pool selector is specified, only include workers with that pool name
+	if request.PoolSelector != "" && worker.PoolName != request.PoolSelector {
+		return types.WorkerRejectionPoolSelector
+	}
+
+	// If pool selector is not specified, only include workers that don't require a pool selector
+	if request.PoolSelector == "" && worker.RequiresPoolSelector {
+		return types.WorkerRejectionRequiresPoolSelector
+	}
+
+	return ""
+}
+
+// capacityRejections returns every resource the worker can't provide for the request
+func capacityRejections(worker *types.Worker, request *types.ContainerRequest) []types.WorkerRejectionReason {
+	rejections := []types.WorkerRejectionReason{}
+
+	if worker.FreeCpu < int64(request.Cpu) {
+		rejections = append(rejections, types.WorkerRejectionCpu)
+	}
+
+	if worker.FreeMemory < int64(request.Memory) {
+		rejections = append(rejections, types.WorkerRejectionMemory)
+	}
+
+	if worker.Gpu != request.Gpu {
+		rejections = append(rejections, types.WorkerRejectionGpuType)
+	}
+
+	// Shared gpu requests are sized by memory, and must fit in the free slices of a single device
+	if request.SharesGpu() {
+		if !worker.SharesGpus() {
+			rejections = append(rejections, types.WorkerRejectionGpuSharing)
+		} else if _, ok := worker.FindGpuSliceDevice(worker.GpuSlicesNeeded(request)); !ok {
+			rejections = append(rejections, types.WorkerRejectionGpuSlices)
 		}
+
+		return rejections
 	}
 
-	return nil, &types.ErrNoSuitableWorkerFound{}
+	if worker.FreeGpuCount < request.GpuCount {
+		rejections = append(rejections, types.WorkerRejectionGpuCount)
+	}
+
+	return rejections
 }
 
 const maxScheduleRetryCount = 3
 const maxScheduleRetryDuration = 10 * time.Minute
 
-func (s *Scheduler) addRequestToBacklog(request *types.ContainerRequest) error {
+// normalizeRequest fills in the defaults a request is scheduled with. GPU requests without a count get one GPU,
+// and requests without a priority use their workspace's.
+func normalizeRequest(request *types.ContainerRequest, quota *types.ConcurrencyLimit) {
 	if request.Gpu != "" && request.GpuCount <= 0 {
 		request.GpuCount = 1
 	}
 
+	if request.Priority == types.ContainerPriorityDefault && quota != nil {
+		request.Priority = quota.Priority
+	}
+}
+
+func (s *Scheduler) addRequestToBacklog(request *types.ContainerRequest) error {
 	if request.RetryCount == 0 {
 		request.RetryCount++
 		return s.requestBacklog.Push(request)
 	}
 
-	// TODO: add some sort of signaling mechanism to alert the caller if the request failed to be pushed to the requestBacklog
+	return s.retryRequest(request, types.ContainerSchedulingFailureNoCapacity, &types.ErrNoSuitableWorkerFound{})
+}
+
+// retryRequest puts a request that couldn't be scheduled back in the backlog after a backoff.
+// Once retries are exhausted, the request fails with the reason of the last attempt.
+func (s *Scheduler) retryRequest(request *types.ContainerRequest, reason types.ContainerSchedulingFailureReason, cause error) error {
+	return s.retryRequestAfter(request, calculateBackoffDelay(request.RetryCount), reason, cause)
+}
+
+// retryRequestAfter is retryRequest with a given delay in place of the backoff
+func (s *Scheduler) retryRequestAfter(request *types.ContainerRequest, delay time.Duration, reason types.ContainerSchedulingFailureReason, cause error) error {
 	go func() {
 		if request.RetryCount < maxScheduleRetryCount && time.Since(request.Timestamp) < maxScheduleRetryDuration {
-			delay := calculateBackoffDelay(request.RetryCount)
 			time.Sleep(delay)
 			request.RetryCount++
 			s.requestBacklog.Push(request)
@@ -297,12 +421,36 @@ func (s *Scheduler) addRequestToBacklog(request *types.ContainerRequest) error {
 		}
 
 		log.Printf("Giving up on request <%s> after %d attempts or due to max retry duration exceeded\n", request.ContainerId, request.RetryCount)
-		s.containerRepo.DeleteContainerState(&types.ContainerRequest{ContainerId: request.ContainerId})
+		s.failRequest(request, reason, cause)
 	}()
 
 	return nil
 }
 
+// failRequest records why a request could not be scheduled, so that callers waiting on the container
+// can find out, and then releases the container state
+func (s *Scheduler) failRequest(request *types.ContainerRequest, reason types.ContainerSchedulingFailureReason, cause error) {
+	failure := &types.ContainerSchedulingFailure{
+		ContainerId: request.ContainerId,
+		Reason:      reason,
+		RetryCount:  request.RetryCount,
+		FailedAt:    time.Now().Unix(),
+	}
+
+	if cause != nil {
+		failure.Message = cause.Error()
+	}
+
+	err := s.containerRepo.SetContainerSchedulingFailure(request.ContainerId, failure)
+	if err != nil {
+		log.Printf("Unable to record scheduling failure for container <%s>: %v\n", request.ContainerId, err)
+	}
+
+	go s.eventRepo.PushContainerFailedEvent(request.ContainerId, request, failure)
+
+	s.containerRepo.DeleteContainerState(&types.ContainerRequest{ContainerId: request.ContainerId})
+}
+
 func calculateBackoffDelay(retryCount int) time.Duration {
 	if retryCount == 0 {
 		return 0
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	repo "github.com/beam-cloud/beta9/pkg/repository"
 
 	"github.com/beam-cloud/beta9/pkg/types"
+	pb "github.com/beam-cloud/beta9/proto"
 	"github.com/google/uuid"
 	"github.com/knadh/koanf/providers/rawbytes"
 	"github.com/tj/assert"
@@ -71,9 +73,10 @@ func NewSchedulerForTest() (*Scheduler, error) {
 }
 
 type LocalWorkerPoolControllerForTest struct {
-	name       string
-	config     types.AppConfig
-	workerRepo repo.WorkerRepository
+	name           string
+	config         types.AppConfig
+	workerRepo     repo.WorkerRepository
+	removedWorkers []string
 }
 
 func (wpc *LocalWorkerPoolControllerForTest) generateWorkerId() string {
@@ -105,6 +108,11 @@ func (wpc *LocalWorkerPoolControllerForTest) AddWorkerToMachine(cpu int64, memor
 	return nil, errors.New("unimplemented")
 }
 
+func (wpc *LocalWorkerPoolControllerForTest) RemoveWorker(worker *types.Worker) error {
+	wpc.removedWorkers = append(wpc.removedWorkers, worker.Id)
+	return wpc.workerRepo.RemoveWorker(worker)
+}
+
 func (wpc *LocalWorkerPoolControllerForTest) Name() string {
 	return wpc.name
 }
@@ -215,6 +223,10 @@ func (wpc *ExternalWorkerPoolControllerForTest) AddWorkerToMachine(cpu int64, me
 	return worker, nil
 }
 
+func (wpc *ExternalWorkerPoolControllerForTest) RemoveWorker(worker *types.Worker) error {
+	return wpc.workerRepo.RemoveWorker(worker)
+}
+
 func (wpc *ExternalWorkerPoolControllerForTest) Name() string {
 	return wpc.name
 }
@@ -419,6 +431,54 @@ func TestSelectGPUWorker(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestSelectSharedGPUWorker(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	workers := []*types.Worker{
+		{Id: "whole", Status: types.WorkerStatusAvailable, FreeCpu: 4000, FreeMemory: 4000, Gpu: "A100-80", TotalGpuCount: 1, FreeGpuCount: 1},
+		{Id: "shared", Status: types.WorkerStatusAvailable, FreeCpu: 4000, FreeMemory: 4000, Gpu: "A100-80", TotalGpuCount: 1, GpuSliceMemory: 20480, GpuSlicesPerDevice: 4, FreeGpuSlices: "4"},
+	}
+	for _, worker := range workers {
+		err = wb.workerRepo.AddWorker(worker)
+		assert.Nil(t, err)
+	}
+
+	// Requests for gpu memory only go to workers that share their gpus
+	for i := 0; i < 2; i++ {
+		request := &types.ContainerRequest{Cpu: 1000, Memory: 1000, Gpu: "A100-80", GpuCount: 1, GpuMemory: 40000}
+
+		worker, err := wb.selectWorker(request)
+		assert.Nil(t, err)
+		assert.Equal(t, "shared", worker.Id)
+
+		err = wb.scheduleRequest(worker, request)
+		assert.Nil(t, err)
+	}
+
+	// The shared gpu is now full
+	request := &types.ContainerRequest{Cpu: 1000, Memory: 1000, Gpu: "A100-80", GpuCount: 1, GpuMemory: 1024}
+	_, err = wb.selectWorker(request)
+	assert.Error(t, err)
+
+	availableWorkers, err := wb.workerRepo.GetAllWorkers()
+	assert.Nil(t, err)
+	for _, worker := range availableWorkers {
+		switch worker.Id {
+		case "whole":
+			assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionGpuSharing}, capacityRejections(worker, request))
+		case "shared":
+			assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionGpuSlices}, capacityRejections(worker, request))
+		}
+	}
+
+	// Whole gpu requests never land on a sharing worker
+	worker, err := wb.selectWorker(&types.ContainerRequest{Cpu: 1000, Memory: 1000, Gpu: "A100-80", GpuCount: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "whole", worker.Id)
+}
+
 func TestSelectCPUWorker(t *testing.T) {
 	wb, err := NewSchedulerForTest()
 	assert.Nil(t, err)
@@ -775,3 +835,566 @@ func TestConcurrencyLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectWorkerPlacementStrategies(t *testing.T) {
+	cpuWorkers := func() []*types.Worker {
+		return []*types.Worker{
+			{
+				Id:          "empty",
+				Status:      types.WorkerStatusAvailable,
+				TotalCpu:    4000,
+				TotalMemory: 4000,
+				FreeCpu:     4000,
+				FreeMemory:  4000,
+				PoolName:    "beta9-cpu",
+			},
+			{
+				Id:          "busy",
+				Status:      types.WorkerStatusAvailable,
+				TotalCpu:    4000,
+				TotalMemory: 4000,
+				FreeCpu:     1000,
+				FreeMemory:  1000,
+				PoolName:    "beta9-cpu",
+			},
+			{
+				Id:          "half",
+				Status:      types.WorkerStatusAvailable,
+				TotalCpu:    4000,
+				TotalMemory: 4000,
+				FreeCpu:     2000,
+				FreeMemory:  2000,
+				PoolName:    "beta9-cpu",
+			},
+			{
+				Id:          "pending",
+				Status:      types.WorkerStatusPending,
+				TotalCpu:    4000,
+				TotalMemory: 4000,
+				FreeCpu:     4000,
+				FreeMemory:  4000,
+				PoolName:    "beta9-cpu",
+			},
+		}
+	}
+
+	gpuWorkers := func() []*types.Worker {
+		return []*types.Worker{
+			{
+				Id:            "gpu-4-free",
+				Status:        types.WorkerStatusAvailable,
+				TotalCpu:      8000,
+				TotalMemory:   8000,
+				FreeCpu:       8000,
+				FreeMemory:    8000,
+				Gpu:           "A10G",
+				TotalGpuCount: 4,
+				FreeGpuCount:  4,
+				PoolName:      "beta9-a10g",
+			},
+			{
+				Id:            "gpu-1-free",
+				Status:        types.WorkerStatusAvailable,
+				TotalCpu:      8000,
+				TotalMemory:   8000,
+				FreeCpu:       8000,
+				FreeMemory:    8000,
+				Gpu:           "A10G",
+				TotalGpuCount: 4,
+				FreeGpuCount:  1,
+				PoolName:      "beta9-a10g",
+			},
+			{
+				Id:            "gpu-2-free",
+				Status:        types.WorkerStatusAvailable,
+				TotalCpu:      8000,
+				TotalMemory:   8000,
+				FreeCpu:       1000,
+				FreeMemory:    1000,
+				Gpu:           "A10G",
+				TotalGpuCount: 2,
+				FreeGpuCount:  2,
+				PoolName:      "beta9-a10g",
+			},
+		}
+	}
+
+	cpuRequest := &types.ContainerRequest{
+		Cpu:          1000,
+		Memory:       1000,
+		PoolSelector: "beta9-cpu",
+	}
+
+	largeCpuRequest := &types.ContainerRequest{
+		Cpu:          3000,
+		Memory:       3000,
+		PoolSelector: "beta9-cpu",
+	}
+
+	gpuRequest := &types.ContainerRequest{
+		Cpu:      1000,
+		Memory:   1000,
+		Gpu:      "A10G",
+		GpuCount: 1,
+	}
+
+	tests := []struct {
+		name             string
+		pool             string
+		strategy         types.PlacementStrategyType
+		workers          []*types.Worker
+		request          *types.ContainerRequest
+		expectedWorkerId string
+	}{
+		{
+			name:             "default prefers available workers over pending ones",
+			pool:             "beta9-cpu",
+			strategy:         types.PlacementStrategyDefault,
+			workers:          cpuWorkers(),
+			request:          largeCpuRequest,
+			expectedWorkerId: "empty",
+		},
+		{
+			name:             "binpack prefers the most allocated worker",
+			pool:             "beta9-cpu",
+			strategy:         types.PlacementStrategyBinpack,
+			workers:          cpuWorkers(),
+			request:          cpuRequest,
+			expectedWorkerId: "busy",
+		},
+		{
+			name:             "spread prefers the least allocated worker",
+			pool:             "beta9-cpu",
+			strategy:         types.PlacementStrategySpread,
+			workers:          cpuWorkers(),
+			request:          cpuRequest,
+			expectedWorkerId: "empty",
+		},
+		{
+			name:             "least fragmentation prefers the tightest gpu fit",
+			pool:             "beta9-a10g",
+			strategy:         types.PlacementStrategyLeastFragmentation,
+			workers:          gpuWorkers(),
+			request:          gpuRequest,
+			expectedWorkerId: "gpu-1-free",
+		},
+		{
+			name:             "binpack on gpus uses overall utilization",
+			pool:             "beta9-a10g",
+			strategy:         types.PlacementStrategyBinpack,
+			workers:          gpuWorkers(),
+			request:          gpuRequest,
+			expectedWorkerId: "gpu-2-free",
+		},
+		{
+			name:             "unknown strategy falls back to default",
+			pool:             "beta9-cpu",
+			strategy:         types.PlacementStrategyType("unknown"),
+			workers:          cpuWorkers(),
+			request:          largeCpuRequest,
+			expectedWorkerId: "empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wb, err := NewSchedulerForTest()
+			assert.Nil(t, err)
+			assert.NotNil(t, wb)
+
+			pool, ok := wb.workerPoolManager.GetPool(test.pool)
+			assert.True(t, ok)
+
+			pool.Config.PlacementStrategy = test.strategy
+			wb.workerPoolManager.SetPool(test.pool, pool.Config, pool.Controller)
+
+			for _, worker := range test.workers {
+				err = wb.workerRepo.AddWorker(worker)
+				assert.Nil(t, err)
+			}
+
+			worker, err := wb.selectWorker(test.request)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedWorkerId, worker.Id)
+		})
+	}
+}
+
+func TestPreemptForRequest(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	worker := &types.Worker{
+		Id:          "worker-1",
+		Status:      types.WorkerStatusAvailable,
+		FreeCpu:     0,
+		FreeMemory:  0,
+		TotalCpu:    3000,
+		TotalMemory: 3000,
+	}
+
+	err = wb.workerRepo.AddWorker(worker)
+	assert.Nil(t, err)
+
+	containers := []types.ContainerState{
+		{ContainerId: "low-old", Status: types.ContainerStatusRunning, Cpu: 1000, Memory: 1000, Priority: -10, ScheduledAt: 1},
+		{ContainerId: "low-new", Status: types.ContainerStatusRunning, Cpu: 1000, Memory: 1000, Priority: -10, ScheduledAt: 2},
+		{ContainerId: "high", Status: types.ContainerStatusRunning, Cpu: 1000, Memory: 1000, Priority: 50, ScheduledAt: 3},
+	}
+
+	for _, container := range containers {
+		err = wb.containerRepo.SetContainerState(container.ContainerId, &container)
+		assert.Nil(t, err)
+
+		err = wb.workerRepo.AddContainerToWorker(worker.Id, container.ContainerId)
+		assert.Nil(t, err)
+	}
+
+	// Requests at or below the priority of every running container can't preempt anything
+	preempted, err := wb.preemptForRequest(&types.ContainerRequest{ContainerId: "default", Cpu: 1000, Memory: 1000, Priority: -10})
+	assert.Nil(t, err)
+	assert.False(t, preempted)
+
+	// A higher priority request evicts the most recently scheduled lowest priority container
+	preempted, err = wb.preemptForRequest(&types.ContainerRequest{ContainerId: "urgent", Cpu: 1000, Memory: 1000, Priority: 10})
+	assert.Nil(t, err)
+	assert.True(t, preempted)
+
+	expectedStatus := map[string]types.ContainerStatus{
+		"low-old": types.ContainerStatusRunning,
+		"low-new": types.ContainerStatusStopping,
+		"high":    types.ContainerStatusRunning,
+	}
+
+	for containerId, status := range expectedStatus {
+		state, err := wb.containerRepo.GetContainerState(containerId)
+		assert.Nil(t, err)
+		assert.Equal(t, status, state.Status)
+	}
+
+	// Capacity that is already being freed is used before evicting anything else
+	preempted, err = wb.preemptForRequest(&types.ContainerRequest{ContainerId: "urgent", Cpu: 1000, Memory: 1000, Priority: 10})
+	assert.Nil(t, err)
+	assert.True(t, preempted)
+
+	state, err := wb.containerRepo.GetContainerState("low-old")
+	assert.Nil(t, err)
+	assert.Equal(t, types.ContainerStatusRunning, state.Status)
+
+	// The request can't fit even after evicting every lower priority container
+	preempted, err = wb.preemptForRequest(&types.ContainerRequest{ContainerId: "huge", Cpu: 3000, Memory: 3000, Priority: 10})
+	assert.Nil(t, err)
+	assert.False(t, preempted)
+}
+
+func TestRunGroup(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	backendRepo, _ := repo.NewBackendPostgresRepositoryForTest()
+	wb.backendRepo = &BackendRepoConcurrencyLimitsForTest{
+		BackendRepository:   backendRepo,
+		GPUConcurrencyLimit: 10,
+		CPUConcurrencyLimit: 10000,
+	}
+
+	existingWorker := &types.Worker{
+		Id:           "worker-1",
+		Status:       types.WorkerStatusAvailable,
+		FreeCpu:      2000,
+		FreeMemory:   2000,
+		Gpu:          "A10G",
+		FreeGpuCount: 1,
+	}
+
+	err = wb.workerRepo.AddWorker(existingWorker)
+	assert.Nil(t, err)
+
+	group := &types.ContainerGroupRequest{
+		GroupId: "group-1",
+		Requests: []*types.ContainerRequest{
+			{ContainerId: "rank-0", Cpu: 1000, Memory: 1000, Gpu: "A10G", GpuCount: 1},
+			{ContainerId: "rank-1", Cpu: 1000, Memory: 1000, Gpu: "A10G", GpuCount: 1},
+		},
+	}
+
+	// The second member doesn't fit on the existing worker, so a new worker is added for it
+	err = wb.RunGroup(group)
+	assert.Nil(t, err)
+
+	workers, err := wb.workerRepo.GetAllWorkers()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(workers))
+
+	for rank, request := range group.Requests {
+		assert.Equal(t, "group-1", request.GroupId)
+		assert.Equal(t, rank, request.GroupRank)
+		assert.Equal(t, []string{"rank-0", "rank-1"}, request.GroupPeers)
+		assert.Contains(t, request.Env, fmt.Sprintf("GROUP_RANK=%d", rank))
+		assert.Contains(t, request.Env, "GROUP_SIZE=2")
+		assert.Contains(t, request.Env, "GROUP_PEERS=rank-0,rank-1")
+	}
+
+	scheduledRequest, err := wb.workerRepo.GetNextContainerRequest(existingWorker.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "rank-0", scheduledRequest.ContainerId)
+}
+
+func TestRunGroupRollsBackWhenMemberCannotBePlaced(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	backendRepo, _ := repo.NewBackendPostgresRepositoryForTest()
+	wb.backendRepo = &BackendRepoConcurrencyLimitsForTest{
+		BackendRepository:   backendRepo,
+		GPUConcurrencyLimit: 10,
+		CPUConcurrencyLimit: 10000,
+	}
+
+	existingWorker := &types.Worker{
+		Id:           "worker-1",
+		Status:       types.WorkerStatusAvailable,
+		FreeCpu:      1000,
+		FreeMemory:   1000,
+		Gpu:          "A10G",
+		FreeGpuCount: 1,
+	}
+
+	err = wb.workerRepo.AddWorker(existingWorker)
+	assert.Nil(t, err)
+
+	// The second member gets a new worker, but no pool serves H100s, so the third member can't be placed anywhere
+	err = wb.RunGroup(&types.ContainerGroupRequest{
+		Requests: []*types.ContainerRequest{
+			{ContainerId: "rank-0", Cpu: 1000, Memory: 1000, Gpu: "A10G", GpuCount: 1},
+			{ContainerId: "rank-1", Cpu: 1000, Memory: 1000, Gpu: "A10G", GpuCount: 1},
+			{ContainerId: "rank-2", Cpu: 1000, Memory: 1000, Gpu: "H100", GpuCount: 1},
+		},
+	})
+	assert.Error(t, err)
+
+	worker, err := wb.workerRepo.GetWorkerById(existingWorker.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, existingWorker.FreeCpu, worker.FreeCpu)
+	assert.Equal(t, existingWorker.FreeGpuCount, worker.FreeGpuCount)
+
+	// The worker added for the second member is removed along with the group, through the pool that added it
+	workers, err := wb.workerRepo.GetAllWorkers()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(workers))
+
+	pool, ok := wb.workerPoolManager.GetPool("beta9-a10g")
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(pool.Controller.(*LocalWorkerPoolControllerForTest).removedWorkers))
+
+	for _, containerId := range []string{"rank-0", "rank-1", "rank-2"} {
+		_, err := wb.containerRepo.GetContainerState(containerId)
+		assert.Error(t, err)
+	}
+}
+
+func TestRunContainerGroupService(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	backendRepo, _ := repo.NewBackendPostgresRepositoryForTest()
+	wb.backendRepo = &BackendRepoConcurrencyLimitsForTest{
+		BackendRepository:   backendRepo,
+		GPUConcurrencyLimit: 10,
+		CPUConcurrencyLimit: 10000,
+	}
+
+	service := &SchedulerService{Scheduler: wb}
+
+	// A member with an invalid resource request fails the whole group before anything is scheduled
+	response, err := service.RunContainerGroup(context.Background(), &pb.RunContainerGroupRequest{
+		Containers: []*pb.RunContainerRequest{
+			{ContainerId: "rank-0", Cpu: "1000m", Memory: "1Gi"},
+			{ContainerId: "rank-1", Cpu: "invalid", Memory: "1Gi"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.False(t, response.Success)
+
+	_, err = wb.containerRepo.GetContainerState("rank-0")
+	assert.Error(t, err)
+
+	response, err = service.RunContainerGroup(context.Background(), &pb.RunContainerGroupRequest{
+		GroupId: "group-1",
+		Containers: []*pb.RunContainerRequest{
+			{ContainerId: "rank-0", Cpu: "1000m", Memory: "1Gi"},
+			{ContainerId: "rank-1", Cpu: "1000m", Memory: "1Gi"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, response.Success)
+	assert.Equal(t, "group-1", response.GroupId)
+
+	for _, containerId := range []string{"rank-0", "rank-1"} {
+		state, err := wb.containerRepo.GetContainerState(containerId)
+		assert.Nil(t, err)
+		assert.Equal(t, types.ContainerStatusPending, types.ContainerStatus(state.Status))
+	}
+}
+
+func TestSchedulingFailureIsRecorded(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	backendRepo, _ := repo.NewBackendPostgresRepositoryForTest()
+	wb.backendRepo = &BackendRepoConcurrencyLimitsForTest{
+		BackendRepository:   backendRepo,
+		GPUConcurrencyLimit: 0,
+		CPUConcurrencyLimit: 1000,
+	}
+
+	// Requests over the workspace quota are throttled and retried by their caller, so they haven't failed
+	err = wb.Run(&types.ContainerRequest{ContainerId: "over-quota", Cpu: 2000})
+	_, ok := err.(*types.ThrottledByConcurrencyLimitError)
+	assert.True(t, ok)
+
+	failure, err := wb.containerRepo.GetContainerSchedulingFailure("over-quota")
+	assert.Nil(t, err)
+	assert.Nil(t, failure)
+
+	// Requests that run out of retries fail with the reason of the last attempt
+	request := &types.ContainerRequest{ContainerId: "no-capacity", Cpu: 1000, RetryCount: maxScheduleRetryCount, Timestamp: time.Now()}
+	err = wb.retryRequest(request, types.ContainerSchedulingFailureProviderError, errors.New("machine out of capacity"))
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		failure, err := wb.containerRepo.GetContainerSchedulingFailure("no-capacity")
+		return err == nil && failure != nil
+	}, time.Second, 10*time.Millisecond)
+
+	failure, err = wb.containerRepo.GetContainerSchedulingFailure("no-capacity")
+	assert.Nil(t, err)
+	assert.Equal(t, types.ContainerSchedulingFailureProviderError, failure.Reason)
+	assert.Equal(t, "machine out of capacity", failure.Message)
+	assert.Equal(t, maxScheduleRetryCount, failure.RetryCount)
+
+	// Callers waiting for the container to start learn about the failure instead of timing out
+	_, err = wb.containerRepo.GetWorkerAddress("no-capacity")
+	_, ok = err.(*types.ErrContainerSchedulingFailed)
+	assert.True(t, ok)
+
+	// Containers that haven't failed have no failure record
+	failure, err = wb.containerRepo.GetContainerSchedulingFailure("unknown")
+	assert.Nil(t, err)
+	assert.Nil(t, failure)
+}
+
+func TestRetryRequestAfterCountsRetries(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+	assert.NotNil(t, wb)
+
+	// Requests requeued after a preemption use up a retry like any other
+	request := &types.ContainerRequest{ContainerId: "preempting", Cpu: 1000, RetryCount: 1, Timestamp: time.Now()}
+	err = wb.retryRequestAfter(request, 0, types.ContainerSchedulingFailureProviderError, errors.New("machine out of capacity"))
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return wb.requestBacklog.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	requeued, err := wb.requestBacklog.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, "preempting", requeued.ContainerId)
+	assert.Equal(t, 2, requeued.RetryCount)
+}
+
+func TestExplain(t *testing.T) {
+	wb, err := NewSchedulerForTest()
+	assert.Nil(t, err)
+
+	backendRepo, _ := repo.NewBackendPostgresRepositoryForTest()
+	wb.backendRepo = &BackendRepoConcurrencyLimitsForTest{
+		BackendRepository:   backendRepo,
+		GPUConcurrencyLimit: 0,
+		CPUConcurrencyLimit: 1000,
+	}
+
+	workers := []*types.Worker{
+		{Id: "cpu-small", Status: types.WorkerStatusAvailable, FreeCpu: 500, FreeMemory: 4000},
+		{Id: "cpu-large", Status: types.WorkerStatusAvailable, FreeCpu: 4000, FreeMemory: 4000},
+		{Id: "build", Status: types.WorkerStatusAvailable, FreeCpu: 4000, FreeMemory: 4000, PoolName: "beta9-build", RequiresPoolSelector: true},
+		{Id: "gpu", Status: types.WorkerStatusAvailable, FreeCpu: 4000, FreeMemory: 4000, Gpu: "T4", FreeGpuCount: 1},
+	}
+	for _, worker := range workers {
+		err = wb.workerRepo.AddWorker(worker)
+		assert.Nil(t, err)
+	}
+
+	request := &types.ContainerRequest{ContainerId: "explained", WorkspaceId: "ws", Cpu: 1000, Memory: 1000}
+	explanation, err := wb.Explain(request)
+	assert.Nil(t, err)
+
+	rejections := map[string][]types.WorkerRejectionReason{}
+	for _, candidate := range explanation.Workers {
+		rejections[candidate.Worker.Id] = candidate.Rejections
+	}
+
+	assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionCpu}, rejections["cpu-small"])
+	assert.Equal(t, []types.WorkerRejectionReason{}, rejections["cpu-large"])
+	assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionRequiresPoolSelector}, rejections["build"])
+	assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionGpuType}, rejections["gpu"])
+	assert.Equal(t, "", explanation.ThrottledReason)
+	assert.Equal(t, "default", explanation.ControllerName)
+
+	// The explanation agrees with the worker the scheduler would actually pick
+	worker, err := wb.selectWorker(request)
+	assert.Nil(t, err)
+	assert.Equal(t, worker.Id, explanation.SelectedWorkerId)
+
+	// Explaining doesn't reserve anything
+	updatedWorker, err := wb.workerRepo.GetWorkerById("cpu-large")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4000), updatedWorker.FreeCpu)
+
+	// Requests over the workspace quota or for an unknown gpu are explained too
+	explanation, err = wb.Explain(&types.ContainerRequest{WorkspaceId: "ws", Cpu: 2000, Gpu: "UNKNOWN_GPU"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", explanation.SelectedWorkerId)
+	assert.Equal(t, "concurrency_limit_reached: gpu quota exceeded", explanation.ThrottledReason)
+	assert.NotEqual(t, "", explanation.ControllerError)
+
+	// Gpu requests without a count are checked against the quota as one gpu, the same as when they're run
+	err = wb.Run(&types.ContainerRequest{ContainerId: "gpu-no-count", WorkspaceId: "ws", Cpu: 100, Gpu: "T4"})
+	_, ok := err.(*types.ThrottledByConcurrencyLimitError)
+	assert.True(t, ok)
+
+	// Pending containers are explained using the request waiting in the backlog
+	err = wb.Run(&types.ContainerRequest{ContainerId: "pending", WorkspaceId: "ws", Cpu: 1000, Memory: 1000, PoolSelector: "beta9-build"})
+	assert.Nil(t, err)
+
+	explanation, err = wb.ExplainContainer("pending")
+	assert.Nil(t, err)
+	assert.Equal(t, "beta9-build", explanation.Request.PoolSelector)
+	assert.Equal(t, "build", explanation.SelectedWorkerId)
+	assert.Equal(t, "beta9-build", explanation.ControllerName)
+
+	// The container's own usage doesn't count against its quota
+	assert.Equal(t, "", explanation.ThrottledReason)
+
+	// Draining workers are never selected
+	err = wb.workerRepo.DrainWorker("cpu-large")
+	assert.Nil(t, err)
+
+	_, err = wb.selectWorker(request)
+	assert.Error(t, err)
+
+	explanation, err = wb.Explain(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "", explanation.SelectedWorkerId)
+	for _, candidate := range explanation.Workers {
+		if candidate.Worker.Id == "cpu-large" {
+			assert.Equal(t, []types.WorkerRejectionReason{types.WorkerRejectionDraining}, candidate.Rejections)
+		}
+	}
+}
@@ -27,7 +27,7 @@ func (wbs *SchedulerService) GetVersion(ctx context.Context, in *pb.VersionReque
 
 // Run a container
 func (wbs *SchedulerService) RunContainer(ctx context.Context, in *pb.RunContainerRequest) (*pb.RunContainerResponse, error) {
-	cpuRequest, err := ParseCPU(in.Cpu)
+	request, err := containerRequestFromProto(in)
 	if err != nil {
 		return &pb.RunContainerResponse{
 			Success: false,
@@ -35,7 +35,7 @@ func (wbs *SchedulerService) RunContainer(ctx context.Context, in *pb.RunContain
 		}, nil
 	}
 
-	memoryRequest, err := ParseMemory(in.Memory)
+	err = wbs.Scheduler.Run(request)
 	if err != nil {
 		return &pb.RunContainerResponse{
 			Success: false,
@@ -43,27 +43,65 @@ func (wbs *SchedulerService) RunContainer(ctx context.Context, in *pb.RunContain
 		}, nil
 	}
 
-	err = wbs.Scheduler.Run(&types.ContainerRequest{
-		ContainerId: in.ContainerId,
-		EntryPoint:  in.EntryPoint,
-		Env:         in.Env,
-		Cpu:         cpuRequest,
-		Memory:      memoryRequest,
-		Gpu:         in.Gpu,
-		ImageId:     in.ImageId,
-		RetryCount:  0,
-	})
+	return &pb.RunContainerResponse{
+		Success: true,
+		Error:   "",
+	}, nil
+}
+
+// Run a group of containers, which are all scheduled or none are
+func (wbs *SchedulerService) RunContainerGroup(ctx context.Context, in *pb.RunContainerGroupRequest) (*pb.RunContainerGroupResponse, error) {
+	group := &types.ContainerGroupRequest{
+		GroupId:  in.GroupId,
+		Requests: make([]*types.ContainerRequest, 0, len(in.Containers)),
+	}
+
+	for _, container := range in.Containers {
+		request, err := containerRequestFromProto(container)
+		if err != nil {
+			return &pb.RunContainerGroupResponse{
+				Success: false,
+				Error:   err.Error(),
+			}, nil
+		}
+
+		group.Requests = append(group.Requests, request)
+	}
 
+	err := wbs.Scheduler.RunGroup(group)
 	if err != nil {
-		return &pb.RunContainerResponse{
+		return &pb.RunContainerGroupResponse{
 			Success: false,
 			Error:   err.Error(),
 		}, nil
 	}
 
-	return &pb.RunContainerResponse{
+	return &pb.RunContainerGroupResponse{
 		Success: true,
 		Error:   "",
+		GroupId: group.GroupId,
 	}, nil
 }
 
+func containerRequestFromProto(in *pb.RunContainerRequest) (*types.ContainerRequest, error) {
+	cpuRequest, err := ParseCPU(in.Cpu)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryRequest, err := ParseMemory(in.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ContainerRequest{
+		ContainerId: in.ContainerId,
+		EntryPoint:  in.EntryPoint,
+		Env:         in.Env,
+		Cpu:         cpuRequest,
+		Memory:      memoryRequest,
+		Gpu:         in.Gpu,
+		ImageId:     in.ImageId,
+		RetryCount:  0,
+	}, nil
+}
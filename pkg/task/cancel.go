@@ -0,0 +1,91 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+// How long a task stays marked as cancelled, so runners that start watching late still see it
+const taskCancelledTTL time.Duration = 24 * time.Hour
+
+var (
+	ErrTaskNotCancelled           = errors.New("task wasn't cancelled")
+	ErrInvalidCancellationOutcome = errors.New("invalid cancellation outcome")
+)
+
+// Cancel stops a task on behalf of a user. The task is marked as cancelled and notified on its cancel
+// channel, so a runner executing it can stop its handler. The runner reports how the handler stopped
+// with ResolveCancellation.
+func (d *Dispatcher) Cancel(ctx context.Context, task *types.TaskWithRelated) error {
+	if task.Status.IsCompleted() {
+		return nil
+	}
+
+	running := task.Status == types.TaskStatusRunning
+
+	// The task is marked as cancelled before its claim is released, so runners watching it never see it end
+	// without a cancellation
+	err := d.taskRepo.CancelTask(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId, types.TaskCancelledByUser, taskCancelledTTL)
+	if err != nil {
+		return errors.New("failed to cancel task")
+	}
+
+	err = d.Complete(ctx, task.Workspace.Name, task.Stub.ExternalId, task.ExternalId)
+	if err != nil {
+		return errors.New("failed to complete task")
+	}
+
+	task.Status = types.TaskStatusCancelled
+	task.EndedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if _, err := d.backendRepo.UpdateTask(ctx, task.ExternalId, task.Task); err != nil {
+		return errors.New("failed to update task")
+	}
+
+	cancellation := types.TaskCancellation{
+		Reason:      types.TaskCancelledByUser,
+		Outcome:     types.TaskCancellationNotRunning,
+		RequestedAt: time.Now(),
+	}
+	if running {
+		cancellation.Outcome = types.TaskCancellationPending
+	}
+
+	task.Cancellation = &cancellation
+	if err := d.backendRepo.SetTaskCancellation(ctx, task.ExternalId, cancellation); err != nil {
+		return errors.New("failed to record task cancellation")
+	}
+
+	return nil
+}
+
+// Cancelled returns why a task was cancelled, or an empty reason if it wasn't
+func (d *Dispatcher) Cancelled(ctx context.Context, workspaceName, stubId, taskId string) (types.TaskCancellationReason, error) {
+	return d.taskRepo.GetTaskCancellation(ctx, workspaceName, stubId, taskId)
+}
+
+// CancelGracePeriod is how long runners let a cancelled task's handler run before restarting its worker process
+func (d *Dispatcher) CancelGracePeriod() time.Duration {
+	return d.cancelGracePeriod
+}
+
+// ResolveCancellation records how a runner stopped a cancelled task
+func (d *Dispatcher) ResolveCancellation(ctx context.Context, task *types.TaskWithRelated, outcome types.TaskCancellationOutcome) error {
+	if outcome != types.TaskCancellationStopped && outcome != types.TaskCancellationKilled {
+		return ErrInvalidCancellationOutcome
+	}
+
+	cancellation := task.Cancellation
+	if cancellation == nil {
+		return ErrTaskNotCancelled
+	}
+
+	resolvedAt := time.Now()
+	cancellation.Outcome = outcome
+	cancellation.ResolvedAt = &resolvedAt
+
+	return d.backendRepo.SetTaskCancellation(ctx, task.ExternalId, *cancellation)
+}
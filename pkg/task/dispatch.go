@@ -2,11 +2,16 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/beam-cloud/beta9/pkg/abstractions/output"
 	"github.com/beam-cloud/beta9/pkg/auth"
 	"github.com/beam-cloud/beta9/pkg/common"
 	"github.com/beam-cloud/beta9/pkg/repository"
@@ -14,10 +19,33 @@ import (
 	"github.com/gofrs/uuid"
 )
 
-func NewDispatcher(ctx context.Context, taskRepo repository.TaskRepository) (*Dispatcher, error) {
+const (
+	defaultIdempotencyWindow     time.Duration = 24 * time.Hour
+	defaultTaskCancelGracePeriod time.Duration = 10 * time.Second
+)
+
+var ErrTaskNotDeadLettered = errors.New("task isn't in the dead-letter queue")
+
+func NewDispatcher(ctx context.Context, taskRepo repository.TaskRepository, backendRepo repository.BackendRepository, metricsRepo repository.MetricsRepository, config types.AppConfig) (*Dispatcher, error) {
+	idempotencyWindow := config.GatewayService.IdempotencyWindow
+	if idempotencyWindow <= 0 {
+		idempotencyWindow = defaultIdempotencyWindow
+	}
+
+	cancelGracePeriod := config.GatewayService.TaskCancelGracePeriod
+	if cancelGracePeriod <= 0 {
+		cancelGracePeriod = defaultTaskCancelGracePeriod
+	}
+
 	d := &Dispatcher{
-		taskRepo:  taskRepo,
-		executors: common.NewSafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)](),
+		taskRepo:          taskRepo,
+		backendRepo:       backendRepo,
+		idempotencyWindow: idempotencyWindow,
+		cancelGracePeriod: cancelGracePeriod,
+		monitorId:         uuid.Must(uuid.NewV4()).String(),
+		monitorConfig:     monitorConfigWithDefaults(config.GatewayService.TaskMonitor),
+		metrics:           NewDispatcherMetrics(metricsRepo),
+		executors:         common.NewSafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)](),
 	}
 
 	go d.monitor(ctx)
@@ -25,8 +53,14 @@ func NewDispatcher(ctx context.Context, taskRepo repository.TaskRepository) (*Di
 }
 
 type Dispatcher struct {
-	taskRepo  repository.TaskRepository
-	executors *common.SafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)]
+	taskRepo          repository.TaskRepository
+	backendRepo       repository.BackendRepository
+	idempotencyWindow time.Duration
+	cancelGracePeriod time.Duration
+	monitorId         string
+	monitorConfig     types.TaskMonitorConfig
+	metrics           DispatcherMetrics
+	executors         *common.SafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)]
 }
 
 var taskMessagePool = sync.Pool{
@@ -64,16 +98,152 @@ func (d *Dispatcher) Register(executor string, taskFactory func(ctx context.Cont
 }
 
 func (d *Dispatcher) SendAndExecute(ctx context.Context, executor string, authInfo *auth.AuthInfo, stubId string, payload *types.TaskPayload, policy types.TaskPolicy) (types.TaskInterface, error) {
-	task, err := d.Send(ctx, executor, authInfo, stubId, payload, policy)
+	taskId, existing, err := d.reserveTaskId(ctx, authInfo.Workspace.Name, stubId, payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return task, task.Execute(ctx)
+	if existing {
+		return d.existingTask(ctx, executor, authInfo.Workspace.Name, stubId, taskId)
+	}
+
+	var task types.TaskInterface
+	if len(payload.DependsOn) > 0 || policy.NotBefore.After(time.Now()) {
+		task, err = d.hold(ctx, taskId, executor, authInfo, stubId, payload, policy)
+	} else {
+		task, err = d.send(ctx, taskId, executor, authInfo, stubId, payload, policy)
+		if err == nil {
+			err = task.Execute(ctx)
+		}
+	}
+
+	if err != nil {
+		d.releaseIdempotencyKey(ctx, authInfo.Workspace.Name, stubId, payload)
+	}
+
+	return task, err
 }
 
 func (d *Dispatcher) Send(ctx context.Context, executor string, authInfo *auth.AuthInfo, stubId string, payload *types.TaskPayload, policy types.TaskPolicy) (types.TaskInterface, error) {
+	// Tasks that are sent without being executed by the dispatcher can't wait on other tasks, or be delayed
+	if len(payload.DependsOn) > 0 || !policy.NotBefore.IsZero() {
+		return nil, fmt.Errorf("task dependencies and delays aren't supported by executor: %v", executor)
+	}
+
+	taskId, existing, err := d.reserveTaskId(ctx, authInfo.Workspace.Name, stubId, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing {
+		return d.existingTask(ctx, executor, authInfo.Workspace.Name, stubId, taskId)
+	}
+
+	task, err := d.send(ctx, taskId, executor, authInfo, stubId, payload, policy)
+	if err != nil {
+		d.releaseIdempotencyKey(ctx, authInfo.Workspace.Name, stubId, payload)
+	}
+
+	return task, err
+}
+
+// SendBatch stores the state of several new tasks in one round trip, without executing them. Batched tasks
+// can't wait on other tasks, be delayed, or use idempotency keys, since each of those needs its own lookups.
+func (d *Dispatcher) SendBatch(ctx context.Context, executor string, authInfo *auth.AuthInfo, stubId string, payloads []*types.TaskPayload, policy types.TaskPolicy) ([]types.TaskInterface, error) {
+	taskFactory, exists := d.executors.Get(executor)
+	if !exists {
+		return nil, fmt.Errorf("invalid task executor: %v", executor)
+	}
+
+	if !policy.NotBefore.IsZero() {
+		return nil, errors.New("delayed tasks can't be sent in a batch")
+	}
+
+	tasks := make([]types.TaskInterface, 0, len(payloads))
+	msgs := make(map[string][]byte, len(payloads))
+	for _, payload := range payloads {
+		if len(payload.DependsOn) > 0 || payload.IdempotencyKey != "" {
+			return nil, errors.New("tasks with dependencies or idempotency keys can't be sent in a batch")
+		}
+
+		taskMessage := d.getTaskMessage()
+		taskMessage.Executor = executor
+		taskMessage.WorkspaceName = authInfo.Workspace.Name
+		taskMessage.StubId = stubId
+		taskMessage.Args = payload.Args
+		taskMessage.Kwargs = payload.Kwargs
+		taskMessage.Policy = policy
+
+		task, err := taskFactory(ctx, *taskMessage)
+		if err != nil {
+			d.releaseTaskMessage(taskMessage)
+			return nil, err
+		}
+
+		msg, err := taskMessage.Encode()
+		if err != nil {
+			d.releaseTaskMessage(taskMessage)
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+		msgs[taskMessage.TaskId] = msg
+		d.releaseTaskMessage(taskMessage)
+	}
+
+	err := d.taskRepo.SetTaskStates(ctx, authInfo.Workspace.Name, stubId, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// reserveTaskId returns the id for a new task. If the payload's idempotency key was already used for the
+// same stub within the idempotency window, the id of the task that used it is returned instead.
+func (d *Dispatcher) reserveTaskId(ctx context.Context, workspaceName, stubId string, payload *types.TaskPayload) (string, bool, error) {
+	taskId := uuid.Must(uuid.NewV4()).String()
+	if payload.IdempotencyKey == "" {
+		return taskId, false, nil
+	}
+
+	reservedTaskId, err := d.taskRepo.ReserveIdempotencyKey(ctx, workspaceName, stubId, payload.IdempotencyKey, taskId, d.idempotencyWindow)
+	if err != nil {
+		return "", false, err
+	}
+
+	return reservedTaskId, reservedTaskId != taskId, nil
+}
+
+// releaseIdempotencyKey frees the idempotency key of a task that couldn't be sent, so that it can be retried
+func (d *Dispatcher) releaseIdempotencyKey(ctx context.Context, workspaceName, stubId string, payload *types.TaskPayload) {
+	if payload.IdempotencyKey == "" {
+		return
+	}
+
+	d.taskRepo.DeleteIdempotencyKey(ctx, workspaceName, stubId, payload.IdempotencyKey)
+}
+
+// existingTask returns a task that was already sent
+func (d *Dispatcher) existingTask(ctx context.Context, executor, workspaceName, stubId, taskId string) (types.TaskInterface, error) {
+	taskFactory, exists := d.executors.Get(executor)
+	if !exists {
+		return nil, fmt.Errorf("invalid task executor: %v", executor)
+	}
+
 	taskMessage := d.getTaskMessage()
+	taskMessage.TaskId = taskId
+	taskMessage.Executor = executor
+	taskMessage.WorkspaceName = workspaceName
+	taskMessage.StubId = stubId
+
+	defer d.releaseTaskMessage(taskMessage)
+	return taskFactory(ctx, *taskMessage)
+}
+
+func (d *Dispatcher) send(ctx context.Context, taskId string, executor string, authInfo *auth.AuthInfo, stubId string, payload *types.TaskPayload, policy types.TaskPolicy) (types.TaskInterface, error) {
+	taskMessage := d.getTaskMessage()
+	taskMessage.TaskId = taskId
 	taskMessage.Executor = executor
 	taskMessage.WorkspaceName = authInfo.Workspace.Name
 	taskMessage.StubId = stubId
@@ -98,8 +268,6 @@ func (d *Dispatcher) Send(ctx context.Context, executor string, authInfo *auth.A
 		return nil, err
 	}
 
-	taskId := task.Metadata().TaskId
-
 	err = d.taskRepo.SetTaskState(ctx, authInfo.Workspace.Name, stubId, taskId, msg)
 	if err != nil {
 		return nil, err
@@ -108,103 +276,461 @@ func (d *Dispatcher) Send(ctx context.Context, executor string, authInfo *auth.A
 	return task, nil
 }
 
+// hold stores a task until it can run. A delayed task is held until it's due, and a task with dependencies
+// is then held in the WAITING state until every task it depends on is complete.
+func (d *Dispatcher) hold(ctx context.Context, taskId string, executor string, authInfo *auth.AuthInfo, stubId string, payload *types.TaskPayload, policy types.TaskPolicy) (types.TaskInterface, error) {
+	dependsOn := slices.Clone(payload.DependsOn)
+	slices.Sort(dependsOn)
+	dependsOn = slices.Compact(dependsOn)
+
+	for _, parentId := range dependsOn {
+		parent, err := d.backendRepo.GetTaskByWorkspace(ctx, parentId, authInfo.Workspace)
+		if err != nil || parent == nil {
+			return nil, fmt.Errorf("invalid task dependency: %v", parentId)
+		}
+	}
+
+	taskFactory, exists := d.executors.Get(executor)
+	if !exists {
+		return nil, fmt.Errorf("invalid task executor: %v", executor)
+	}
+
+	stub, err := d.backendRepo.GetStubByExternalId(ctx, stubId)
+	if err != nil {
+		return nil, err
+	}
+
+	taskMessage := d.getTaskMessage()
+	taskMessage.TaskId = taskId
+	taskMessage.Executor = executor
+	taskMessage.WorkspaceName = authInfo.Workspace.Name
+	taskMessage.StubId = stubId
+	taskMessage.Args = payload.Args
+	taskMessage.Kwargs = payload.Kwargs
+	taskMessage.Policy = policy
+	taskMessage.DependsOn = dependsOn
+	taskMessage.Timestamp = time.Now().Unix()
+
+	defer d.releaseTaskMessage(taskMessage)
+	task, err := taskFactory(ctx, *taskMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := d.backendRepo.CreateTask(ctx, &types.TaskParams{
+		TaskId:      taskId,
+		WorkspaceId: stub.WorkspaceId,
+		StubId:      stub.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dependsOn) > 0 {
+		t.Status = types.TaskStatusWaiting
+		_, err = d.backendRepo.UpdateTask(ctx, taskId, *t)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.backendRepo.CreateTaskDependencies(ctx, taskId, dependsOn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msg, err := taskMessage.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.NotBefore.After(time.Now()) {
+		err = d.taskRepo.SetDelayedTaskState(ctx, authInfo.Workspace.Name, stubId, taskId, msg, policy.NotBefore)
+	} else {
+		err = d.taskRepo.SetWaitingTaskState(ctx, authInfo.Workspace.Name, stubId, taskId, msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
 func (d *Dispatcher) Complete(ctx context.Context, workspaceName, stubId, taskId string) error {
+	d.taskRepo.DeleteDelayedTaskState(ctx, workspaceName, stubId, taskId)
+	d.taskRepo.DeleteWaitingTaskState(ctx, workspaceName, stubId, taskId)
 	return d.taskRepo.DeleteTaskState(ctx, workspaceName, stubId, taskId)
 }
 
-func (d *Dispatcher) Claim(ctx context.Context, workspaceName, stubId, taskId, containerId string) error {
-	return d.taskRepo.ClaimTask(ctx, workspaceName, stubId, taskId, containerId)
+type dependencyState int
+
+const (
+	dependenciesPending dependencyState = iota
+	dependenciesComplete
+	dependenciesFailed
+)
+
+// resolveDependencies returns the state of a waiting task from the tasks it depends on. A dependency
+// that is missing, or that finished without completing, fails the waiting task.
+func resolveDependencies(parents []types.Task, expected int) dependencyState {
+	if len(parents) < expected {
+		return dependenciesFailed
+	}
+
+	state := dependenciesComplete
+	for _, parent := range parents {
+		switch {
+		case parent.Status == types.TaskStatusComplete:
+		case parent.Status.IsCompleted():
+			return dependenciesFailed
+		default:
+			state = dependenciesPending
+		}
+	}
+
+	return state
 }
 
-func (d *Dispatcher) monitor(ctx context.Context) {
-	monitorRate := time.Duration(5) * time.Second
-	ticker := time.NewTicker(monitorRate)
-	defer ticker.Stop()
+// processDelayedTasks releases delayed tasks that are due, or moves them to the WAITING state if
+// they depend on other tasks
+func (d *Dispatcher) processDelayedTasks(ctx context.Context) {
+	tasks, err := d.taskRepo.GetDueTasks(ctx, time.Now())
+	if err != nil {
+		return
+	}
+
+	for _, taskMessage := range tasks {
+		// The task is read before it's claimed, so that it stays delayed and is tried again if it can't be read
+		task, err := d.backendRepo.GetTask(ctx, taskMessage.TaskId)
+		if err != nil {
+			log.Printf("<dispatcher> unable to get delayed task: %s, %v\n", taskMessage.TaskId, err)
+			continue
+		}
+
+		claimed, err := d.taskRepo.DeleteDelayedTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+		if err != nil || !claimed {
+			continue
+		}
+
+		// Cancelled while it was delayed
+		if task.Status.IsCompleted() {
+			continue
+		}
 
+		if len(taskMessage.DependsOn) > 0 {
+			msg, err := taskMessage.Encode()
+			if err != nil {
+				continue
+			}
+
+			err = d.taskRepo.SetWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId, msg)
+			if err != nil {
+				log.Printf("<dispatcher> unable to hold delayed task: %s, %v\n", taskMessage.TaskId, err)
+			}
+
+			continue
+		}
+
+		err = d.release(ctx, taskMessage, nil)
+		if err != nil {
+			log.Printf("<dispatcher> unable to release task: %s, %v\n", taskMessage.TaskId, err)
+		}
+	}
+}
+
+// processWaitingTasks releases waiting tasks whose dependencies are complete, and cancels those with a
+// failed dependency. Tasks are processed until nothing changes, so a failure cascades through the graph.
+func (d *Dispatcher) processWaitingTasks(ctx context.Context) {
 	for {
-		select {
-		case <-ctx.Done():
+		tasks, err := d.taskRepo.GetWaitingTasks(ctx)
+		if err != nil {
 			return
-		case <-ticker.C:
-			tasks, err := d.taskRepo.GetTasksInFlight(ctx)
+		}
+
+		changed := false
+		for _, taskMessage := range tasks {
+			task, err := d.backendRepo.GetTask(ctx, taskMessage.TaskId)
 			if err != nil {
 				continue
 			}
 
-			for _, taskMessage := range tasks {
-				taskFactory, exists := d.executors.Get(taskMessage.Executor)
-				if !exists {
-					d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
-					continue
-				}
+			// Cancelled while it was waiting
+			if task.Status.IsCompleted() {
+				d.taskRepo.DeleteWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+				changed = true
+				continue
+			}
 
-				task, err := taskFactory(ctx, *taskMessage)
+			parents, err := d.backendRepo.GetTaskDependencies(ctx, taskMessage.TaskId)
+			if err != nil {
+				continue
+			}
+
+			switch resolveDependencies(parents, len(taskMessage.DependsOn)) {
+			case dependenciesComplete:
+				err = d.release(ctx, taskMessage, parents)
 				if err != nil {
+					log.Printf("<dispatcher> unable to release task: %s, %v\n", taskMessage.TaskId, err)
 					continue
 				}
-
-				claimed, err := d.taskRepo.IsClaimed(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+			case dependenciesFailed:
+				err = d.cancelWaiting(ctx, taskMessage)
 				if err != nil {
+					log.Printf("<dispatcher> unable to cancel task: %s, %v\n", taskMessage.TaskId, err)
 					continue
 				}
+			default:
+				continue
+			}
 
-				if !claimed {
-					if time.Now().After(taskMessage.Policy.Expires) {
-						err = task.Cancel(ctx, types.TaskExpired)
-						if err != nil {
-							log.Printf("<dispatcher> unable to cancel task: %s, %v\n", task.Metadata().TaskId, err)
-						}
+			changed = true
+		}
 
-						d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
-					}
+		if !changed {
+			return
+		}
+	}
+}
 
-					continue
-				}
+// release executes a held task, passing it references to the outputs of any tasks it depended on. Tasks
+// that were held for a retry are retried rather than executed again.
+func (d *Dispatcher) release(ctx context.Context, taskMessage *types.TaskMessage, parents []types.Task) error {
+	taskFactory, exists := d.executors.Get(taskMessage.Executor)
+	if !exists {
+		return d.taskRepo.DeleteWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+	}
 
-				heartbeat, err := task.HeartBeat(ctx)
-				if err != nil {
-					continue
-				}
+	parentOutputs := map[string]interface{}{}
+	for _, parent := range parents {
+		parentWithRelated, err := d.backendRepo.GetTaskWithRelated(ctx, parent.ExternalId)
+		if err != nil || parentWithRelated == nil {
+			return fmt.Errorf("unable to get task dependency <%s>: %v", parent.ExternalId, err)
+		}
 
-				if !heartbeat && claimed {
-					// Hit retry limit, cancel task and resolve
-					if taskMessage.Retries >= taskMessage.Policy.MaxRetries {
-						log.Printf("<dispatcher> hit retry limit, not reinserting task <%s> into queue: %s\n", taskMessage.TaskId, taskMessage.StubId)
-
-						err := task.Cancel(ctx, types.TaskExceededRetryLimit)
-						if err != nil {
-							log.Printf("<dispatcher> unable to cancel task: %s, %v\n", task.Metadata().TaskId, err)
-							continue
-						}
-
-						d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
-						continue
-					}
-
-					// Retry task
-					log.Printf("<dispatcher> missing heartbeat, reinserting task<%s:%s> into queue: %s\n",
-						taskMessage.WorkspaceName, taskMessage.TaskId, taskMessage.StubId)
-
-					taskMessage.Retries += 1
-					taskMessage.Timestamp = time.Now().Unix()
-
-					msg, err := taskMessage.Encode()
-					if err != nil {
-						continue
-					}
-
-					err = d.taskRepo.SetTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId, msg)
-					if err != nil {
-						continue
-					}
-
-					err = task.Retry(ctx)
-					if err != nil {
-						log.Printf("<dispatcher> retry failed: %+v\n", err)
-						continue
-					}
-				}
-			}
+		outputs := []map[string]string{}
+		for outputId, fileName := range output.GetTaskOutputFiles(taskMessage.WorkspaceName, parentWithRelated) {
+			outputs = append(outputs, map[string]string{"id": outputId, "name": fileName})
 		}
+		sort.Slice(outputs, func(i, j int) bool { return outputs[i]["id"] < outputs[j]["id"] })
+
+		parentOutputs[parent.ExternalId] = outputs
 	}
+
+	if len(parents) > 0 {
+		if taskMessage.Kwargs == nil {
+			taskMessage.Kwargs = make(map[string]interface{})
+		}
+		taskMessage.Kwargs["parent_outputs"] = parentOutputs
+	}
+
+	// Time spent held doesn't count towards the task's expiration
+	waited := time.Since(time.Unix(taskMessage.Timestamp, 0))
+	taskMessage.Policy.Expires = taskMessage.Policy.Expires.Add(waited)
+	taskMessage.Timestamp = time.Now().Unix()
+
+	// Dependencies are resolved, so a retry of the task is released as soon as its backoff has passed
+	taskMessage.DependsOn = nil
+
+	msg, err := taskMessage.Encode()
+	if err != nil {
+		return err
+	}
+
+	err = d.taskRepo.SetTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId, msg)
+	if err != nil {
+		return err
+	}
+
+	err = d.taskRepo.DeleteWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+	if err != nil {
+		return err
+	}
+
+	task, err := taskFactory(ctx, *taskMessage)
+	if err != nil {
+		return err
+	}
+
+	if taskMessage.Retries > 0 {
+		err = task.Retry(ctx)
+	} else {
+		err = task.Execute(ctx)
+	}
+	if err != nil {
+		task.Cancel(ctx, types.TaskSchedulingFailed)
+		d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+		return err
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) cancelWaiting(ctx context.Context, taskMessage *types.TaskMessage) error {
+	taskFactory, exists := d.executors.Get(taskMessage.Executor)
+	if !exists {
+		return d.taskRepo.DeleteWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+	}
+
+	task, err := taskFactory(ctx, *taskMessage)
+	if err != nil {
+		return err
+	}
+
+	err = task.Cancel(ctx, types.TaskDependencyFailed)
+	if err != nil {
+		return err
+	}
+
+	return d.taskRepo.DeleteWaitingTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+}
+
+// RetryFailedTask schedules another attempt of a task that failed with an error reported by its runner. It
+// returns false if the task's policy doesn't retry errors of that kind, or the task is out of retries.
+func (d *Dispatcher) RetryFailedTask(ctx context.Context, workspaceName, stubId, taskId, containerId, errorKind, errorMessage string) (bool, error) {
+	if errorKind == "" {
+		return false, nil
+	}
+
+	taskMessage, err := d.taskRepo.GetTaskState(ctx, workspaceName, stubId, taskId)
+	if err != nil {
+		return false, err
+	}
+
+	if !taskMessage.Policy.IsRetryable(errorKind) {
+		return false, nil
+	}
+
+	if taskMessage.Retries >= taskMessage.Policy.MaxRetries {
+		d.deadLetter(ctx, taskMessage, types.TaskExceededRetryLimit, fmt.Sprintf("%s: %s", errorKind, errorMessage))
+		return false, nil
+	}
+
+	err = d.scheduleRetry(ctx, taskMessage, types.TaskRetry{
+		ContainerId: containerId,
+		ErrorKind:   errorKind,
+		Error:       errorMessage,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// scheduleRetry records a failed attempt of an in-flight task, and moves it to the delayed set until
+// its backoff has passed
+func (d *Dispatcher) scheduleRetry(ctx context.Context, taskMessage *types.TaskMessage, retry types.TaskRetry) error {
+	task, err := d.backendRepo.GetTask(ctx, taskMessage.TaskId)
+	if err != nil {
+		return err
+	}
+
+	if retry.ContainerId == "" {
+		retry.ContainerId = task.ContainerId
+	}
+
+	delay := taskMessage.Policy.RetryDelay(taskMessage.Retries, rand.Float64())
+
+	taskMessage.Retries += 1
+	taskMessage.Timestamp = time.Now().Unix()
+
+	retry.Attempt = taskMessage.Retries
+	retry.RetriedAt = time.Now()
+
+	err = d.backendRepo.AppendTaskRetry(ctx, taskMessage.TaskId, retry)
+	if err != nil {
+		return err
+	}
+
+	task.Status = types.TaskStatusRetry
+	_, err = d.backendRepo.UpdateTask(ctx, taskMessage.TaskId, *task)
+	if err != nil {
+		return err
+	}
+
+	msg, err := taskMessage.Encode()
+	if err != nil {
+		return err
+	}
+
+	err = d.taskRepo.SetDelayedTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId, msg, time.Now().Add(delay))
+	if err != nil {
+		return err
+	}
+
+	return d.taskRepo.DeleteTaskState(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+}
+
+// deadLetter keeps a task that ran out of retries in its stub's dead-letter queue, so that it can be
+// inspected and re-driven. Only task queues have a dead-letter queue.
+func (d *Dispatcher) deadLetter(ctx context.Context, taskMessage *types.TaskMessage, reason types.TaskCancellationReason, errorMessage string) {
+	if taskMessage.Executor != string(types.ExecutorTaskQueue) {
+		return
+	}
+
+	err := d.taskRepo.AddDeadLetterTask(ctx, taskMessage.WorkspaceName, taskMessage.StubId, &types.DeadLetterTask{
+		TaskMessage:    *taskMessage,
+		Reason:         reason,
+		Error:          errorMessage,
+		DeadLetteredAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("<dispatcher> unable to dead-letter task: %s, %v\n", taskMessage.TaskId, err)
+	}
+}
+
+// Redrive moves a task out of its stub's dead-letter queue and runs it again, with its retries reset
+func (d *Dispatcher) Redrive(ctx context.Context, workspaceName, stubId, taskId string, expires time.Time) error {
+	deadLetterTask, err := d.taskRepo.GetDeadLetterTask(ctx, workspaceName, stubId, taskId)
+	if err != nil {
+		return err
+	}
+
+	if deadLetterTask == nil {
+		return ErrTaskNotDeadLettered
+	}
+
+	claimed, err := d.taskRepo.DeleteDeadLetterTask(ctx, workspaceName, stubId, taskId)
+	if err != nil {
+		return err
+	}
+
+	// Re-driven or purged by another request
+	if !claimed {
+		return ErrTaskNotDeadLettered
+	}
+
+	taskMessage := deadLetterTask.TaskMessage
+	taskMessage.Retries = 0
+	taskMessage.Policy.Expires = expires
+	taskMessage.Policy.NotBefore = time.Time{}
+	taskMessage.Timestamp = time.Now().Unix()
+
+	err = d.release(ctx, &taskMessage, nil)
+	if err != nil {
+		// Keep the task so the re-drive can be tried again
+		d.taskRepo.AddDeadLetterTask(ctx, workspaceName, stubId, deadLetterTask)
+		return err
+	}
+
+	return nil
+}
+
+// TimedOut reports whether a task was marked as timed out. Timeouts enforced by the dispatcher are signalled
+// on the task's cancel channel, so monitors use this to tell them apart from cancellations.
+func (d *Dispatcher) TimedOut(ctx context.Context, taskId string) bool {
+	task, err := d.backendRepo.GetTask(ctx, taskId)
+	if err != nil {
+		return false
+	}
+
+	return task.Status == types.TaskStatusTimeout
+}
+
+func (d *Dispatcher) Claim(ctx context.Context, workspaceName, stubId, taskId, containerId string) error {
+	return d.taskRepo.ClaimTask(ctx, workspaceName, stubId, taskId, containerId)
+}
+
+func (d *Dispatcher) IsClaimed(ctx context.Context, workspaceName, stubId, taskId string) (bool, error) {
+	return d.taskRepo.IsClaimed(ctx, workspaceName, stubId, taskId)
 }
@@ -0,0 +1,181 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/beam-cloud/beta9/pkg/auth"
+	"github.com/beam-cloud/beta9/pkg/common"
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+func TestResolveDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []types.TaskStatus
+		expected int
+		want     dependencyState
+	}{
+		{
+			name:     "all complete",
+			statuses: []types.TaskStatus{types.TaskStatusComplete, types.TaskStatusComplete},
+			expected: 2,
+			want:     dependenciesComplete,
+		},
+		{
+			name:     "one running",
+			statuses: []types.TaskStatus{types.TaskStatusComplete, types.TaskStatusRunning},
+			expected: 2,
+			want:     dependenciesPending,
+		},
+		{
+			name:     "one waiting",
+			statuses: []types.TaskStatus{types.TaskStatusWaiting},
+			expected: 1,
+			want:     dependenciesPending,
+		},
+		{
+			name:     "one failed",
+			statuses: []types.TaskStatus{types.TaskStatusRunning, types.TaskStatusError},
+			expected: 2,
+			want:     dependenciesFailed,
+		},
+		{
+			name:     "one cancelled",
+			statuses: []types.TaskStatus{types.TaskStatusComplete, types.TaskStatusCancelled},
+			expected: 2,
+			want:     dependenciesFailed,
+		},
+		{
+			name:     "missing dependency",
+			statuses: []types.TaskStatus{types.TaskStatusComplete},
+			expected: 2,
+			want:     dependenciesFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parents := []types.Task{}
+			for _, status := range tt.statuses {
+				parents = append(parents, types.Task{Status: status})
+			}
+
+			if got := resolveDependencies(parents, tt.expected); got != tt.want {
+				t.Errorf("resolveDependencies() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+const benchmarkTaskCount = 100
+
+func benchmarkDispatcher(b *testing.B) *Dispatcher {
+	rdb, err := repository.NewRedisClientForTest()
+	if err != nil {
+		b.Fatalf("Unable to create redis client: %v", err)
+	}
+
+	d := &Dispatcher{
+		taskRepo:  repository.NewTaskRedisRepository(rdb),
+		executors: common.NewSafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)](),
+	}
+	d.Register("executor", func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error) {
+		return nil, nil
+	})
+
+	return d
+}
+
+func benchmarkTaskPayloads() []*types.TaskPayload {
+	payloads := make([]*types.TaskPayload, 0, benchmarkTaskCount)
+	for i := 0; i < benchmarkTaskCount; i++ {
+		payloads = append(payloads, &types.TaskPayload{Args: []interface{}{i}})
+	}
+
+	return payloads
+}
+
+func BenchmarkDispatcherSend(b *testing.B) {
+	d := benchmarkDispatcher(b)
+	ctx := context.Background()
+	authInfo := &auth.AuthInfo{Workspace: &types.Workspace{Name: "workspace"}}
+	payloads := benchmarkTaskPayloads()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, payload := range payloads {
+			if _, err := d.Send(ctx, "executor", authInfo, "stub", payload, types.TaskPolicy{}); err != nil {
+				b.Fatalf("Unable to send task: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkDispatcherSendBatch(b *testing.B) {
+	d := benchmarkDispatcher(b)
+	ctx := context.Background()
+	authInfo := &auth.AuthInfo{Workspace: &types.Workspace{Name: "workspace"}}
+	payloads := benchmarkTaskPayloads()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := d.SendBatch(ctx, "executor", authInfo, "stub", payloads, types.TaskPolicy{}); err != nil {
+			b.Fatalf("Unable to send tasks: %v", err)
+		}
+	}
+}
+
+func TestProcessDelayedTasksKeepsUnreadTasks(t *testing.T) {
+	rdb, err := repository.NewRedisClientForTest()
+	if err != nil {
+		t.Fatalf("Unable to create redis client: %v", err)
+	}
+
+	backendRepo, mock := repository.NewBackendPostgresRepositoryForTest()
+	d := &Dispatcher{
+		taskRepo:    repository.NewTaskRedisRepository(rdb),
+		backendRepo: backendRepo,
+		executors:   common.NewSafeMap[func(ctx context.Context, message types.TaskMessage) (types.TaskInterface, error)](),
+	}
+
+	ctx := context.Background()
+	msg, err := (&types.TaskMessage{TaskId: "task-1", StubId: "stub", WorkspaceName: "workspace"}).Encode()
+	if err != nil {
+		t.Fatalf("Unable to encode task: %v", err)
+	}
+
+	err = d.taskRepo.SetDelayedTaskState(ctx, "workspace", "stub", "task-1", msg, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatalf("Unable to delay task: %v", err)
+	}
+
+	// A task that can't be read stays delayed
+	mock.ExpectQuery("SELECT (.+) FROM task").WillReturnError(errors.New("connection reset"))
+	d.processDelayedTasks(ctx)
+
+	tasks, err := d.taskRepo.GetDueTasks(ctx, time.Now())
+	if err != nil || len(tasks) != 1 {
+		t.Fatalf("Expected the task to still be delayed, got %d tasks: %v", len(tasks), err)
+	}
+
+	// Once it's read, it's claimed, and a task that was cancelled while it was delayed is dropped
+	mock.ExpectQuery("SELECT (.+) FROM task").
+		WillReturnRows(sqlmock.NewRows([]string{"external_id", "status"}).AddRow("task-1", types.TaskStatusCancelled))
+	d.processDelayedTasks(ctx)
+
+	tasks, err = d.taskRepo.GetDueTasks(ctx, time.Now())
+	if err != nil || len(tasks) != 0 {
+		t.Fatalf("Expected no delayed tasks, got %d tasks: %v", len(tasks), err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package task
+
+import (
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/repository"
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+type DispatcherMetrics struct {
+	metricsRepo repository.MetricsRepository
+}
+
+func NewDispatcherMetrics(metricsRepo repository.MetricsRepository) DispatcherMetrics {
+	return DispatcherMetrics{
+		metricsRepo: metricsRepo,
+	}
+}
+
+func (dm *DispatcherMetrics) GaugeShardLag(shard int, lag time.Duration) {
+	if dm.metricsRepo == nil {
+		return
+	}
+
+	dm.metricsRepo.SetGauge(types.MetricsTaskMonitorShardLag, map[string]interface{}{
+		"shard": shard,
+	}, lag.Seconds())
+}
+
+func (dm *DispatcherMetrics) GaugeShardsOwned(monitorId string, shards int) {
+	if dm.metricsRepo == nil {
+		return
+	}
+
+	dm.metricsRepo.SetGauge(types.MetricsTaskMonitorShardsOwned, map[string]interface{}{
+		"monitor_id": monitorId,
+	}, float64(shards))
+}
+
+func (dm *DispatcherMetrics) CounterIncTasksScanned(tasks int) {
+	if dm.metricsRepo == nil || tasks == 0 {
+		return
+	}
+
+	dm.metricsRepo.IncrementCounter(types.MetricsTaskMonitorTasksScanned, map[string]interface{}{}, float64(tasks))
+}
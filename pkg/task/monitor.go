@@ -0,0 +1,234 @@
+package task
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/beam-cloud/beta9/pkg/types"
+)
+
+const (
+	defaultMonitorScanInterval  time.Duration = time.Second
+	defaultMonitorScanBatchSize int64         = 500
+	defaultMonitorLeaseTTL      time.Duration = 15 * time.Second
+	heldTaskProcessingInterval  time.Duration = 5 * time.Second
+)
+
+func monitorConfigWithDefaults(config types.TaskMonitorConfig) types.TaskMonitorConfig {
+	if config.ScanInterval <= 0 {
+		config.ScanInterval = defaultMonitorScanInterval
+	}
+
+	if config.ScanBatchSize <= 0 {
+		config.ScanBatchSize = defaultMonitorScanBatchSize
+	}
+
+	if config.LeaseTTL <= 0 {
+		config.LeaseTTL = defaultMonitorLeaseTTL
+	}
+
+	return config
+}
+
+// shardScan is the progress of an incremental scan over a shard of in-flight tasks
+type shardScan struct {
+	cursor    uint64
+	startedAt time.Time
+}
+
+// monitor checks on in-flight tasks. The task state shards are split between gateway replicas with leases,
+// and each replica scans its shards a page at a time, so a pass over a shard can span several ticks.
+func (d *Dispatcher) monitor(ctx context.Context) {
+	migrated, err := d.taskRepo.MigrateTaskIndex(ctx)
+	if err != nil {
+		log.Printf("<dispatcher> unable to migrate task index: %v\n", err)
+	} else if migrated > 0 {
+		log.Printf("<dispatcher> migrated %d tasks to the sharded task index\n", migrated)
+	}
+
+	scanTicker := time.NewTicker(d.monitorConfig.ScanInterval)
+	defer scanTicker.Stop()
+
+	leaseTicker := time.NewTicker(d.monitorConfig.LeaseTTL / 3)
+	defer leaseTicker.Stop()
+
+	heldTaskTicker := time.NewTicker(heldTaskProcessingInterval)
+	defer heldTaskTicker.Stop()
+
+	scans := map[int]*shardScan{}
+	d.balanceShards(ctx, scans)
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.releaseShards(scans)
+			return
+		case <-leaseTicker.C:
+			d.balanceShards(ctx, scans)
+		case <-heldTaskTicker.C:
+			// Delayed and waiting tasks aren't sharded, so only the owner of the first shard releases them
+			if _, ok := scans[0]; ok {
+				d.processDelayedTasks(ctx)
+				d.processWaitingTasks(ctx)
+			}
+		case <-scanTicker.C:
+			for shard, scan := range scans {
+				d.scanShard(ctx, shard, scan)
+			}
+		}
+	}
+}
+
+// balanceShards renews the leases this replica holds, and takes or gives up shards so that each live
+// replica monitors an even share of them
+func (d *Dispatcher) balanceShards(ctx context.Context, scans map[int]*shardScan) {
+	monitors, err := d.taskRepo.RegisterTaskMonitor(ctx, d.monitorId, d.monitorConfig.LeaseTTL)
+	if err != nil {
+		log.Printf("<dispatcher> unable to register task monitor: %v\n", err)
+		return
+	}
+	target := (types.TaskStateShards + max(monitors, 1) - 1) / max(monitors, 1)
+
+	for shard := range scans {
+		held, err := d.taskRepo.AcquireTaskShardLease(ctx, shard, d.monitorId, d.monitorConfig.LeaseTTL)
+		if err != nil || !held {
+			delete(scans, shard)
+		}
+	}
+
+	for shard := range scans {
+		if len(scans) <= target {
+			break
+		}
+
+		d.taskRepo.ReleaseTaskShardLease(ctx, shard, d.monitorId)
+		delete(scans, shard)
+	}
+
+	// Start at a random shard, so replicas don't all contend for the same free shards
+	offset := rand.Intn(types.TaskStateShards)
+	for i := 0; i < types.TaskStateShards && len(scans) < target; i++ {
+		shard := (offset + i) % types.TaskStateShards
+		if _, ok := scans[shard]; ok {
+			continue
+		}
+
+		held, err := d.taskRepo.AcquireTaskShardLease(ctx, shard, d.monitorId, d.monitorConfig.LeaseTTL)
+		if err != nil || !held {
+			continue
+		}
+
+		scans[shard] = &shardScan{startedAt: time.Now()}
+	}
+
+	d.metrics.GaugeShardsOwned(d.monitorId, len(scans))
+}
+
+// releaseShards gives up this replica's shards on shutdown, so other replicas can take them over right away
+func (d *Dispatcher) releaseShards(scans map[int]*shardScan) {
+	ctx := context.Background()
+	for shard := range scans {
+		d.taskRepo.ReleaseTaskShardLease(ctx, shard, d.monitorId)
+	}
+
+	d.taskRepo.DeregisterTaskMonitor(ctx, d.monitorId)
+}
+
+// scanShard checks the next page of tasks in a shard. When a pass over the shard completes, its duration is
+// reported as the shard's lag, the longest a task in the shard can go unchecked.
+func (d *Dispatcher) scanShard(ctx context.Context, shard int, scan *shardScan) {
+	tasks, cursor, err := d.taskRepo.ScanTasksInFlight(ctx, shard, scan.cursor, d.monitorConfig.ScanBatchSize)
+	if err != nil {
+		log.Printf("<dispatcher> unable to scan task shard <%d>: %v\n", shard, err)
+		return
+	}
+
+	for _, taskMessage := range tasks {
+		d.checkTask(ctx, taskMessage)
+	}
+	d.metrics.CounterIncTasksScanned(len(tasks))
+
+	scan.cursor = cursor
+	if cursor == 0 {
+		d.metrics.GaugeShardLag(shard, time.Since(scan.startedAt))
+		scan.startedAt = time.Now()
+	}
+}
+
+// checkTask expires, times out, or retries an in-flight task as needed
+func (d *Dispatcher) checkTask(ctx context.Context, taskMessage *types.TaskMessage) {
+	taskFactory, exists := d.executors.Get(taskMessage.Executor)
+	if !exists {
+		d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+		return
+	}
+
+	task, err := taskFactory(ctx, *taskMessage)
+	if err != nil {
+		return
+	}
+
+	claimed, err := d.taskRepo.IsClaimed(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+	if err != nil {
+		return
+	}
+
+	if !claimed {
+		if time.Now().After(taskMessage.Policy.Expires) {
+			err = task.Cancel(ctx, types.TaskExpired)
+			if err != nil {
+				log.Printf("<dispatcher> unable to cancel task: %s, %v\n", task.Metadata().TaskId, err)
+			}
+
+			d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+		}
+
+		return
+	}
+
+	claim, err := d.taskRepo.GetTaskClaim(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+	if err == nil && claim != nil && claim.TimedOut(taskMessage.Policy, time.Now()) {
+		log.Printf("<dispatcher> task <%s> exceeded its timeout of %ds, stopping it\n", taskMessage.TaskId, taskMessage.Policy.Timeout)
+
+		err = task.Cancel(ctx, types.TaskTimedOut)
+		if err != nil {
+			log.Printf("<dispatcher> unable to time out task: %s, %v\n", task.Metadata().TaskId, err)
+			return
+		}
+
+		d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+		return
+	}
+
+	heartbeat, err := task.HeartBeat(ctx)
+	if err != nil {
+		return
+	}
+
+	if !heartbeat {
+		// Hit retry limit, cancel task and resolve
+		if taskMessage.Retries >= taskMessage.Policy.MaxRetries {
+			log.Printf("<dispatcher> hit retry limit, not reinserting task <%s> into queue: %s\n", taskMessage.TaskId, taskMessage.StubId)
+
+			err := task.Cancel(ctx, types.TaskExceededRetryLimit)
+			if err != nil {
+				log.Printf("<dispatcher> unable to cancel task: %s, %v\n", task.Metadata().TaskId, err)
+				return
+			}
+
+			d.deadLetter(ctx, taskMessage, types.TaskExceededRetryLimit, "missing heartbeat")
+			d.Complete(ctx, taskMessage.WorkspaceName, taskMessage.StubId, taskMessage.TaskId)
+			return
+		}
+
+		log.Printf("<dispatcher> missing heartbeat, scheduling retry of task<%s:%s>: %s\n",
+			taskMessage.WorkspaceName, taskMessage.TaskId, taskMessage.StubId)
+
+		err = d.scheduleRetry(ctx, taskMessage, types.TaskRetry{Error: "missing heartbeat"})
+		if err != nil {
+			log.Printf("<dispatcher> retry failed: %+v\n", err)
+		}
+	}
+}
@@ -3,12 +3,29 @@ package task
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/beam-cloud/beta9/pkg/types"
 	"github.com/labstack/echo/v4"
 )
 
+// IdempotencyKeyHeader is the header that holds a task submission's idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// DependsOnHeader is the header that holds a comma separated list of tasks that must complete before a submitted
+// task runs. Options like this are kept out of the body, so that they never collide with a task's own kwargs.
+const DependsOnHeader = "Depends-On"
+
+// RunAtHeader and DelaySecondsHeader delay a submitted task, until an RFC 3339 timestamp or by a number of seconds
+const (
+	RunAtHeader        = "Run-At"
+	DelaySecondsHeader = "Delay-Seconds"
+)
+
 func SerializeHttpPayload(ctx echo.Context) (*types.TaskPayload, error) {
 	defer ctx.Request().Body.Close()
 
@@ -27,6 +44,74 @@ func SerializeHttpPayload(ctx echo.Context) (*types.TaskPayload, error) {
 		return nil, errors.New("invalid request payload")
 	}
 
+	return serializePayload(payload)
+}
+
+// SerializeHttpPayloads decodes a batch of task payloads from a request body of the form {"tasks": [...]}.
+// Each task is decoded like a single payload, and can also set its own 'idempotency_key'.
+func SerializeHttpPayloads(ctx echo.Context) ([]*types.TaskPayload, error) {
+	defer ctx.Request().Body.Close()
+
+	var batch struct {
+		Tasks []map[string]interface{} `json:"tasks"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&batch); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	taskPayloads := make([]*types.TaskPayload, 0, len(batch.Tasks))
+	for _, payload := range batch.Tasks {
+		var idempotencyKey string
+		if key, ok := payload["idempotency_key"].(string); ok {
+			idempotencyKey = key
+			delete(payload, "idempotency_key")
+		}
+
+		taskPayload, err := serializePayload(payload)
+		if err != nil {
+			return nil, err
+		}
+		taskPayload.IdempotencyKey = idempotencyKey
+
+		taskPayloads = append(taskPayloads, taskPayload)
+	}
+
+	return taskPayloads, nil
+}
+
+// SerializeHttpTaskOptions reads the options a task is submitted with from a request's headers. Only task queues
+// and functions take these, endpoint requests are passed on as they are.
+func SerializeHttpTaskOptions(ctx echo.Context, payload *types.TaskPayload) error {
+	header := ctx.Request().Header
+
+	payload.IdempotencyKey = header.Get(IdempotencyKeyHeader)
+
+	for _, taskId := range strings.Split(header.Get(DependsOnHeader), ",") {
+		if taskId = strings.TrimSpace(taskId); taskId != "" {
+			payload.DependsOn = append(payload.DependsOn, taskId)
+		}
+	}
+
+	if runAt := header.Get(RunAtHeader); runAt != "" {
+		t, err := time.Parse(time.RFC3339, runAt)
+		if err != nil {
+			return fmt.Errorf("invalid %s header, expected an RFC 3339 timestamp", RunAtHeader)
+		}
+		payload.RunAt = &t
+	}
+
+	if delaySeconds := header.Get(DelaySecondsHeader); delaySeconds != "" {
+		seconds, err := strconv.ParseInt(delaySeconds, 10, 64)
+		if err != nil || seconds < 0 {
+			return fmt.Errorf("invalid %s header, expected a number of seconds", DelaySecondsHeader)
+		}
+		payload.DelaySeconds = seconds
+	}
+
+	return nil
+}
+
+func serializePayload(payload map[string]interface{}) (*types.TaskPayload, error) {
 	// Handle empty JSON object
 	if len(payload) == 0 {
 		return &types.TaskPayload{
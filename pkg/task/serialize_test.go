@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/beam-cloud/beta9/pkg/types"
 	"github.com/labstack/echo/v4"
@@ -80,6 +81,24 @@ func TestSerializeHttpPayload(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "depends on kwarg",
+			body: `{"args": [1], "depends_on": ["task1", "task2"]}`,
+			wantPayload: &types.TaskPayload{
+				Args:   []interface{}{1.0},
+				Kwargs: map[string]interface{}{"depends_on": []interface{}{"task1", "task2"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delay kwargs",
+			body: `{"args": [1], "delay_seconds": 60, "run_at": "tomorrow"}`,
+			wantPayload: &types.TaskPayload{
+				Args:   []interface{}{1.0},
+				Kwargs: map[string]interface{}{"delay_seconds": 60.0, "run_at": "tomorrow"},
+			},
+			wantErr: false,
+		},
 		{
 			name:        "malformed json",
 			body:        `{"args": [1, 2, 3}`,
@@ -111,3 +130,87 @@ func TestSerializeHttpPayload(t *testing.T) {
 		})
 	}
 }
+
+func TestSerializeHttpPayloads(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantPayloads []*types.TaskPayload
+		wantErr      bool
+	}{
+		{
+			name:         "empty batch",
+			body:         `{"tasks": []}`,
+			wantPayloads: []*types.TaskPayload{},
+			wantErr:      false,
+		},
+		{
+			name: "mixed tasks",
+			body: `{"tasks": [{}, {"args": [1]}, {"mykwarg": 1, "idempotency_key": "key1"}, {"args": [2], "delay_seconds": 30}]}`,
+			wantPayloads: []*types.TaskPayload{
+				{Args: nil, Kwargs: map[string]interface{}{}},
+				{Args: []interface{}{1.0}, Kwargs: nil},
+				{Args: nil, Kwargs: map[string]interface{}{"mykwarg": 1.0}, IdempotencyKey: "key1"},
+				{Args: []interface{}{2.0}, Kwargs: map[string]interface{}{"delay_seconds": 30.0}},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "malformed json",
+			body:         `{"tasks": [{"args": [1]}`,
+			wantPayloads: nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := setupEchoContext(tt.body)
+			got, err := SerializeHttpPayloads(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SerializeHttpPayloads() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.wantPayloads) {
+				t.Errorf("SerializeHttpPayloads() got = %+v, want %+v", got, tt.wantPayloads)
+			}
+		})
+	}
+}
+
+func TestSerializeHttpTaskOptions(t *testing.T) {
+	ctx := setupEchoContext(`{"depends_on": ["kwarg"]}`)
+	ctx.Request().Header.Set(IdempotencyKeyHeader, "key")
+	ctx.Request().Header.Set(DependsOnHeader, "task1, task2,")
+	ctx.Request().Header.Set(RunAtHeader, "2030-01-02T03:04:05Z")
+	ctx.Request().Header.Set(DelaySecondsHeader, "60")
+
+	payload, err := SerializeHttpPayload(ctx)
+	if err != nil {
+		t.Fatalf("SerializeHttpPayload() error = %v", err)
+	}
+
+	if err := SerializeHttpTaskOptions(ctx, payload); err != nil {
+		t.Fatalf("SerializeHttpTaskOptions() error = %v", err)
+	}
+
+	runAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	want := &types.TaskPayload{
+		Kwargs:         map[string]interface{}{"depends_on": []interface{}{"kwarg"}},
+		IdempotencyKey: "key",
+		DependsOn:      []string{"task1", "task2"},
+		RunAt:          &runAt,
+		DelaySeconds:   60,
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("SerializeHttpTaskOptions() got = %+v, want %+v", payload, want)
+	}
+
+	for header, value := range map[string]string{RunAtHeader: "tomorrow", DelaySecondsHeader: "soon"} {
+		ctx := setupEchoContext(`{}`)
+		ctx.Request().Header.Set(header, value)
+		if err := SerializeHttpTaskOptions(ctx, &types.TaskPayload{}); err == nil {
+			t.Errorf("SerializeHttpTaskOptions() with %s: %s, expected an error", header, value)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -105,6 +107,7 @@ const (
 	TaskStatusCancelled TaskStatus = "CANCELLED"
 	TaskStatusTimeout   TaskStatus = "TIMEOUT"
 	TaskStatusRetry     TaskStatus = "RETRY"
+	TaskStatusWaiting   TaskStatus = "WAITING" // Held until every task it depends on is complete
 )
 
 type TaskParams struct {
@@ -125,6 +128,77 @@ type Task struct {
 	StubId      uint         `db:"stub_id" json:"stub_id,omitempty"`           // Foreign key to Stub
 	CreatedAt   time.Time    `db:"created_at" json:"created_at,omitempty"`
 	UpdatedAt   time.Time    `db:"updated_at" json:"updated_at,omitempty"`
+
+	RetryHistory TaskRetryHistory  `db:"retry_history" json:"retry_history,omitempty"`
+	Cancellation *TaskCancellation `db:"cancellation" json:"cancellation,omitempty"`
+}
+
+// TaskRetry records a failed attempt of a task that was retried
+type TaskRetry struct {
+	Attempt     uint      `json:"attempt"`
+	ContainerId string    `json:"container_id"`
+	ErrorKind   string    `json:"error_kind,omitempty"`
+	Error       string    `json:"error"`
+	RetriedAt   time.Time `json:"retried_at"`
+}
+
+type TaskRetryHistory []TaskRetry
+
+func (h *TaskRetryHistory) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, h)
+	case string:
+		return json.Unmarshal([]byte(v), h)
+	default:
+		return fmt.Errorf("unsupported retry history type: %T", src)
+	}
+}
+
+func (h TaskRetryHistory) Value() (driver.Value, error) {
+	if h == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(h)
+	return string(data), err
+}
+
+type TaskCancellationOutcome string
+
+const (
+	TaskCancellationPending    TaskCancellationOutcome = "pending"     // Sent to the runner, which hasn't reported back yet
+	TaskCancellationStopped    TaskCancellationOutcome = "stopped"     // The handler stopped within the grace period
+	TaskCancellationKilled     TaskCancellationOutcome = "killed"      // The handler outlived the grace period, so its worker process was restarted
+	TaskCancellationNotRunning TaskCancellationOutcome = "not_running" // The task hadn't started when it was cancelled
+)
+
+// TaskCancellation records why a task was cancelled, and how the runner executing it responded
+type TaskCancellation struct {
+	Reason      TaskCancellationReason  `json:"reason"`
+	Outcome     TaskCancellationOutcome `json:"outcome"`
+	Error       string                  `json:"error,omitempty"` // Why the task was stopped, if it wasn't requested
+	RequestedAt time.Time               `json:"requested_at"`
+	ResolvedAt  *time.Time              `json:"resolved_at,omitempty"`
+}
+
+func (c *TaskCancellation) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported task cancellation type: %T", src)
+	}
+}
+
+func (c TaskCancellation) Value() (driver.Value, error) {
+	data, err := json.Marshal(c)
+	return string(data), err
 }
 
 type TaskWithRelated struct {
@@ -152,6 +226,41 @@ func (t *TaskWithRelated) SanitizeStubConfig() error {
 	return nil
 }
 
+// TaskDependency is an edge in a workflow, TaskId only runs once DependsOnTaskId is complete
+type TaskDependency struct {
+	TaskId          string `db:"task_id" json:"task_id"`
+	DependsOnTaskId string `db:"depends_on_task_id" json:"depends_on_task_id"`
+}
+
+// TaskGraph is every task connected to a task through its dependencies
+type TaskGraph struct {
+	Tasks        []Task           `json:"tasks"`
+	Dependencies []TaskDependency `json:"dependencies"`
+}
+
+// Schedule creates a task for a deployment each time its cron expression is due
+type Schedule struct {
+	Id             uint            `db:"id" json:"-"`
+	ExternalId     string          `db:"external_id" json:"external_id"`
+	CronExpression string          `db:"cron_expression" json:"cron_expression"`
+	Timezone       string          `db:"timezone" json:"timezone"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	Active         bool            `db:"active" json:"active"`
+	NextRunAt      time.Time       `db:"next_run_at" json:"next_run_at"`
+	LastRunAt      sql.NullTime    `db:"last_run_at" json:"last_run_at"`
+	WorkspaceId    uint            `db:"workspace_id" json:"workspace_id"`   // Foreign key to Workspace
+	DeploymentId   uint            `db:"deployment_id" json:"deployment_id"` // Foreign key to Deployment
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+type ScheduleWithRelated struct {
+	Schedule
+	Workspace  Workspace  `db:"workspace" json:"workspace"`
+	Deployment Deployment `db:"deployment" json:"deployment"`
+	Stub       Stub       `db:"stub" json:"stub"`
+}
+
 type TaskCountPerDeployment struct {
 	DeploymentName string `db:"deployment_name" json:"deployment_name"`
 	TaskCount      uint   `db:"task_count" json:"task_count"`
@@ -174,31 +283,62 @@ type TaskStats struct {
 }
 
 type StubConfigV1 struct {
-	Runtime         Runtime      `json:"runtime"`
-	Handler         string       `json:"handler"`
-	OnStart         string       `json:"on_start"`
-	PythonVersion   string       `json:"python_version"`
-	KeepWarmSeconds uint         `json:"keep_warm_seconds"`
-	MaxPendingTasks uint         `json:"max_pending_tasks"`
-	CallbackUrl     string       `json:"callback_url"`
-	TaskPolicy      TaskPolicy   `json:"task_policy"`
-	Workers         uint         `json:"workers"`
-	Authorized      bool         `json:"authorized"`
-	Volumes         []*pb.Volume `json:"volumes"`
-	Secrets         []Secret     `json:"secrets,omitempty"`
-	Autoscaler      *Autoscaler  `json:"autoscaler"`
+	Runtime         Runtime       `json:"runtime"`
+	Handler         string        `json:"handler"`
+	OnStart         string        `json:"on_start"`
+	PythonVersion   string        `json:"python_version"`
+	KeepWarmSeconds uint          `json:"keep_warm_seconds"`
+	MaxPendingTasks uint          `json:"max_pending_tasks"`
+	CallbackUrl     string        `json:"callback_url"`
+	TaskPolicy      TaskPolicy    `json:"task_policy"`
+	Workers         uint          `json:"workers"`
+	Authorized      bool          `json:"authorized"`
+	Volumes         []*pb.Volume  `json:"volumes"`
+	Secrets         []Secret      `json:"secrets,omitempty"`
+	Autoscaler      *Autoscaler   `json:"autoscaler"`
+	Priority        int32         `json:"priority"`
+	LoadBalancer    *LoadBalancer `json:"load_balancer,omitempty"`
+	EndpointMode    EndpointMode  `json:"endpoint_mode,omitempty"`
+}
+
+type EndpointMode string
+
+const (
+	EndpointModeTask EndpointMode = "task" // Request bodies are decoded into the args and kwargs of a task (the default)
+	EndpointModeASGI EndpointMode = "asgi" // Requests and WebSockets are passed through to the stub's ASGI app untouched
+)
+
+type LoadBalancerPolicy string
+
+const (
+	LeastInFlightLoadBalancer  LoadBalancerPolicy = "least_in_flight" // Containers handling the fewest requests
+	PowerOfTwoLoadBalancer     LoadBalancerPolicy = "power_of_two"    // The less busy of two random containers
+	ConsistentHashLoadBalancer LoadBalancerPolicy = "consistent_hash" // Requests with the same hash header go to the same container
+)
+
+// LoadBalancer configures how an endpoint picks the container that handles a request
+type LoadBalancer struct {
+	Policy     LoadBalancerPolicy `json:"policy"`
+	HashHeader string             `json:"hash_header,omitempty"` // Request header hashed by the consistent hash policy
 }
 
 type AutoscalerType string
 
 const (
-	QueueDepthAutoscaler AutoscalerType = "queue_depth"
+	QueueDepthAutoscaler  AutoscalerType = "queue_depth"
+	ConcurrencyAutoscaler AutoscalerType = "concurrency" // Endpoints only, targets in-flight requests per container
+	P95LatencyAutoscaler  AutoscalerType = "p95_latency" // Endpoints only, targets the 95th percentile request duration
+	RPSAutoscaler         AutoscalerType = "rps"         // Endpoints only, targets requests per second per container
 )
 
 type Autoscaler struct {
 	Type              AutoscalerType `json:"type"`
+	MinContainers     uint           `json:"min_containers,omitempty"` // Deployments keep at least this many containers, even without traffic
 	MaxContainers     uint           `json:"max_containers"`
 	TasksPerContainer uint           `json:"tasks_per_container"`
+	TargetConcurrency float64        `json:"target_concurrency,omitempty"`
+	TargetLatencyMs   float64        `json:"target_latency_ms,omitempty"`
+	TargetRPS         float64        `json:"target_rps,omitempty"`
 }
 
 const (
@@ -252,10 +392,11 @@ type Image struct {
 }
 
 type Runtime struct {
-	Cpu     int64   `json:"cpu"`
-	Gpu     GpuType `json:"gpu"`
-	Memory  int64   `json:"memory"`
-	ImageId string  `json:"image_id"`
+	Cpu       int64   `json:"cpu"`
+	Gpu       GpuType `json:"gpu"`
+	GpuMemory int64   `json:"gpu_memory"` // Share a gpu, rather than use a whole one
+	Memory    int64   `json:"memory"`
+	ImageId   string  `json:"image_id"`
 }
 
 type GpuType string
@@ -296,10 +437,37 @@ type ConcurrencyLimit struct {
 	ExternalId        string    `db:"external_id" json:"external_id,omitempty" redis:"external_id"`
 	GPULimit          uint32    `db:"gpu_limit" json:"gpu_limit" redis:"gpu_limit"`
 	CPUMillicoreLimit uint32    `db:"cpu_millicore_limit" json:"cpu_millicore_limit" redis:"cpu_millicore_limit"`
+	Priority          int32     `db:"priority" json:"priority" redis:"priority"`
+	FairShareWeight   uint32    `db:"fair_share_weight" json:"fair_share_weight" redis:"fair_share_weight"`
+	MaxReplicas       uint32    `db:"max_replicas" json:"max_replicas" redis:"max_replicas"` // Most containers a stub in the workspace can scale to, or 0 to use the gateway's default
 	CreatedAt         time.Time `db:"created_at" json:"created_at,omitempty" redis:"-"`
 	UpdatedAt         time.Time `db:"updated_at" json:"updated_at,omitempty" redis:"-"`
 }
 
+// Check returns a ThrottledByConcurrencyLimitError if the request doesn't fit in the limit alongside the workspace's active containers
+func (l *ConcurrencyLimit) Check(containers []ContainerState, request *ContainerRequest) error {
+	totalGpuCount := 0
+	totalCpu := 0
+	for _, container := range containers {
+		totalGpuCount += int(container.GpuCount)
+		totalCpu += int(container.Cpu)
+	}
+
+	if totalGpuCount+int(request.GpuCount) > int(l.GPULimit) {
+		return &ThrottledByConcurrencyLimitError{
+			Reason: "gpu quota exceeded",
+		}
+	}
+
+	if totalCpu+int(request.Cpu) > int(l.CPUMillicoreLimit) {
+		return &ThrottledByConcurrencyLimitError{
+			Reason: "cpu quota exceeded",
+		}
+	}
+
+	return nil
+}
+
 type Secret struct {
 	Id            uint      `db:"id" json:"-"`
 	ExternalId    string    `db:"external_id" json:"external_id,omitempty"`
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 )
 
 // TestIsServe checks the IsServe method for various stub types
@@ -55,3 +56,31 @@ func TestIsDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskCancellationScan(t *testing.T) {
+	resolvedAt := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	want := TaskCancellation{
+		Reason:      TaskCancelledByUser,
+		Outcome:     TaskCancellationKilled,
+		RequestedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ResolvedAt:  &resolvedAt,
+	}
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+
+	var got TaskCancellation
+	if err := got.Scan([]byte(value.(string))); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if got.Reason != want.Reason || got.Outcome != want.Outcome || !got.RequestedAt.Equal(want.RequestedAt) || !got.ResolvedAt.Equal(*want.ResolvedAt) {
+		t.Errorf("Scan() = %+v, want %+v", got, want)
+	}
+
+	if err := got.Scan(42); err == nil {
+		t.Errorf("Scan(42) error = nil, want an error")
+	}
+}
@@ -84,12 +84,36 @@ type CORSConfig struct {
 }
 
 type GatewayServiceConfig struct {
-	Host            string        `key:"host" json:"host"`
-	ExternalHost    string        `key:"externalHost" json:"external_host"`
-	ExternalURL     string        `key:"externalURL" json:"external_url"`
-	GRPC            GRPCConfig    `key:"grpc" json:"grpc"`
-	HTTP            HTTPConfig    `key:"http" json:"http"`
-	ShutdownTimeout time.Duration `key:"shutdownTimeout" json:"shutdown_timeout"`
+	Host                  string            `key:"host" json:"host"`
+	ExternalHost          string            `key:"externalHost" json:"external_host"`
+	ExternalURL           string            `key:"externalURL" json:"external_url"`
+	GRPC                  GRPCConfig        `key:"grpc" json:"grpc"`
+	HTTP                  HTTPConfig        `key:"http" json:"http"`
+	ShutdownTimeout       time.Duration     `key:"shutdownTimeout" json:"shutdown_timeout"`
+	IdempotencyWindow     time.Duration     `key:"idempotencyWindow" json:"idempotency_window"`
+	TaskCancelGracePeriod time.Duration     `key:"taskCancelGracePeriod" json:"task_cancel_grace_period"` // How long a cancelled task's handler has to stop before its worker process is restarted
+	MaxReplicas           uint              `key:"maxReplicas" json:"max_replicas"`                       // Most containers a stub can scale to, unless its workspace's concurrency limit sets its own
+	TaskResults           TaskResultConfig  `key:"taskResults" json:"task_results"`
+	TaskMonitor           TaskMonitorConfig `key:"taskMonitor" json:"task_monitor"`
+}
+
+// TaskMonitorConfig configures how the dispatcher monitors in-flight tasks. Each gateway replica leases a
+// share of the task state shards, and scans up to ScanBatchSize tasks per shard every ScanInterval.
+type TaskMonitorConfig struct {
+	ScanInterval  time.Duration `key:"scanInterval" json:"scan_interval"`
+	ScanBatchSize int64         `key:"scanBatchSize" json:"scan_batch_size"`
+	LeaseTTL      time.Duration `key:"leaseTTL" json:"lease_ttl"`
+}
+
+// TaskResultConfig configures how task queue results are stored. Results larger than MaxInlineSize are
+// spilled from Redis to the result store, which should expire them itself (e.g. with an S3 lifecycle rule).
+// Every gateway replica has to be able to read spilled results, so they're only spilled when Store is "s3".
+// Without a store, results larger than MaxInlineSize aren't kept.
+type TaskResultConfig struct {
+	TTL           time.Duration         `key:"ttl" json:"ttl"`
+	MaxInlineSize int64                 `key:"maxInlineSize" json:"max_inline_size"`
+	Store         string                `key:"store" json:"store"`
+	S3            S3ImageRegistryConfig `key:"s3" json:"s3"`
 }
 
 type ImageServiceConfig struct {
@@ -172,6 +196,15 @@ type WorkerConfig struct {
 	ImagePVCName               string                      `key:"imagePVCName" json:"image_pvc_name"`
 	AddWorkerTimeout           time.Duration               `key:"addWorkerTimeout" json:"add_worker_timeout"`
 	TerminationGracePeriod     int64                       `key:"terminationGracePeriod"`
+	GpuTopology                GpuTopologyConfig           `key:"gpuTopology" json:"gpu_topology"`
+}
+
+// GpuTopologyConfig tells workers where to find the NVLink groups and NUMA nodes of their gpus.
+// ConfigPath takes precedence over DiscoveryCommand. Without either, gpus are assigned without a topology,
+// and containers aren't pinned to cpus.
+type GpuTopologyConfig struct {
+	ConfigPath       string `key:"configPath" json:"config_path"`
+	DiscoveryCommand string `key:"discoveryCommand" json:"discovery_command"`
 }
 
 type PoolMode string
@@ -190,8 +223,27 @@ type WorkerPoolConfig struct {
 	PoolSizing           WorkerPoolJobSpecPoolSizingConfig `key:"poolSizing" json:"pool_sizing"`
 	DefaultMachineCost   float64                           `key:"defaultMachineCost" json:"default_machine_cost"`
 	RequiresPoolSelector bool                              `key:"requiresPoolSelector" json:"requires_pool_selector"`
+	PlacementStrategy    PlacementStrategyType             `key:"placementStrategy" json:"placement_strategy"`
+	GPUSharing           WorkerPoolGPUSharingConfig        `key:"gpuSharing" json:"gpu_sharing"`
+}
+
+// WorkerPoolGPUSharingConfig splits each gpu on a pool's workers into slices, so that containers requesting
+// gpu memory rather than whole gpus can share a device. Sharing workers don't accept whole gpu requests.
+type WorkerPoolGPUSharingConfig struct {
+	Enabled      bool   `key:"enabled" json:"enabled"`
+	SlicesPerGPU uint32 `key:"slicesPerGPU" json:"slices_per_gpu"`
+	SliceMemory  string `key:"sliceMemory" json:"slice_memory"`
 }
 
+type PlacementStrategyType string
+
+var (
+	PlacementStrategyDefault            PlacementStrategyType = ""
+	PlacementStrategyBinpack            PlacementStrategyType = "binpack"
+	PlacementStrategySpread             PlacementStrategyType = "spread"
+	PlacementStrategyLeastFragmentation PlacementStrategyType = "least-fragmentation"
+)
+
 type WorkerPoolJobSpecConfig struct {
 	NodeSelector map[string]string `key:"nodeSelector" json:"node_selector"`
 	Env          []corev1.EnvVar   `key:"env" json:"env"`
@@ -208,13 +260,31 @@ type WorkerPoolJobSpecConfig struct {
 }
 
 type WorkerPoolJobSpecPoolSizingConfig struct {
-	DefaultWorkerCPU      string `key:"defaultWorkerCPU" json:"default_worker_cpu"`
-	DefaultWorkerMemory   string `key:"defaultWorkerMemory" json:"default_worker_memory"`
-	DefaultWorkerGpuType  string `key:"defaultWorkerGPUType" json:"default_worker_gpu_type"`
-	DefaultWorkerGpuCount string `key:"defaultWorkerGpuCount" json:"default_worker_gpu_count"`
-	MinFreeCPU            string `key:"minFreeCPU" json:"min_free_cpu"`
-	MinFreeMemory         string `key:"minFreeMemory" json:"min_free_memory"`
-	MinFreeGPU            string `key:"minFreeGPU" json:"min_free_gpu"`
+	DefaultWorkerCPU      string                     `key:"defaultWorkerCPU" json:"default_worker_cpu"`
+	DefaultWorkerMemory   string                     `key:"defaultWorkerMemory" json:"default_worker_memory"`
+	DefaultWorkerGpuType  string                     `key:"defaultWorkerGPUType" json:"default_worker_gpu_type"`
+	DefaultWorkerGpuCount string                     `key:"defaultWorkerGpuCount" json:"default_worker_gpu_count"`
+	MinFreeCPU            string                     `key:"minFreeCPU" json:"min_free_cpu"`
+	MinFreeMemory         string                     `key:"minFreeMemory" json:"min_free_memory"`
+	MinFreeGPU            string                     `key:"minFreeGPU" json:"min_free_gpu"`
+	MaxFreeCPU            string                     `key:"maxFreeCPU" json:"max_free_cpu"`
+	MaxFreeMemory         string                     `key:"maxFreeMemory" json:"max_free_memory"`
+	MaxFreeGPU            string                     `key:"maxFreeGPU" json:"max_free_gpu"`
+	MaxWorkers            string                     `key:"maxWorkers" json:"max_workers"`
+	ScaleDownCooldown     string                     `key:"scaleDownCooldown" json:"scale_down_cooldown"`
+	Schedules             []WorkerPoolSizingSchedule `key:"schedules" json:"schedules"`
+}
+
+// WorkerPoolSizingSchedule raises a pool's minimum free capacity during a recurring time window.
+// A window opens at every time matched by Cron (evaluated in Timezone) and stays open for Duration.
+type WorkerPoolSizingSchedule struct {
+	Name          string `key:"name" json:"name"`
+	Cron          string `key:"cron" json:"cron"`
+	Duration      string `key:"duration" json:"duration"`
+	Timezone      string `key:"timezone" json:"timezone"`
+	MinFreeCPU    string `key:"minFreeCPU" json:"min_free_cpu"`
+	MinFreeMemory string `key:"minFreeMemory" json:"min_free_memory"`
+	MinFreeGPU    string `key:"minFreeGPU" json:"min_free_gpu"`
 }
 
 type MachineProvider string
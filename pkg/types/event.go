@@ -23,6 +23,7 @@ var (
 	EventContainerLifecycleStarted   = "started"
 	EventContainerLifecycleStopped   = "stopped"
 	EventContainerLifecycleFailed    = "failed"
+	EventContainerLifecycleEvicted   = "evicted"
 )
 
 var (
@@ -41,6 +42,26 @@ type EventContainerLifecycleSchema struct {
 	Request     ContainerRequest `json:"request"`
 }
 
+var EventContainerEvictedSchemaVersion = "1.0"
+
+type EventContainerEvictedSchema struct {
+	ContainerID string           `json:"container_id"`
+	WorkerID    string           `json:"worker_id"`
+	Status      string           `json:"status"`
+	EvictedBy   string           `json:"evicted_by"`
+	Request     ContainerRequest `json:"request"`
+}
+
+var EventContainerFailedSchemaVersion = "1.0"
+
+type EventContainerFailedSchema struct {
+	ContainerID string                           `json:"container_id"`
+	Status      string                           `json:"status"`
+	Reason      ContainerSchedulingFailureReason `json:"reason"`
+	Message     string                           `json:"message"`
+	Request     ContainerRequest                 `json:"request"`
+}
+
 var EventContainerMetricsSchemaVersion = "1.0"
 
 type EventContainerMetricsSchema struct {
@@ -52,22 +73,24 @@ type EventContainerMetricsSchema struct {
 }
 
 type EventContainerMetricsData struct {
-	CPUUsed            uint64  `json:"cpu_used"`
-	CPUTotal           uint64  `json:"cpu_total"`
-	CPUPercent         float32 `json:"cpu_pct"`
-	MemoryRSS          uint64  `json:"memory_rss_bytes"`
-	MemoryVMS          uint64  `json:"memory_vms_bytes"`
-	MemorySwap         uint64  `json:"memory_swap_bytes"`
-	MemoryTotal        uint64  `json:"memory_total_bytes"`
-	DiskReadBytes      uint64  `json:"disk_read_bytes"`
-	DiskWriteBytes     uint64  `json:"disk_write_bytes"`
-	NetworkBytesRecv   uint64  `json:"network_recv_bytes"`
-	NetworkBytesSent   uint64  `json:"network_sent_bytes"`
-	NetworkPacketsRecv uint64  `json:"network_recv_packets"`
-	NetworkPacketsSent uint64  `json:"network_sent_packets"`
-	GPUMemoryUsed      uint64  `json:"gpu_memory_used_bytes"`
-	GPUMemoryTotal     uint64  `json:"gpu_memory_total_bytes"`
-	GPUType            string  `json:"gpu_type"`
+	CPUUsed             uint64  `json:"cpu_used"`
+	CPUTotal            uint64  `json:"cpu_total"`
+	CPUPercent          float32 `json:"cpu_pct"`
+	MemoryRSS           uint64  `json:"memory_rss_bytes"`
+	MemoryVMS           uint64  `json:"memory_vms_bytes"`
+	MemorySwap          uint64  `json:"memory_swap_bytes"`
+	MemoryTotal         uint64  `json:"memory_total_bytes"`
+	DiskReadBytes       uint64  `json:"disk_read_bytes"`
+	DiskWriteBytes      uint64  `json:"disk_write_bytes"`
+	NetworkBytesRecv    uint64  `json:"network_recv_bytes"`
+	NetworkBytesSent    uint64  `json:"network_sent_bytes"`
+	NetworkPacketsRecv  uint64  `json:"network_recv_packets"`
+	NetworkPacketsSent  uint64  `json:"network_sent_packets"`
+	GPUMemoryUsed       uint64  `json:"gpu_memory_used_bytes"`
+	GPUMemoryTotal      uint64  `json:"gpu_memory_total_bytes"`
+	GPUSliceMemoryUsed  uint64  `json:"gpu_slice_memory_used_bytes"`
+	GPUSliceMemoryTotal uint64  `json:"gpu_slice_memory_total_bytes"`
+	GPUType             string  `json:"gpu_type"`
 }
 
 var EventContainerStatusRequestedSchemaVersion = "1.0"
@@ -4,6 +4,13 @@ var (
 	// Scheduler keys
 	MetricsSchedulerContainerScheduled = "container_scheduled_count"
 	MetricsSchedulerContainerRequested = "container_requested_count"
+	MetricsSchedulerWorkspaceShare     = "workspace_dominant_share"
+	MetricsSchedulerWorkspaceBacklog   = "workspace_backlog_length"
+
+	// Task monitor keys
+	MetricsTaskMonitorShardLag     = "task_monitor_shard_lag_seconds"
+	MetricsTaskMonitorShardsOwned  = "task_monitor_shards_owned"
+	MetricsTaskMonitorTasksScanned = "task_monitor_tasks_scanned_count"
 
 	// Worker keys
 	MetricsWorkerContainerDuration = "container_duration_milliseconds"
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -10,6 +12,7 @@ type WorkerStatus string
 const (
 	WorkerStatusAvailable WorkerStatus = "available"
 	WorkerStatusPending   WorkerStatus = "pending"
+	WorkerStatusDraining  WorkerStatus = "draining"
 	WorkerStateTtlS       int          = 60
 )
 
@@ -27,6 +30,96 @@ type Worker struct {
 	MachineId            string       `json:"machine_id" redis:"machine_id"`
 	ResourceVersion      int64        `json:"resource_version" redis:"resource_version"`
 	RequiresPoolSelector bool         `json:"requires_pool_selector" redis:"requires_pool_selector"`
+	GpuSliceMemory       int64        `json:"gpu_slice_memory" redis:"gpu_slice_memory"`
+	GpuSlicesPerDevice   uint32       `json:"gpu_slices_per_device" redis:"gpu_slices_per_device"`
+	FreeGpuSlices        string       `json:"free_gpu_slices" redis:"free_gpu_slices"`
+}
+
+// SharesGpus checks if the worker advertises its gpus as slices rather than whole devices
+func (w *Worker) SharesGpus() bool {
+	return w.GpuSliceMemory > 0 && w.GpuSlicesPerDevice > 0
+}
+
+// GpuSlicesNeeded returns the number of slices needed to cover a request's gpu memory
+func (w *Worker) GpuSlicesNeeded(request *ContainerRequest) uint32 {
+	if w.GpuSliceMemory <= 0 {
+		return 0
+	}
+
+	return uint32((request.GpuMemory + w.GpuSliceMemory - 1) / w.GpuSliceMemory)
+}
+
+// FreeGpuSlicesPerDevice returns the number of free slices on each gpu, indexed by device
+func (w *Worker) FreeGpuSlicesPerDevice() []uint32 {
+	if w.FreeGpuSlices == "" {
+		return []uint32{}
+	}
+
+	parts := strings.Split(w.FreeGpuSlices, ",")
+	slices := make([]uint32, len(parts))
+	for i, part := range parts {
+		free, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			continue
+		}
+		slices[i] = uint32(free)
+	}
+
+	return slices
+}
+
+// SetFreeGpuSlicesPerDevice stores the number of free slices on each gpu, indexed by device
+func (w *Worker) SetFreeGpuSlicesPerDevice(slices []uint32) {
+	parts := make([]string, len(slices))
+	for i, free := range slices {
+		parts[i] = strconv.FormatUint(uint64(free), 10)
+	}
+
+	w.FreeGpuSlices = strings.Join(parts, ",")
+}
+
+// FindGpuSliceDevice returns the device with the fewest free slices that can still fit the given number of slices.
+// Packing slices onto the fullest device leaves whole devices free for larger requests.
+func (w *Worker) FindGpuSliceDevice(slices uint32) (int, bool) {
+	freeSlices := w.FreeGpuSlicesPerDevice()
+
+	device := -1
+	for i, free := range freeSlices {
+		if free < slices {
+			continue
+		}
+
+		if device == -1 || free < freeSlices[device] {
+			device = i
+		}
+	}
+
+	return device, device != -1
+}
+
+// ReserveGpuSlices takes the given number of slices from a single device, and returns that device
+func (w *Worker) ReserveGpuSlices(slices uint32) (int, bool) {
+	device, ok := w.FindGpuSliceDevice(slices)
+	if !ok {
+		return -1, false
+	}
+
+	freeSlices := w.FreeGpuSlicesPerDevice()
+	freeSlices[device] -= slices
+	w.SetFreeGpuSlicesPerDevice(freeSlices)
+
+	return device, true
+}
+
+// ReleaseGpuSlices returns slices to the device they were reserved on
+func (w *Worker) ReleaseGpuSlices(device int, slices uint32) {
+	freeSlices := w.FreeGpuSlicesPerDevice()
+	if device < 0 || device >= len(freeSlices) {
+		return
+	}
+
+	freeSlices[device] = min(freeSlices[device]+slices, w.GpuSlicesPerDevice)
+	w.SetFreeGpuSlicesPerDevice(freeSlices)
 }
 
 type CapacityUpdateType int
@@ -62,6 +155,7 @@ type ContainerState struct {
 	GpuCount    uint32          `redis:"gpu_count" json:"gpu_count"`
 	Cpu         int64           `redis:"cpu" json:"cpu"`
 	Memory      int64           `redis:"memory" json:"memory"`
+	Priority    int32           `redis:"priority" json:"priority"`
 }
 
 type ContainerRequest struct {
@@ -80,8 +174,43 @@ type ContainerRequest struct {
 	Mounts       []Mount   `json:"mounts"`
 	RetryCount   int       `json:"retry_count"`
 	PoolSelector string    `json:"pool_selector"`
+	Priority     int32     `json:"priority"`
+	GroupId      string    `json:"group_id"`
+	GroupRank    int       `json:"group_rank"`
+	GroupPeers   []string  `json:"group_peers"`
+	GpuMemory    int64     `json:"gpu_memory"`
+	GpuSlice     *GpuSlice `json:"gpu_slice"`
+}
+
+// SharesGpu checks if the request asks for a slice of a gpu (by memory) rather than whole gpus
+func (r *ContainerRequest) SharesGpu() bool {
+	return r.Gpu != "" && r.GpuMemory > 0
+}
+
+// GpuSlice is the part of a shared gpu reserved for a container. It's assigned when the
+// container is scheduled on a worker, and used by the worker to enforce the memory limit.
+type GpuSlice struct {
+	Device         int    `json:"device"`
+	Slices         uint32 `json:"slices"`
+	SlicesPerGpu   uint32 `json:"slices_per_gpu"`
+	MemoryLimitMiB int64  `json:"memory_limit_mib"`
+}
+
+// ContainerGroupRequest is a set of containers that must all be placed together (gang scheduled).
+// Each member's rank is its index in Requests, and GroupPeers lists every member's container id by rank.
+type ContainerGroupRequest struct {
+	GroupId  string              `json:"group_id"`
+	Requests []*ContainerRequest `json:"requests"`
 }
 
+// Container priorities are bounded so they can be folded into the backlog score.
+// Higher values are scheduled first and may preempt containers with a lower priority.
+const (
+	ContainerPriorityMin     int32 = -100
+	ContainerPriorityDefault int32 = 0
+	ContainerPriorityMax     int32 = 100
+)
+
 const ContainerExitCodeTtlS int = 300
 
 const (
@@ -114,6 +243,64 @@ func (e *ErrNoSuitableWorkerFound) Error() string {
 	return "no suitable worker found for request"
 }
 
+type ContainerSchedulingFailureReason string
+
+const (
+	ContainerSchedulingFailureNoController  ContainerSchedulingFailureReason = "no_controller"
+	ContainerSchedulingFailureNoCapacity    ContainerSchedulingFailureReason = "no_capacity"
+	ContainerSchedulingFailureProviderError ContainerSchedulingFailureReason = "provider_error"
+)
+
+const ContainerSchedulingFailureTtlS int = 600
+
+// ContainerSchedulingFailure records why the scheduler gave up on a container request
+type ContainerSchedulingFailure struct {
+	ContainerId string                           `redis:"container_id" json:"container_id"`
+	Reason      ContainerSchedulingFailureReason `redis:"reason" json:"reason"`
+	Message     string                           `redis:"message" json:"message"`
+	RetryCount  int                              `redis:"retry_count" json:"retry_count"`
+	FailedAt    int64                            `redis:"failed_at" json:"failed_at"`
+}
+
+type ErrContainerSchedulingFailed struct {
+	Failure *ContainerSchedulingFailure
+}
+
+func (e *ErrContainerSchedulingFailed) Error() string {
+	return fmt.Sprintf("unable to schedule container %s (%s): %s", e.Failure.ContainerId, e.Failure.Reason, e.Failure.Message)
+}
+
+type WorkerRejectionReason string
+
+const (
+	WorkerRejectionDraining             WorkerRejectionReason = "draining"
+	WorkerRejectionPoolSelector         WorkerRejectionReason = "pool_selector"
+	WorkerRejectionRequiresPoolSelector WorkerRejectionReason = "requires_pool_selector"
+	WorkerRejectionCpu                  WorkerRejectionReason = "cpu"
+	WorkerRejectionMemory               WorkerRejectionReason = "memory"
+	WorkerRejectionGpuType              WorkerRejectionReason = "gpu_type"
+	WorkerRejectionGpuCount             WorkerRejectionReason = "gpu_count"
+	WorkerRejectionGpuSharing           WorkerRejectionReason = "gpu_sharing"
+	WorkerRejectionGpuSlices            WorkerRejectionReason = "gpu_slices"
+)
+
+// WorkerCandidate is a worker the scheduler considered for a request, along with every reason it was rejected
+type WorkerCandidate struct {
+	Worker     *Worker                 `json:"worker"`
+	Rejections []WorkerRejectionReason `json:"rejections"`
+}
+
+// SchedulingExplanation describes how the scheduler would handle a container request, without scheduling it
+type SchedulingExplanation struct {
+	Request          *ContainerRequest `json:"request"`
+	Workers          []WorkerCandidate `json:"workers"`
+	SelectedWorkerId string            `json:"selected_worker_id"`
+	PoolName         string            `json:"pool_name"`
+	ControllerName   string            `json:"controller_name"`
+	ControllerError  string            `json:"controller_error"`
+	ThrottledReason  string            `json:"throttled_reason"`
+}
+
 type ErrWorkerNotFound struct {
 	WorkerId string
 }
@@ -130,6 +317,11 @@ type WorkerPoolSizingConfig struct {
 	DefaultWorkerMemory   int64
 	DefaultWorkerGpuType  string
 	DefaultWorkerGpuCount uint32
+	MaxFreeCpu            int64         // Zero means free cpu never triggers a scale down
+	MaxFreeMemory         int64         // Zero means free memory never triggers a scale down
+	MaxFreeGpu            uint          // Zero means free gpus never trigger a scale down
+	MaxWorkers            int           // Zero means the pool size isn't capped
+	ScaleDownCooldown     time.Duration // Zero means the default cooldown is used
 }
 
 func NewWorkerPoolSizingConfig() *WorkerPoolSizingConfig {
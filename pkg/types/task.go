@@ -4,15 +4,46 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid"
 )
 
 type TaskPayload struct {
-	Args   []interface{}          `json:"args"`
-	Kwargs map[string]interface{} `json:"kwargs"`
+	Args         []interface{}          `json:"args"`
+	Kwargs       map[string]interface{} `json:"kwargs"`
+	DependsOn    []string               `json:"depends_on,omitempty"`
+	RunAt        *time.Time             `json:"run_at,omitempty"`
+	DelaySeconds int64                  `json:"delay_seconds,omitempty"`
+
+	// IdempotencyKey is set by the caller's request rather than its payload
+	IdempotencyKey string `json:"-"`
+}
+
+// NotBefore returns the time a task can run from, or the zero time if it can run immediately
+func (p *TaskPayload) NotBefore() (time.Time, error) {
+	if p.RunAt != nil && p.DelaySeconds != 0 {
+		return time.Time{}, errors.New("run_at and delay_seconds can't both be set")
+	}
+
+	if p.DelaySeconds < 0 {
+		return time.Time{}, errors.New("delay_seconds must be positive")
+	}
+
+	if p.DelaySeconds > 0 {
+		return time.Now().Add(time.Duration(p.DelaySeconds) * time.Second), nil
+	}
+
+	if p.RunAt != nil {
+		return *p.RunAt, nil
+	}
+
+	return time.Time{}, nil
 }
 
 type TaskMetadata struct {
@@ -27,8 +58,46 @@ type TaskCancellationReason string
 const (
 	TaskExpired            TaskCancellationReason = "expired"
 	TaskExceededRetryLimit TaskCancellationReason = "exceeded_retry_limit"
+	TaskSchedulingFailed   TaskCancellationReason = "scheduling_failed"
+	TaskDependencyFailed   TaskCancellationReason = "dependency_failed"
+	TaskTimedOut           TaskCancellationReason = "timed_out"
+	TaskCancelledByUser    TaskCancellationReason = "user_requested"
 )
 
+// TaskStateShards is the number of shards that the state of in-flight tasks is indexed in. Each shard is
+// monitored by one gateway replica at a time.
+const TaskStateShards = 64
+
+// TaskStateShard returns the shard that a task's state is indexed in
+func TaskStateShard(taskId string) int {
+	h := fnv.New32a()
+	h.Write([]byte(taskId))
+	return int(h.Sum32() % TaskStateShards)
+}
+
+// TaskClaim records which container is running a task, and since when
+type TaskClaim struct {
+	ContainerId string
+	ClaimedAt   time.Time
+}
+
+// TimedOut reports whether a claimed task has run for longer than its policy's timeout
+func (c *TaskClaim) TimedOut(policy TaskPolicy, now time.Time) bool {
+	if policy.Timeout <= 0 {
+		return false
+	}
+
+	return now.Sub(c.ClaimedAt) > time.Duration(policy.Timeout)*time.Second
+}
+
+// DeadLetterTask is a task that was moved to its stub's dead-letter queue after running out of retries
+type DeadLetterTask struct {
+	TaskMessage    TaskMessage            `json:"task_message"`
+	Reason         TaskCancellationReason `json:"reason"`
+	Error          string                 `json:"error,omitempty"`
+	DeadLetteredAt time.Time              `json:"dead_lettered_at"`
+}
+
 type TaskInterface interface {
 	Execute(ctx context.Context, options ...interface{}) error
 	Cancel(ctx context.Context, reason TaskCancellationReason) error
@@ -58,6 +127,7 @@ type TaskMessage struct {
 	Policy        TaskPolicy             `json:"policy" redis:"policy"`
 	Retries       uint                   `json:"retries" redis:"retries"`
 	Timestamp     int64                  `json:"timestamp" redis:"timestamp"`
+	DependsOn     []string               `json:"depends_on,omitempty" redis:"depends_on"`
 }
 
 func (tm *TaskMessage) Reset() {
@@ -69,6 +139,7 @@ func (tm *TaskMessage) Reset() {
 	tm.Timestamp = time.Now().Unix()
 	tm.Policy = DefaultTaskPolicy
 	tm.Retries = 0
+	tm.DependsOn = nil
 }
 
 // Encode returns a binary representation of the TaskMessage
@@ -109,9 +180,37 @@ var DefaultTaskPolicy = TaskPolicy{
 }
 
 type TaskPolicy struct {
-	MaxRetries uint      `json:"max_retries" redis:"max_retries"`
-	Timeout    int       `json:"timeout" redis:"timeout"`
-	Expires    time.Time `json:"expires" redis:"expires"`
+	MaxRetries  uint      `json:"max_retries" redis:"max_retries"`
+	Timeout     int       `json:"timeout" redis:"timeout"`
+	Expires     time.Time `json:"expires" redis:"expires"`
+	NotBefore   time.Time `json:"not_before" redis:"not_before"`
+	BackoffBase float64   `json:"backoff_base" redis:"backoff_base"` // Seconds before the first retry, doubled for each retry after it
+	BackoffMax  float64   `json:"backoff_max" redis:"backoff_max"`   // Upper bound on the delay between retries, in seconds
+	Jitter      float64   `json:"jitter" redis:"jitter"`             // Fraction of the delay that is randomized, between 0 and 1
+	RetryOn     []string  `json:"retry_on" redis:"retry_on"`         // Error kinds reported by the runner that are retried
+}
+
+// IsRetryable returns whether a task that failed with an error of the given kind should be retried
+func (p *TaskPolicy) IsRetryable(errorKind string) bool {
+	return errorKind != "" && slices.Contains(p.RetryOn, errorKind)
+}
+
+// RetryDelay returns how long to wait before a task's next attempt. The delay grows exponentially from
+// BackoffBase up to BackoffMax, and r (in [0, 1)) randomizes the Jitter fraction of it.
+func (p *TaskPolicy) RetryDelay(retries uint, r float64) time.Duration {
+	if p.BackoffBase <= 0 {
+		return 0
+	}
+
+	delay := p.BackoffBase * math.Pow(2, float64(retries))
+	if p.BackoffMax > 0 && delay > p.BackoffMax {
+		delay = p.BackoffMax
+	}
+
+	jitter := math.Min(math.Max(p.Jitter, 0), 1)
+	delay -= delay * jitter * r
+
+	return time.Duration(delay * float64(time.Second))
 }
 
 type ErrExceededTaskLimit struct {
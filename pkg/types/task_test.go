@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  TaskPolicy
+		retries uint
+		r       float64
+		want    time.Duration
+	}{
+		{"no backoff", TaskPolicy{}, 2, 0.5, 0},
+		{"first retry", TaskPolicy{BackoffBase: 2}, 0, 0, 2 * time.Second},
+		{"exponential", TaskPolicy{BackoffBase: 2}, 3, 0, 16 * time.Second},
+		{"capped", TaskPolicy{BackoffBase: 2, BackoffMax: 10}, 3, 0, 10 * time.Second},
+		{"jitter", TaskPolicy{BackoffBase: 2, BackoffMax: 10, Jitter: 0.5}, 3, 0.5, 7500 * time.Millisecond},
+		{"jitter above one", TaskPolicy{BackoffBase: 4, Jitter: 2}, 0, 0.25, 3 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.RetryDelay(tt.retries, tt.r); got != tt.want {
+				t.Errorf("RetryDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	policy := TaskPolicy{RetryOn: []string{"TimeoutError", "ConnectionError"}}
+
+	tests := []struct {
+		errorKind string
+		want      bool
+	}{
+		{"TimeoutError", true},
+		{"ConnectionError", true},
+		{"ValueError", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := policy.IsRetryable(tt.errorKind); got != tt.want {
+			t.Errorf("IsRetryable(%q) = %v, want %v", tt.errorKind, got, tt.want)
+		}
+	}
+}
+
+func TestTaskClaimTimedOut(t *testing.T) {
+	now := time.Now()
+	claim := TaskClaim{ContainerId: "container", ClaimedAt: now.Add(-time.Minute)}
+
+	tests := []struct {
+		name   string
+		policy TaskPolicy
+		want   bool
+	}{
+		{"no timeout", TaskPolicy{Timeout: 0}, false},
+		{"negative timeout", TaskPolicy{Timeout: -1}, false},
+		{"within timeout", TaskPolicy{Timeout: 120}, false},
+		{"past timeout", TaskPolicy{Timeout: 30}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := claim.TimedOut(tt.policy, now); got != tt.want {
+				t.Errorf("TimedOut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskStateShard(t *testing.T) {
+	used := map[int]bool{}
+
+	for i := 0; i < 1000; i++ {
+		taskId := fmt.Sprintf("task-%d", i)
+
+		shard := TaskStateShard(taskId)
+		if shard < 0 || shard >= TaskStateShards {
+			t.Fatalf("TaskStateShard(%q) = %d, want a shard in [0, %d)", taskId, shard, TaskStateShards)
+		}
+
+		if again := TaskStateShard(taskId); again != shard {
+			t.Errorf("TaskStateShard(%q) = %d, then %d", taskId, shard, again)
+		}
+
+		used[shard] = true
+	}
+
+	if len(used) != TaskStateShards {
+		t.Errorf("tasks used %d shards, want %d", len(used), TaskStateShards)
+	}
+}
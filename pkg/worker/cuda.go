@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"syscall"
 
 	common "github.com/beam-cloud/beta9/pkg/common"
+	types "github.com/beam-cloud/beta9/pkg/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"gvisor.dev/gvisor/pkg/sync"
 )
@@ -18,33 +20,57 @@ var (
 	defaultContainerLibrary     []string = []string{"/usr/lib/x86_64-linux-gnu", "/usr/lib/worker/x86_64-linux-gnu", "/usr/local/nvidia/lib64"}
 )
 
+var (
+	defaultMpsPipeDirectory string = "/tmp/nvidia-mps"
+)
+
 type ContainerCudaManager struct {
-	gpuAllocationMap *common.SafeMap[[]int]
-	gpuCount         uint32
-	mu               sync.Mutex
-	statFunc         func(path string, stat *syscall.Stat_t) (err error)
+	gpuAllocationMap      *common.SafeMap[[]int]
+	gpuSliceAllocationMap *common.SafeMap[types.GpuSlice]
+	gpuCount              uint32
+	topology              *GpuTopology
+	mu                    sync.Mutex
+	statFunc              func(path string, stat *syscall.Stat_t) (err error)
 }
 
 func NewContainerCudaManager(gpuCount uint32) *ContainerCudaManager {
 	return &ContainerCudaManager{
-		gpuAllocationMap: common.NewSafeMap[[]int](),
-		gpuCount:         gpuCount,
-		mu:               sync.Mutex{},
-		statFunc:         syscall.Stat,
+		gpuAllocationMap:      common.NewSafeMap[[]int](),
+		gpuSliceAllocationMap: common.NewSafeMap[types.GpuSlice](),
+		gpuCount:              gpuCount,
+		mu:                    sync.Mutex{},
+		statFunc:              syscall.Stat,
 	}
 }
 
 type AssignedGpuDevices struct {
 	devices []specs.LinuxDeviceCgroup
 	visible string // Visible devices (for NVIDIA_VISIBLE_DEVICES env var)
+	cpus    string // Cpuset of the NUMA node the devices are attached to, if known
+	mems    string
 }
 
 func (d *AssignedGpuDevices) String() string {
 	return d.visible
 }
 
+// LoadTopology loads the gpu topology used to keep multi-gpu containers on connected devices
+func (c *ContainerCudaManager) LoadTopology(discover GpuTopologyDiscoveryFunc) error {
+	topology, err := discover()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.topology = topology
+	return nil
+}
+
 func (c *ContainerCudaManager) UnassignGpuDevices(containerId string) {
 	c.gpuAllocationMap.Delete(containerId)
+	c.gpuSliceAllocationMap.Delete(containerId)
 }
 
 func (c *ContainerCudaManager) AssignGpuDevices(containerId string, gpuCount uint32) (*AssignedGpuDevices, error) {
@@ -53,6 +79,55 @@ func (c *ContainerCudaManager) AssignGpuDevices(containerId string, gpuCount uin
 		return nil, err
 	}
 
+	return c.assignedDevices(gpuIds)
+}
+
+// AssignGpuSlice gives a container the gpu slice the scheduler reserved for it. Unlike whole gpus,
+// a device can be shared by several sliced containers, but never with a container that owns it outright.
+func (c *ContainerCudaManager) AssignGpuSlice(containerId string, slice *types.GpuSlice) (*AssignedGpuDevices, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if slice.Device < 0 || slice.Device >= int(c.gpuCount) {
+		return nil, fmt.Errorf("invalid gpu slice device: %d", slice.Device)
+	}
+
+	allocated := false
+	c.gpuAllocationMap.Range(func(_ string, value []int) bool {
+		allocated = slices.Contains(value, slice.Device)
+		return !allocated
+	})
+
+	if allocated {
+		return nil, fmt.Errorf("gpu %d is already assigned to a container", slice.Device)
+	}
+
+	c.gpuSliceAllocationMap.Set(containerId, *slice)
+
+	return c.assignedDevices([]int{slice.Device})
+}
+
+// GpuSliceEnvVars returns the env vars that cap a container's use of a shared gpu. The limits are enforced by
+// the MPS control daemon, so the memory limit refers to the container's only visible device (index 0).
+func (c *ContainerCudaManager) GpuSliceEnvVars(slice *types.GpuSlice) []string {
+	env := []string{
+		fmt.Sprintf("CUDA_MPS_PIPE_DIRECTORY=%s", defaultMpsPipeDirectory),
+		fmt.Sprintf("CUDA_MPS_PINNED_DEVICE_MEM_LIMIT=0=%dM", slice.MemoryLimitMiB),
+	}
+
+	if slice.SlicesPerGpu > 0 {
+		env = append(env, fmt.Sprintf("CUDA_MPS_ACTIVE_THREAD_PERCENTAGE=%d", max(1, slice.Slices*100/slice.SlicesPerGpu)))
+	}
+
+	return env
+}
+
+// GetGpuSlice returns the gpu slice assigned to a container, if it has one
+func (c *ContainerCudaManager) GetGpuSlice(containerId string) (types.GpuSlice, bool) {
+	return c.gpuSliceAllocationMap.Get(containerId)
+}
+
+func (c *ContainerCudaManager) assignedDevices(gpuIds []int) (*AssignedGpuDevices, error) {
 	// Device cgroup rules for the specific GPUs
 	var devices []specs.LinuxDeviceCgroup
 	var visibleGPUs []string // To collect the IDs for NVIDIA_VISIBLE_DEVICES
@@ -104,10 +179,16 @@ func (c *ContainerCudaManager) AssignGpuDevices(containerId string, gpuCount uin
 	// Join the GPU IDs with commas for the NVIDIA_VISIBLE_DEVICES variable
 	visible := strings.Join(visibleGPUs, ",")
 
-	return &AssignedGpuDevices{
+	assigned := &AssignedGpuDevices{
 		visible: visible,
 		devices: devices,
-	}, nil
+	}
+
+	if c.topology != nil {
+		assigned.cpus, assigned.mems = c.topology.cpuset(gpuIds)
+	}
+
+	return assigned, nil
 }
 
 func (c *ContainerCudaManager) chooseDevices(containerId string, requestedGpuCount uint32) ([]int, error) {
@@ -124,13 +205,26 @@ func (c *ContainerCudaManager) chooseDevices(containerId string, requestedGpuCou
 		return true // Continue iteration
 	})
 
+	// Devices shared by sliced containers can't be assigned whole
+	c.gpuSliceAllocationMap.Range(func(_ string, value types.GpuSlice) bool {
+		currentAllocations[value.Device] = true
+		return true
+	})
+
 	// Find available GPUs and allocate to the current container
-	for gpuId := 0; gpuId < int(c.gpuCount) && uint32(len(allocatedDevices)) < requestedGpuCount; gpuId++ {
+	freeDevices := []int{}
+	for gpuId := 0; gpuId < int(c.gpuCount); gpuId++ {
 		if !currentAllocations[gpuId] {
-			allocatedDevices = append(allocatedDevices, gpuId)
+			freeDevices = append(freeDevices, gpuId)
 		}
 	}
 
+	if c.topology != nil {
+		allocatedDevices = c.topology.selectDevices(freeDevices, int(requestedGpuCount))
+	} else if len(freeDevices) >= int(requestedGpuCount) {
+		allocatedDevices = freeDevices[:requestedGpuCount]
+	}
+
 	// Check if we managed to allocate the requested number of GPUs
 	if len(allocatedDevices) < int(requestedGpuCount) {
 		return nil, fmt.Errorf("not enough GPUs available, requested: %d, available: %d", requestedGpuCount, int(c.gpuCount)-len(currentAllocations))
@@ -252,7 +346,15 @@ func (c *ContainerCudaManager) InjectCudaEnvVars(env []string, options *Containe
 
 func (c *ContainerCudaManager) InjectCudaMounts(mounts []specs.Mount) []specs.Mount {
 	cudaPaths := []string{fmt.Sprintf("/usr/local/cuda-%s", defaultContainerCudaVersion), "/usr/local/nvidia/lib64"}
+	return c.injectMounts(mounts, cudaPaths)
+}
+
+// InjectMpsMounts mounts the MPS control daemon's pipe directory, so sliced containers can reach the daemon
+func (c *ContainerCudaManager) InjectMpsMounts(mounts []specs.Mount) []specs.Mount {
+	return c.injectMounts(mounts, []string{defaultMpsPipeDirectory})
+}
 
+func (c *ContainerCudaManager) injectMounts(mounts []specs.Mount, cudaPaths []string) []specs.Mount {
 	for _, path := range cudaPaths {
 		if _, err := os.Stat(path); os.IsNotExist(err) {
 			continue
@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"testing"
 
+	types "github.com/beam-cloud/beta9/pkg/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -171,3 +172,184 @@ func TestAssignGPUsStatFail(t *testing.T) {
 		t.Errorf("Expected error due to stat failure, but got none")
 	}
 }
+
+func TestAssignGpuSlices(t *testing.T) {
+	manager := NewContainerCudaManager(2) // Assume a machine with 2 GPUs
+	manager.statFunc = mockStat
+
+	slice := &types.GpuSlice{Device: 1, Slices: 1, SlicesPerGpu: 4, MemoryLimitMiB: 10240}
+
+	// Several containers can share the same device
+	for _, containerId := range []string{"container1", "container2"} {
+		assignedDevices, err := manager.AssignGpuSlice(containerId, slice)
+		if err != nil {
+			t.Fatalf("Failed to assign GPU slice to %s: %v", containerId, err)
+		}
+
+		if assignedDevices.visible != "1" {
+			t.Errorf("Expected visible GPUs to be '1', got '%s'", assignedDevices.visible)
+		}
+	}
+
+	// A shared device can't be assigned whole
+	_, err := manager.AssignGpuDevices("container3", 2)
+	if err == nil {
+		t.Errorf("Expected failure when assigning a shared GPU whole, but got none")
+	}
+
+	assignedDevices, err := manager.AssignGpuDevices("container3", 1)
+	if err != nil {
+		t.Fatalf("Failed to assign GPUs to container3: %v", err)
+	}
+
+	if assignedDevices.visible != "0" {
+		t.Errorf("Expected visible GPUs to be '0', got '%s'", assignedDevices.visible)
+	}
+
+	// A device owned by a container can't be sliced
+	_, err = manager.AssignGpuSlice("container4", &types.GpuSlice{Device: 0, Slices: 1, SlicesPerGpu: 4, MemoryLimitMiB: 10240})
+	if err == nil {
+		t.Errorf("Expected failure when slicing an assigned GPU, but got none")
+	}
+
+	// Once every sliced container is gone, the device can be assigned whole again
+	manager.UnassignGpuDevices("container1")
+	manager.UnassignGpuDevices("container2")
+
+	_, err = manager.AssignGpuDevices("container5", 1)
+	if err != nil {
+		t.Errorf("Failed to assign GPUs to container5 after unassigning slices: %v", err)
+	}
+}
+
+func TestGpuSliceEnvVars(t *testing.T) {
+	manager := NewContainerCudaManager(1)
+
+	expectedEnv := []string{
+		"CUDA_MPS_PIPE_DIRECTORY=/tmp/nvidia-mps",
+		"CUDA_MPS_PINNED_DEVICE_MEM_LIMIT=0=20480M",
+		"CUDA_MPS_ACTIVE_THREAD_PERCENTAGE=50",
+	}
+
+	resultEnv := manager.GpuSliceEnvVars(&types.GpuSlice{Device: 0, Slices: 2, SlicesPerGpu: 4, MemoryLimitMiB: 20480})
+	if !reflect.DeepEqual(expectedEnv, resultEnv) {
+		t.Errorf("Expected %v, got %v", expectedEnv, resultEnv)
+	}
+}
+
+// fakeTopology describes a machine with two NVLink groups of 4 gpus, each on its own NUMA node
+func fakeTopology() (*GpuTopology, error) {
+	topology := &GpuTopology{
+		NumaNodes: []NumaNodeTopology{{Id: 0, Cpus: "0-31"}, {Id: 1, Cpus: "32-63"}},
+	}
+
+	for i := 0; i < 8; i++ {
+		topology.Devices = append(topology.Devices, GpuDeviceTopology{Index: i, NvlinkGroup: i / 4, NumaNode: i / 4})
+	}
+
+	return topology, nil
+}
+
+func TestAssignGpuDevicesWithTopology(t *testing.T) {
+	manager := NewContainerCudaManager(8)
+	manager.statFunc = mockStat
+
+	err := manager.LoadTopology(fakeTopology)
+	if err != nil {
+		t.Fatalf("Failed to load topology: %v", err)
+	}
+
+	// Take one gpu from the first group, leaving 3 free there and 4 in the second
+	_, err = manager.AssignGpuDevices("container1", 1)
+	if err != nil {
+		t.Fatalf("Failed to assign GPUs to container1: %v", err)
+	}
+
+	tests := []struct {
+		containerId string
+		gpuCount    uint32
+		visible     string
+		cpus        string
+		mems        string
+	}{
+		// Fits in the fuller group, so the second group stays whole
+		{containerId: "container2", gpuCount: 2, visible: "1,2", cpus: "0-31", mems: "0"},
+		// Doesn't fit in what's left of the first group
+		{containerId: "container3", gpuCount: 4, visible: "4,5,6,7", cpus: "32-63", mems: "1"},
+	}
+
+	for _, tt := range tests {
+		assignedDevices, err := manager.AssignGpuDevices(tt.containerId, tt.gpuCount)
+		if err != nil {
+			t.Fatalf("Failed to assign GPUs to %s: %v", tt.containerId, err)
+		}
+
+		if assignedDevices.visible != tt.visible {
+			t.Errorf("Expected visible GPUs to be '%s', got '%s'", tt.visible, assignedDevices.visible)
+		}
+
+		if assignedDevices.cpus != tt.cpus || assignedDevices.mems != tt.mems {
+			t.Errorf("Expected cpuset '%s' on node '%s', got '%s' on node '%s'", tt.cpus, tt.mems, assignedDevices.cpus, assignedDevices.mems)
+		}
+	}
+
+	// Free gpus are spread across both groups, so the container isn't pinned to a NUMA node
+	manager.UnassignGpuDevices("container3")
+	_, err = manager.AssignGpuDevices("container4", 3)
+	if err != nil {
+		t.Fatalf("Failed to assign GPUs to container4: %v", err)
+	}
+
+	assignedDevices, err := manager.AssignGpuDevices("container5", 2)
+	if err != nil {
+		t.Fatalf("Failed to assign GPUs to container5: %v", err)
+	}
+
+	if assignedDevices.visible != "3,7" || assignedDevices.cpus != "" {
+		t.Errorf("Expected GPUs '3,7' without a cpuset, got '%s' with cpuset '%s'", assignedDevices.visible, assignedDevices.cpus)
+	}
+}
+
+func TestLoadTopologyFailure(t *testing.T) {
+	manager := NewContainerCudaManager(2)
+	manager.statFunc = mockStat
+
+	err := manager.LoadTopology(func() (*GpuTopology, error) {
+		return nil, fmt.Errorf("mock discovery error")
+	})
+	if err == nil {
+		t.Errorf("Expected error due to discovery failure, but got none")
+	}
+
+	// Without a topology, devices are assigned in order
+	assignedDevices, err := manager.AssignGpuDevices("container1", 2)
+	if err != nil {
+		t.Fatalf("Failed to assign GPU devices: %v", err)
+	}
+
+	if assignedDevices.visible != "0,1" || assignedDevices.cpus != "" {
+		t.Errorf("Expected GPUs '0,1' without a cpuset, got '%s' with cpuset '%s'", assignedDevices.visible, assignedDevices.cpus)
+	}
+}
+
+func TestGpuTopologyDiscoveryIsOptIn(t *testing.T) {
+	// Without a config file or hook there's no topology to load
+	if discover := NewGpuTopologyDiscoveryFunc(types.GpuTopologyConfig{}); discover != nil {
+		t.Errorf("Expected no topology discovery without a config file or hook")
+	}
+
+	configPath := t.TempDir() + "/gpu-topology.json"
+	err := os.WriteFile(configPath, []byte(`{"devices": [{"index": 0, "nvlink_group": 1, "numa_node": 0}], "numa_nodes": [{"id": 0, "cpus": "0-15"}]}`), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write topology file: %v", err)
+	}
+
+	topology, err := NewGpuTopologyDiscoveryFunc(types.GpuTopologyConfig{ConfigPath: configPath})()
+	if err != nil {
+		t.Fatalf("Failed to load topology file: %v", err)
+	}
+
+	if cpus, mems := topology.cpuset([]int{0}); cpus != "0-15" || mems != "0" {
+		t.Errorf("Expected cpuset '0-15' on node '0', got '%s' on node '%s'", cpus, mems)
+	}
+}
@@ -20,7 +20,7 @@ func (w *Worker) collectAndSendContainerMetrics(request *types.ContainerRequest,
 	ticker := time.NewTicker(w.config.Monitoring.ContainerMetricsInterval)
 	defer ticker.Stop()
 
-	monitor := NewProcessMonitor(containerPid, spec.Linux.Resources.Devices)
+	monitor := NewProcessMonitor(containerPid, spec.Linux.Resources.Devices, request.GpuSlice)
 
 	for {
 		select {
@@ -37,22 +37,24 @@ func (w *Worker) collectAndSendContainerMetrics(request *types.ContainerRequest,
 				w.workerId,
 				request,
 				types.EventContainerMetricsData{
-					CPUUsed:            stats.CPU,
-					CPUTotal:           uint64(request.Cpu),
-					CPUPercent:         float32((float64(stats.CPU) * 100 / float64(request.Cpu))),
-					MemoryRSS:          stats.Memory.RSS,
-					MemoryVMS:          stats.Memory.VMS,
-					MemorySwap:         stats.Memory.Swap,
-					MemoryTotal:        uint64(request.Memory * 1024 * 1024),
-					DiskReadBytes:      stats.IO.ReadBytes,
-					DiskWriteBytes:     stats.IO.WriteBytes,
-					NetworkBytesRecv:   stats.NetIO.BytesRecv,
-					NetworkBytesSent:   stats.NetIO.BytesSent,
-					NetworkPacketsRecv: stats.NetIO.PacketsRecv,
-					NetworkPacketsSent: stats.NetIO.PacketsSent,
-					GPUMemoryUsed:      stats.GPU.MemoryUsed,
-					GPUMemoryTotal:     stats.GPU.MemoryTotal,
-					GPUType:            request.Gpu,
+					CPUUsed:             stats.CPU,
+					CPUTotal:            uint64(request.Cpu),
+					CPUPercent:          float32((float64(stats.CPU) * 100 / float64(request.Cpu))),
+					MemoryRSS:           stats.Memory.RSS,
+					MemoryVMS:           stats.Memory.VMS,
+					MemorySwap:          stats.Memory.Swap,
+					MemoryTotal:         uint64(request.Memory * 1024 * 1024),
+					DiskReadBytes:       stats.IO.ReadBytes,
+					DiskWriteBytes:      stats.IO.WriteBytes,
+					NetworkBytesRecv:    stats.NetIO.BytesRecv,
+					NetworkBytesSent:    stats.NetIO.BytesSent,
+					NetworkPacketsRecv:  stats.NetIO.PacketsRecv,
+					NetworkPacketsSent:  stats.NetIO.PacketsSent,
+					GPUMemoryUsed:       stats.GPU.MemoryUsed,
+					GPUMemoryTotal:      stats.GPU.MemoryTotal,
+					GPUSliceMemoryUsed:  stats.GPU.SliceMemoryUsed,
+					GPUSliceMemoryTotal: stats.GPU.SliceMemoryTotal,
+					GPUType:             request.Gpu,
 				},
 			)
 		}
@@ -68,19 +70,22 @@ type ProcessStats struct {
 }
 
 type GPUInfoStat struct {
-	MemoryUsed  uint64
-	MemoryTotal uint64
+	MemoryUsed       uint64
+	MemoryTotal      uint64
+	SliceMemoryUsed  uint64 // Only set for containers sharing a gpu
+	SliceMemoryTotal uint64
 }
 
 type ProcessMonitor struct {
 	pid       int32
 	devices   []specs.LinuxDeviceCgroup
+	gpuSlice  *types.GpuSlice
 	lastIO    process.IOCountersStat
 	lastNetIO net.IOCountersStat
 }
 
-func NewProcessMonitor(pid int, devices []specs.LinuxDeviceCgroup) *ProcessMonitor {
-	return &ProcessMonitor{pid: int32(pid), devices: devices}
+func NewProcessMonitor(pid int, devices []specs.LinuxDeviceCgroup, gpuSlice *types.GpuSlice) *ProcessMonitor {
+	return &ProcessMonitor{pid: int32(pid), devices: devices, gpuSlice: gpuSlice}
 }
 
 func (m *ProcessMonitor) GetStatistics() (*ProcessStats, error) {
@@ -90,6 +95,7 @@ func (m *ProcessMonitor) GetStatistics() (*ProcessStats, error) {
 	}
 
 	gpuStat := m.fetchGPUMemory()
+	m.fetchGPUSliceMemory(processes, gpuStat)
 
 	netIOStat, err := m.fetchNetworkIO()
 	if err != nil {
@@ -128,6 +134,25 @@ func (m *ProcessMonitor) fetchGPUMemory() *GPUInfoStat {
 	return stat
 }
 
+// fetchGPUSliceMemory sums the gpu memory used by the container's processes. Device-wide usage
+// includes every container sharing the gpu, so it says little about a single slice.
+func (m *ProcessMonitor) fetchGPUSliceMemory(processes []*process.Process, stat *GPUInfoStat) {
+	if m.gpuSlice == nil {
+		return
+	}
+
+	stat.SliceMemoryTotal = uint64(m.gpuSlice.MemoryLimitMiB * 1024 * 1024)
+
+	usage, err := GetGpuProcessMemoryUsage(m.gpuSlice.Device)
+	if err != nil {
+		return
+	}
+
+	for _, p := range processes {
+		stat.SliceMemoryUsed += uint64(usage[p.Pid])
+	}
+}
+
 // fetchNetworkIO gets network IO for all NICs globally, regardless of process.
 // Avoid using this data for external purposes.
 // TODO: Look into per-process network IO
@@ -56,6 +56,42 @@ func GetGpuMemoryUsage(deviceIndex int) (GpuMemoryUsageStats, error) {
 	return stats, nil
 }
 
+// GetGpuProcessMemoryUsage retrieves the memory used by each process running on a specific NVIDIA GPU.
+// It returns the used memory in bytes, keyed by pid.
+func GetGpuProcessMemoryUsage(deviceIndex int) (map[int32]int64, error) {
+	usage := map[int32]int64{}
+
+	command := "nvidia-smi"
+	commandArgs := []string{"--query-compute-apps=pid,used_memory", "--format=csv,noheader,nounits", fmt.Sprintf("--id=%d", deviceIndex)}
+
+	out, err := exec.Command(command, commandArgs...).Output()
+	if err != nil {
+		return usage, fmt.Errorf("unable to invoke nvidia-smi: %v", err)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ",")
+		if len(fields) != 2 {
+			continue
+		}
+
+		pid, err := strconv.ParseInt(strings.Trim(fields[0], " "), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		used, err := strconv.ParseInt(strings.Trim(fields[1], " "), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		usage[int32(pid)] += used * 1024 * 1024
+	}
+
+	return usage, nil
+}
+
 func GetSystemCPU() (float64, error) {
 	fs, err := procfs.NewFS("/proc")
 	if err != nil {
@@ -0,0 +1,163 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+
+	types "github.com/beam-cloud/beta9/pkg/types"
+)
+
+// GpuTopology describes how a worker's gpus are connected, so that multi-gpu containers can be kept
+// within a single NVLink group and pinned to the cpus closest to their gpus
+type GpuTopology struct {
+	Devices   []GpuDeviceTopology `json:"devices"`
+	NumaNodes []NumaNodeTopology  `json:"numa_nodes"`
+}
+
+type GpuDeviceTopology struct {
+	Index       int `json:"index"`
+	NvlinkGroup int `json:"nvlink_group"`
+	NumaNode    int `json:"numa_node"`
+}
+
+type NumaNodeTopology struct {
+	Id   int    `json:"id"`
+	Cpus string `json:"cpus"` // Cpuset, e.g. "0-31,64-95"
+}
+
+// GpuTopologyDiscoveryFunc loads the topology of the worker's gpus
+type GpuTopologyDiscoveryFunc func() (*GpuTopology, error)
+
+// NewGpuTopologyDiscoveryFunc returns the topology source for a worker. A config file takes precedence over a
+// discovery hook. Without either there's no topology, since NVLink groups can't be inferred reliably, so nil is
+// returned and gpus are assigned without one.
+func NewGpuTopologyDiscoveryFunc(config types.GpuTopologyConfig) GpuTopologyDiscoveryFunc {
+	switch {
+	case config.ConfigPath != "":
+		return func() (*GpuTopology, error) {
+			return loadGpuTopologyFile(config.ConfigPath)
+		}
+	case config.DiscoveryCommand != "":
+		return func() (*GpuTopology, error) {
+			return runGpuTopologyHook(config.DiscoveryCommand)
+		}
+	default:
+		return nil
+	}
+}
+
+func loadGpuTopologyFile(path string) (*GpuTopology, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	topology := &GpuTopology{}
+	if err := json.Unmarshal(data, topology); err != nil {
+		return nil, fmt.Errorf("unable to parse gpu topology file: %v", err)
+	}
+
+	return topology, nil
+}
+
+// runGpuTopologyHook runs a discovery command, which prints the topology as json to stdout
+func runGpuTopologyHook(command string) (*GpuTopology, error) {
+	out, err := exec.Command("sh", "-c", command).Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to run gpu topology hook: %v", err)
+	}
+
+	topology := &GpuTopology{}
+	if err := json.Unmarshal(out, topology); err != nil {
+		return nil, fmt.Errorf("unable to parse gpu topology hook output: %v", err)
+	}
+
+	return topology, nil
+}
+
+func (t *GpuTopology) device(index int) (GpuDeviceTopology, bool) {
+	for _, device := range t.Devices {
+		if device.Index == index {
+			return device, true
+		}
+	}
+
+	return GpuDeviceTopology{}, false
+}
+
+// selectDevices picks count devices from the free devices, keeping them in as few NVLink groups as possible.
+// If a single group can hold every device, the smallest such group is used, so larger groups stay free for
+// larger requests. Devices missing from the topology are treated as a group of their own.
+func (t *GpuTopology) selectDevices(free []int, count int) []int {
+	groups := map[int][]int{}
+	for _, index := range free {
+		group := -(index + 1)
+		if device, ok := t.device(index); ok {
+			group = device.NvlinkGroup
+		}
+
+		groups[group] = append(groups[group], index)
+	}
+
+	groupIds := make([]int, 0, len(groups))
+	for id := range groups {
+		groupIds = append(groupIds, id)
+	}
+
+	// Largest groups first, then by group id so the order is stable
+	sort.Slice(groupIds, func(i, j int) bool {
+		if len(groups[groupIds[i]]) != len(groups[groupIds[j]]) {
+			return len(groups[groupIds[i]]) > len(groups[groupIds[j]])
+		}
+		return groupIds[i] < groupIds[j]
+	})
+
+	bestFit := -1
+	for i, id := range groupIds {
+		if len(groups[id]) >= count && (bestFit == -1 || len(groups[id]) < len(groups[groupIds[bestFit]])) {
+			bestFit = i
+		}
+	}
+
+	if bestFit != -1 {
+		return groups[groupIds[bestFit]][:count]
+	}
+
+	selected := []int{}
+	for _, id := range groupIds {
+		for _, index := range groups[id] {
+			if len(selected) == count {
+				return selected
+			}
+			selected = append(selected, index)
+		}
+	}
+
+	return selected
+}
+
+// cpuset returns the cpus and memory nodes of the NUMA node shared by every device. Devices that span
+// NUMA nodes, or a node without a known cpuset, leave the container unpinned.
+func (t *GpuTopology) cpuset(indexes []int) (string, string) {
+	numaNode := -1
+	for _, index := range indexes {
+		device, ok := t.device(index)
+		if !ok || (numaNode != -1 && device.NumaNode != numaNode) {
+			return "", ""
+		}
+
+		numaNode = device.NumaNode
+	}
+
+	for _, node := range t.NumaNodes {
+		if node.Id == numaNode && node.Cpus != "" {
+			return node.Cpus, strconv.Itoa(node.Id)
+		}
+	}
+
+	return "", ""
+}
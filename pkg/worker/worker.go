@@ -27,6 +27,8 @@ import (
 const (
 	requestProcessingInterval     time.Duration = 100 * time.Millisecond
 	containerStatusUpdateInterval time.Duration = 30 * time.Second
+	groupPeerPollInterval         time.Duration = 500 * time.Millisecond
+	groupPeerTimeout              time.Duration = 5 * time.Minute
 )
 
 type Worker struct {
@@ -154,6 +156,16 @@ func NewWorker() (*Worker, error) {
 		return nil, err
 	}
 
+	// Gpus are only kept within NVLink groups, and containers pinned to their NUMA node, if the topology was supplied
+	containerCudaManager := NewContainerCudaManager(uint32(gpuCount))
+	discoverTopology := NewGpuTopologyDiscoveryFunc(config.Worker.GpuTopology)
+	if gpuCount > 0 && discoverTopology != nil {
+		err = containerCudaManager.LoadTopology(discoverTopology)
+		if err != nil {
+			log.Printf("Unable to load gpu topology, gpus will be assigned without it: %v\n", err)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	workerMetrics, err := NewWorkerMetrics(ctx, workerId, workerRepo, config.Monitoring)
@@ -173,7 +185,7 @@ func NewWorker() (*Worker, error) {
 		gpuCount:             uint32(gpuCount),
 		runcHandle:           runc.Runc{},
 		runcServer:           runcServer,
-		containerCudaManager: NewContainerCudaManager(uint32(gpuCount)),
+		containerCudaManager: containerCudaManager,
 		redisClient:          redisClient,
 		podAddr:              podAddr,
 		imageClient:          imageClient,
@@ -252,12 +264,19 @@ func (s *Worker) Run() error {
 	return s.shutdown()
 }
 
-// Only exit if there are no containers running, and no containers have recently been spun up on this worker
+// Only exit if there are no containers running, and either no containers have recently been spun up on this worker
+// or the pool is draining this worker
 func (s *Worker) shouldShutDown(lastContainerRequest time.Time) bool {
-	if (time.Since(lastContainerRequest).Seconds() > defaultWorkerSpindownTimeS) && s.containerInstances.Len() == 0 {
+	if s.containerInstances.Len() > 0 {
+		return false
+	}
+
+	if time.Since(lastContainerRequest).Seconds() > defaultWorkerSpindownTimeS {
 		return true
 	}
-	return false
+
+	worker, err := s.workerRepo.GetWorkerById(s.workerId)
+	return err == nil && worker.Status == types.WorkerStatusDraining
 }
 
 // Spawn a single container and stream output to stdout/stderr
@@ -583,6 +602,18 @@ func (s *Worker) spawn(request *types.ContainerRequest, bundlePath string, spec
 
 	spec.Root.Path = containerInstance.Overlay.TopLayerPath()
 
+	// Containers in a group can't start until every peer has an address
+	if request.GroupId != "" {
+		peerAddrs, err := s.waitForGroupPeers(request)
+		if err != nil {
+			log.Printf("<%s> failed to resolve group peers: %v", containerId, err)
+			containerErr = err
+			return
+		}
+
+		spec.Process.Env = append(spec.Process.Env, fmt.Sprintf("GROUP_PEER_ADDRS=%s", strings.Join(peerAddrs, ",")))
+	}
+
 	// Write runc config spec to disk
 	configContents, err := json.MarshalIndent(spec, "", " ")
 	if err != nil {
@@ -633,6 +664,41 @@ func (s *Worker) spawn(request *types.ContainerRequest, bundlePath string, spec
 	}
 }
 
+// waitForGroupPeers returns the address of every container in the request's group, ordered by rank.
+// Peers may be placed on other workers, so their addresses are polled until all of them are set.
+func (s *Worker) waitForGroupPeers(request *types.ContainerRequest) ([]string, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, groupPeerTimeout)
+	defer cancel()
+
+	peerAddrs := make([]string, len(request.GroupPeers))
+	for {
+		resolved := true
+		for rank, peerId := range request.GroupPeers {
+			if peerAddrs[rank] != "" {
+				continue
+			}
+
+			addr, err := s.containerRepo.GetContainerAddress(peerId)
+			if err != nil {
+				resolved = false
+				continue
+			}
+
+			peerAddrs[rank] = addr
+		}
+
+		if resolved {
+			return peerAddrs, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for peers in group <%s>", request.GroupId)
+		case <-time.After(groupPeerPollInterval):
+		}
+	}
+}
+
 func (s *Worker) getContainerEnvironment(request *types.ContainerRequest, options *ContainerOptions) []string {
 	env := []string{
 		fmt.Sprintf("BIND_PORT=%d", options.BindPort),
@@ -700,15 +766,32 @@ func (s *Worker) specFromRequest(request *types.ContainerRequest, options *Conta
 			spec.Mounts = s.containerCudaManager.InjectCudaMounts(spec.Mounts)
 		}
 
-		// Assign n-number of GPUs to a container
-		assignedGpus, err := s.containerCudaManager.AssignGpuDevices(request.ContainerId, request.GpuCount)
-		if err != nil {
-			return nil, err
+		var assignedGpus *AssignedGpuDevices
+		if request.GpuSlice != nil {
+			// Share a single GPU with other containers, capped to the reserved slice
+			assignedGpus, err = s.containerCudaManager.AssignGpuSlice(request.ContainerId, request.GpuSlice)
+			if err != nil {
+				return nil, err
+			}
+
+			env = append(env, s.containerCudaManager.GpuSliceEnvVars(request.GpuSlice)...)
+			spec.Mounts = s.containerCudaManager.InjectMpsMounts(spec.Mounts)
+		} else {
+			// Assign n-number of GPUs to a container
+			assignedGpus, err = s.containerCudaManager.AssignGpuDevices(request.ContainerId, request.GpuCount)
+			if err != nil {
+				return nil, err
+			}
 		}
 		env = append(env, fmt.Sprintf("NVIDIA_VISIBLE_DEVICES=%s", assignedGpus.String()))
 
 		spec.Linux.Resources.Devices = append(spec.Linux.Resources.Devices, assignedGpus.devices...)
 
+		// Keep the container's cpus on the same NUMA node as its GPUs, if the worker was given a topology
+		if assignedGpus.cpus != "" {
+			spec.Linux.Resources.CPU = &specs.LinuxCPU{Cpus: assignedGpus.cpus, Mems: assignedGpus.mems}
+		}
+
 	} else {
 		spec.Hooks.Prestart = nil
 	}
@@ -19,27 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GatewayService_Authorize_FullMethodName            = "/gateway.GatewayService/Authorize"
-	GatewayService_SignPayload_FullMethodName          = "/gateway.GatewayService/SignPayload"
-	GatewayService_HeadObject_FullMethodName           = "/gateway.GatewayService/HeadObject"
-	GatewayService_PutObject_FullMethodName            = "/gateway.GatewayService/PutObject"
-	GatewayService_PutObjectStream_FullMethodName      = "/gateway.GatewayService/PutObjectStream"
-	GatewayService_ReplaceObjectContent_FullMethodName = "/gateway.GatewayService/ReplaceObjectContent"
-	GatewayService_ListContainers_FullMethodName       = "/gateway.GatewayService/ListContainers"
-	GatewayService_StopContainer_FullMethodName        = "/gateway.GatewayService/StopContainer"
-	GatewayService_StartTask_FullMethodName            = "/gateway.GatewayService/StartTask"
-	GatewayService_EndTask_FullMethodName              = "/gateway.GatewayService/EndTask"
-	GatewayService_StopTasks_FullMethodName            = "/gateway.GatewayService/StopTasks"
-	GatewayService_ListTasks_FullMethodName            = "/gateway.GatewayService/ListTasks"
-	GatewayService_GetOrCreateStub_FullMethodName      = "/gateway.GatewayService/GetOrCreateStub"
-	GatewayService_DeployStub_FullMethodName           = "/gateway.GatewayService/DeployStub"
-	GatewayService_ListDeployments_FullMethodName      = "/gateway.GatewayService/ListDeployments"
-	GatewayService_StopDeployment_FullMethodName       = "/gateway.GatewayService/StopDeployment"
-	GatewayService_DeleteDeployment_FullMethodName     = "/gateway.GatewayService/DeleteDeployment"
-	GatewayService_ListPools_FullMethodName            = "/gateway.GatewayService/ListPools"
-	GatewayService_ListMachines_FullMethodName         = "/gateway.GatewayService/ListMachines"
-	GatewayService_CreateMachine_FullMethodName        = "/gateway.GatewayService/CreateMachine"
-	GatewayService_DeleteMachine_FullMethodName        = "/gateway.GatewayService/DeleteMachine"
+	GatewayService_Authorize_FullMethodName               = "/gateway.GatewayService/Authorize"
+	GatewayService_SignPayload_FullMethodName             = "/gateway.GatewayService/SignPayload"
+	GatewayService_HeadObject_FullMethodName              = "/gateway.GatewayService/HeadObject"
+	GatewayService_PutObject_FullMethodName               = "/gateway.GatewayService/PutObject"
+	GatewayService_PutObjectStream_FullMethodName         = "/gateway.GatewayService/PutObjectStream"
+	GatewayService_ReplaceObjectContent_FullMethodName    = "/gateway.GatewayService/ReplaceObjectContent"
+	GatewayService_ListContainers_FullMethodName          = "/gateway.GatewayService/ListContainers"
+	GatewayService_StopContainer_FullMethodName           = "/gateway.GatewayService/StopContainer"
+	GatewayService_StartTask_FullMethodName               = "/gateway.GatewayService/StartTask"
+	GatewayService_EndTask_FullMethodName                 = "/gateway.GatewayService/EndTask"
+	GatewayService_StopTasks_FullMethodName               = "/gateway.GatewayService/StopTasks"
+	GatewayService_WatchTaskCancellation_FullMethodName   = "/gateway.GatewayService/WatchTaskCancellation"
+	GatewayService_ResolveTaskCancellation_FullMethodName = "/gateway.GatewayService/ResolveTaskCancellation"
+	GatewayService_ListTasks_FullMethodName               = "/gateway.GatewayService/ListTasks"
+	GatewayService_GetOrCreateStub_FullMethodName         = "/gateway.GatewayService/GetOrCreateStub"
+	GatewayService_DeployStub_FullMethodName              = "/gateway.GatewayService/DeployStub"
+	GatewayService_ListDeployments_FullMethodName         = "/gateway.GatewayService/ListDeployments"
+	GatewayService_StopDeployment_FullMethodName          = "/gateway.GatewayService/StopDeployment"
+	GatewayService_DeleteDeployment_FullMethodName        = "/gateway.GatewayService/DeleteDeployment"
+	GatewayService_ListPools_FullMethodName               = "/gateway.GatewayService/ListPools"
+	GatewayService_ListMachines_FullMethodName            = "/gateway.GatewayService/ListMachines"
+	GatewayService_CreateMachine_FullMethodName           = "/gateway.GatewayService/CreateMachine"
+	GatewayService_DeleteMachine_FullMethodName           = "/gateway.GatewayService/DeleteMachine"
+	GatewayService_ExplainScheduling_FullMethodName       = "/gateway.GatewayService/ExplainScheduling"
 )
 
 // GatewayServiceClient is the client API for GatewayService service.
@@ -61,6 +64,8 @@ type GatewayServiceClient interface {
 	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
 	EndTask(ctx context.Context, in *EndTaskRequest, opts ...grpc.CallOption) (*EndTaskResponse, error)
 	StopTasks(ctx context.Context, in *StopTasksRequest, opts ...grpc.CallOption) (*StopTasksResponse, error)
+	WatchTaskCancellation(ctx context.Context, in *WatchTaskCancellationRequest, opts ...grpc.CallOption) (GatewayService_WatchTaskCancellationClient, error)
+	ResolveTaskCancellation(ctx context.Context, in *ResolveTaskCancellationRequest, opts ...grpc.CallOption) (*ResolveTaskCancellationResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	// Stubs
 	GetOrCreateStub(ctx context.Context, in *GetOrCreateStubRequest, opts ...grpc.CallOption) (*GetOrCreateStubResponse, error)
@@ -75,6 +80,8 @@ type GatewayServiceClient interface {
 	ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error)
 	CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error)
 	DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*DeleteMachineResponse, error)
+	// Scheduler
+	ExplainScheduling(ctx context.Context, in *ExplainSchedulingRequest, opts ...grpc.CallOption) (*ExplainSchedulingResponse, error)
 }
 
 type gatewayServiceClient struct {
@@ -209,6 +216,47 @@ func (c *gatewayServiceClient) StopTasks(ctx context.Context, in *StopTasksReque
 	return out, nil
 }
 
+func (c *gatewayServiceClient) WatchTaskCancellation(ctx context.Context, in *WatchTaskCancellationRequest, opts ...grpc.CallOption) (GatewayService_WatchTaskCancellationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GatewayService_ServiceDesc.Streams[1], GatewayService_WatchTaskCancellation_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayServiceWatchTaskCancellationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GatewayService_WatchTaskCancellationClient interface {
+	Recv() (*WatchTaskCancellationResponse, error)
+	grpc.ClientStream
+}
+
+type gatewayServiceWatchTaskCancellationClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayServiceWatchTaskCancellationClient) Recv() (*WatchTaskCancellationResponse, error) {
+	m := new(WatchTaskCancellationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayServiceClient) ResolveTaskCancellation(ctx context.Context, in *ResolveTaskCancellationRequest, opts ...grpc.CallOption) (*ResolveTaskCancellationResponse, error) {
+	out := new(ResolveTaskCancellationResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ResolveTaskCancellation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	out := new(ListTasksResponse)
 	err := c.cc.Invoke(ctx, GatewayService_ListTasks_FullMethodName, in, out, opts...)
@@ -299,6 +347,15 @@ func (c *gatewayServiceClient) DeleteMachine(ctx context.Context, in *DeleteMach
 	return out, nil
 }
 
+func (c *gatewayServiceClient) ExplainScheduling(ctx context.Context, in *ExplainSchedulingRequest, opts ...grpc.CallOption) (*ExplainSchedulingResponse, error) {
+	out := new(ExplainSchedulingResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ExplainScheduling_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServiceServer is the server API for GatewayService service.
 // All implementations must embed UnimplementedGatewayServiceServer
 // for forward compatibility
@@ -318,6 +375,8 @@ type GatewayServiceServer interface {
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
 	EndTask(context.Context, *EndTaskRequest) (*EndTaskResponse, error)
 	StopTasks(context.Context, *StopTasksRequest) (*StopTasksResponse, error)
+	WatchTaskCancellation(*WatchTaskCancellationRequest, GatewayService_WatchTaskCancellationServer) error
+	ResolveTaskCancellation(context.Context, *ResolveTaskCancellationRequest) (*ResolveTaskCancellationResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	// Stubs
 	GetOrCreateStub(context.Context, *GetOrCreateStubRequest) (*GetOrCreateStubResponse, error)
@@ -332,6 +391,8 @@ type GatewayServiceServer interface {
 	ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error)
 	CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error)
 	DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error)
+	// Scheduler
+	ExplainScheduling(context.Context, *ExplainSchedulingRequest) (*ExplainSchedulingResponse, error)
 	mustEmbedUnimplementedGatewayServiceServer()
 }
 
@@ -372,6 +433,12 @@ func (UnimplementedGatewayServiceServer) EndTask(context.Context, *EndTaskReques
 func (UnimplementedGatewayServiceServer) StopTasks(context.Context, *StopTasksRequest) (*StopTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopTasks not implemented")
 }
+func (UnimplementedGatewayServiceServer) WatchTaskCancellation(*WatchTaskCancellationRequest, GatewayService_WatchTaskCancellationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTaskCancellation not implemented")
+}
+func (UnimplementedGatewayServiceServer) ResolveTaskCancellation(context.Context, *ResolveTaskCancellationRequest) (*ResolveTaskCancellationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTaskCancellation not implemented")
+}
 func (UnimplementedGatewayServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
@@ -402,6 +469,9 @@ func (UnimplementedGatewayServiceServer) CreateMachine(context.Context, *CreateM
 func (UnimplementedGatewayServiceServer) DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMachine not implemented")
 }
+func (UnimplementedGatewayServiceServer) ExplainScheduling(context.Context, *ExplainSchedulingRequest) (*ExplainSchedulingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainScheduling not implemented")
+}
 func (UnimplementedGatewayServiceServer) mustEmbedUnimplementedGatewayServiceServer() {}
 
 // UnsafeGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -621,6 +691,45 @@ func _GatewayService_StopTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_WatchTaskCancellation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskCancellationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServiceServer).WatchTaskCancellation(m, &gatewayServiceWatchTaskCancellationServer{stream})
+}
+
+type GatewayService_WatchTaskCancellationServer interface {
+	Send(*WatchTaskCancellationResponse) error
+	grpc.ServerStream
+}
+
+type gatewayServiceWatchTaskCancellationServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayServiceWatchTaskCancellationServer) Send(m *WatchTaskCancellationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GatewayService_ResolveTaskCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTaskCancellationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ResolveTaskCancellation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ResolveTaskCancellation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ResolveTaskCancellation(ctx, req.(*ResolveTaskCancellationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
@@ -801,6 +910,24 @@ func _GatewayService_DeleteMachine_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_ExplainScheduling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSchedulingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ExplainScheduling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ExplainScheduling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ExplainScheduling(ctx, req.(*ExplainSchedulingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GatewayService_ServiceDesc is the grpc.ServiceDesc for GatewayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -848,6 +975,10 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopTasks",
 			Handler:    _GatewayService_StopTasks_Handler,
 		},
+		{
+			MethodName: "ResolveTaskCancellation",
+			Handler:    _GatewayService_ResolveTaskCancellation_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _GatewayService_ListTasks_Handler,
@@ -888,6 +1019,10 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMachine",
 			Handler:    _GatewayService_DeleteMachine_Handler,
 		},
+		{
+			MethodName: "ExplainScheduling",
+			Handler:    _GatewayService_ExplainScheduling_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -895,6 +1030,11 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GatewayService_PutObjectStream_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchTaskCancellation",
+			Handler:       _GatewayService_WatchTaskCancellation_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gateway.proto",
 }
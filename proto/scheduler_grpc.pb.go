@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Scheduler_GetVersion_FullMethodName   = "/scheduler.Scheduler/GetVersion"
-	Scheduler_RunContainer_FullMethodName = "/scheduler.Scheduler/RunContainer"
+	Scheduler_GetVersion_FullMethodName        = "/scheduler.Scheduler/GetVersion"
+	Scheduler_RunContainer_FullMethodName      = "/scheduler.Scheduler/RunContainer"
+	Scheduler_RunContainerGroup_FullMethodName = "/scheduler.Scheduler/RunContainerGroup"
 )
 
 // SchedulerClient is the client API for Scheduler service.
@@ -29,6 +30,7 @@ const (
 type SchedulerClient interface {
 	GetVersion(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	RunContainer(ctx context.Context, in *RunContainerRequest, opts ...grpc.CallOption) (*RunContainerResponse, error)
+	RunContainerGroup(ctx context.Context, in *RunContainerGroupRequest, opts ...grpc.CallOption) (*RunContainerGroupResponse, error)
 }
 
 type schedulerClient struct {
@@ -57,12 +59,22 @@ func (c *schedulerClient) RunContainer(ctx context.Context, in *RunContainerRequ
 	return out, nil
 }
 
+func (c *schedulerClient) RunContainerGroup(ctx context.Context, in *RunContainerGroupRequest, opts ...grpc.CallOption) (*RunContainerGroupResponse, error) {
+	out := new(RunContainerGroupResponse)
+	err := c.cc.Invoke(ctx, Scheduler_RunContainerGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerServer is the server API for Scheduler service.
 // All implementations must embed UnimplementedSchedulerServer
 // for forward compatibility
 type SchedulerServer interface {
 	GetVersion(context.Context, *VersionRequest) (*VersionResponse, error)
 	RunContainer(context.Context, *RunContainerRequest) (*RunContainerResponse, error)
+	RunContainerGroup(context.Context, *RunContainerGroupRequest) (*RunContainerGroupResponse, error)
 	mustEmbedUnimplementedSchedulerServer()
 }
 
@@ -76,6 +88,9 @@ func (UnimplementedSchedulerServer) GetVersion(context.Context, *VersionRequest)
 func (UnimplementedSchedulerServer) RunContainer(context.Context, *RunContainerRequest) (*RunContainerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunContainer not implemented")
 }
+func (UnimplementedSchedulerServer) RunContainerGroup(context.Context, *RunContainerGroupRequest) (*RunContainerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunContainerGroup not implemented")
+}
 func (UnimplementedSchedulerServer) mustEmbedUnimplementedSchedulerServer() {}
 
 // UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +140,24 @@ func _Scheduler_RunContainer_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_RunContainerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunContainerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).RunContainerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_RunContainerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).RunContainerGroup(ctx, req.(*RunContainerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Scheduler_ServiceDesc is the grpc.ServiceDesc for Scheduler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +173,10 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunContainer",
 			Handler:    _Scheduler_RunContainer_Handler,
 		},
+		{
+			MethodName: "RunContainerGroup",
+			Handler:    _Scheduler_RunContainerGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "scheduler.proto",
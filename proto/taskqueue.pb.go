@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -25,8 +26,11 @@ type TaskQueuePutRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId  string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	StubId         string                 `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Payload        []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	RunAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	DelaySeconds   int64                  `protobuf:"varint,4,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *TaskQueuePutRequest) Reset() {
@@ -75,6 +79,27 @@ func (x *TaskQueuePutRequest) GetPayload() []byte {
 	return nil
 }
 
+func (x *TaskQueuePutRequest) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TaskQueuePutRequest) GetDelaySeconds() int64 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *TaskQueuePutRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type TaskQueuePutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -130,17 +155,19 @@ func (x *TaskQueuePutResponse) GetTaskId() string {
 	return ""
 }
 
-type TaskQueuePopRequest struct {
+type TaskQueueBatchItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId      string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Payload        []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	RunAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	DelaySeconds   int64                  `protobuf:"varint,3,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *TaskQueuePopRequest) Reset() {
-	*x = TaskQueuePopRequest{}
+func (x *TaskQueueBatchItem) Reset() {
+	*x = TaskQueueBatchItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,13 +175,13 @@ func (x *TaskQueuePopRequest) Reset() {
 	}
 }
 
-func (x *TaskQueuePopRequest) String() string {
+func (x *TaskQueueBatchItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueuePopRequest) ProtoMessage() {}
+func (*TaskQueueBatchItem) ProtoMessage() {}
 
-func (x *TaskQueuePopRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueBatchItem) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -166,36 +193,50 @@ func (x *TaskQueuePopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueuePopRequest.ProtoReflect.Descriptor instead.
-func (*TaskQueuePopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueBatchItem.ProtoReflect.Descriptor instead.
+func (*TaskQueueBatchItem) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TaskQueuePopRequest) GetStubId() string {
+func (x *TaskQueueBatchItem) GetPayload() []byte {
 	if x != nil {
-		return x.StubId
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskQueuePopRequest) GetContainerId() string {
+func (x *TaskQueueBatchItem) GetRunAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ContainerId
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TaskQueueBatchItem) GetDelaySeconds() int64 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *TaskQueueBatchItem) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-type TaskQueuePopResponse struct {
+type TaskQueuePutBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	TaskMsg []byte `protobuf:"bytes,2,opt,name=task_msg,json=taskMsg,proto3" json:"task_msg,omitempty"`
+	StubId string                `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Items  []*TaskQueueBatchItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *TaskQueuePopResponse) Reset() {
-	*x = TaskQueuePopResponse{}
+func (x *TaskQueuePutBatchRequest) Reset() {
+	*x = TaskQueuePutBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,13 +244,13 @@ func (x *TaskQueuePopResponse) Reset() {
 	}
 }
 
-func (x *TaskQueuePopResponse) String() string {
+func (x *TaskQueuePutBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueuePopResponse) ProtoMessage() {}
+func (*TaskQueuePutBatchRequest) ProtoMessage() {}
 
-func (x *TaskQueuePopResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueuePutBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -221,35 +262,37 @@ func (x *TaskQueuePopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueuePopResponse.ProtoReflect.Descriptor instead.
-func (*TaskQueuePopResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueuePutBatchRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueuePutBatchRequest) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TaskQueuePopResponse) GetOk() bool {
+func (x *TaskQueuePutBatchRequest) GetStubId() string {
 	if x != nil {
-		return x.Ok
+		return x.StubId
 	}
-	return false
+	return ""
 }
 
-func (x *TaskQueuePopResponse) GetTaskMsg() []byte {
+func (x *TaskQueuePutBatchRequest) GetItems() []*TaskQueueBatchItem {
 	if x != nil {
-		return x.TaskMsg
+		return x.Items
 	}
 	return nil
 }
 
-type TaskQueueLengthRequest struct {
+type TaskQueuePutBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Ok      bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	TaskIds []string `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	ErrMsg  string   `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
 }
 
-func (x *TaskQueueLengthRequest) Reset() {
-	*x = TaskQueueLengthRequest{}
+func (x *TaskQueuePutBatchResponse) Reset() {
+	*x = TaskQueuePutBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -257,13 +300,13 @@ func (x *TaskQueueLengthRequest) Reset() {
 	}
 }
 
-func (x *TaskQueueLengthRequest) String() string {
+func (x *TaskQueuePutBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueLengthRequest) ProtoMessage() {}
+func (*TaskQueuePutBatchResponse) ProtoMessage() {}
 
-func (x *TaskQueueLengthRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueuePutBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,29 +318,43 @@ func (x *TaskQueueLengthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueLengthRequest.ProtoReflect.Descriptor instead.
-func (*TaskQueueLengthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueuePutBatchResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueuePutBatchResponse) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TaskQueueLengthRequest) GetStubId() string {
+func (x *TaskQueuePutBatchResponse) GetOk() bool {
 	if x != nil {
-		return x.StubId
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueuePutBatchResponse) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *TaskQueuePutBatchResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
 	}
 	return ""
 }
 
-type TaskQueueLengthResponse struct {
+type TaskQueueTaskStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok     bool  `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Length int64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *TaskQueueLengthResponse) Reset() {
-	*x = TaskQueueLengthResponse{}
+func (x *TaskQueueTaskStatus) Reset() {
+	*x = TaskQueueTaskStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,13 +362,13 @@ func (x *TaskQueueLengthResponse) Reset() {
 	}
 }
 
-func (x *TaskQueueLengthResponse) String() string {
+func (x *TaskQueueTaskStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueLengthResponse) ProtoMessage() {}
+func (*TaskQueueTaskStatus) ProtoMessage() {}
 
-func (x *TaskQueueLengthResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueTaskStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,41 +380,36 @@ func (x *TaskQueueLengthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueLengthResponse.ProtoReflect.Descriptor instead.
-func (*TaskQueueLengthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueTaskStatus.ProtoReflect.Descriptor instead.
+func (*TaskQueueTaskStatus) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TaskQueueLengthResponse) GetOk() bool {
+func (x *TaskQueueTaskStatus) GetTaskId() string {
 	if x != nil {
-		return x.Ok
+		return x.TaskId
 	}
-	return false
+	return ""
 }
 
-func (x *TaskQueueLengthResponse) GetLength() int64 {
+func (x *TaskQueueTaskStatus) GetStatus() string {
 	if x != nil {
-		return x.Length
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type TaskQueueCompleteRequest struct {
+type TaskQueueGetTaskStatusesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId            string  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	StubId            string  `protobuf:"bytes,2,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	TaskDuration      float32 `protobuf:"fixed32,3,opt,name=task_duration,json=taskDuration,proto3" json:"task_duration,omitempty"`
-	TaskStatus        string  `protobuf:"bytes,4,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
-	ContainerId       string  `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ContainerHostname string  `protobuf:"bytes,6,opt,name=container_hostname,json=containerHostname,proto3" json:"container_hostname,omitempty"`
-	KeepWarmSeconds   float32 `protobuf:"fixed32,7,opt,name=keep_warm_seconds,json=keepWarmSeconds,proto3" json:"keep_warm_seconds,omitempty"`
+	StubId  string   `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskIds []string `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
 }
 
-func (x *TaskQueueCompleteRequest) Reset() {
-	*x = TaskQueueCompleteRequest{}
+func (x *TaskQueueGetTaskStatusesRequest) Reset() {
+	*x = TaskQueueGetTaskStatusesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,13 +417,13 @@ func (x *TaskQueueCompleteRequest) Reset() {
 	}
 }
 
-func (x *TaskQueueCompleteRequest) String() string {
+func (x *TaskQueueGetTaskStatusesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueCompleteRequest) ProtoMessage() {}
+func (*TaskQueueGetTaskStatusesRequest) ProtoMessage() {}
 
-func (x *TaskQueueCompleteRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueGetTaskStatusesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,70 +435,37 @@ func (x *TaskQueueCompleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueCompleteRequest.ProtoReflect.Descriptor instead.
-func (*TaskQueueCompleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueGetTaskStatusesRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetTaskStatusesRequest) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TaskQueueCompleteRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *TaskQueueCompleteRequest) GetStubId() string {
+func (x *TaskQueueGetTaskStatusesRequest) GetStubId() string {
 	if x != nil {
 		return x.StubId
 	}
 	return ""
 }
 
-func (x *TaskQueueCompleteRequest) GetTaskDuration() float32 {
-	if x != nil {
-		return x.TaskDuration
-	}
-	return 0
-}
-
-func (x *TaskQueueCompleteRequest) GetTaskStatus() string {
-	if x != nil {
-		return x.TaskStatus
-	}
-	return ""
-}
-
-func (x *TaskQueueCompleteRequest) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *TaskQueueCompleteRequest) GetContainerHostname() string {
-	if x != nil {
-		return x.ContainerHostname
-	}
-	return ""
-}
-
-func (x *TaskQueueCompleteRequest) GetKeepWarmSeconds() float32 {
+func (x *TaskQueueGetTaskStatusesRequest) GetTaskIds() []string {
 	if x != nil {
-		return x.KeepWarmSeconds
+		return x.TaskIds
 	}
-	return 0
+	return nil
 }
 
-type TaskQueueCompleteResponse struct {
+type TaskQueueGetTaskStatusesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Ok       bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Statuses []*TaskQueueTaskStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	ErrMsg   string                 `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
 }
 
-func (x *TaskQueueCompleteResponse) Reset() {
-	*x = TaskQueueCompleteResponse{}
+func (x *TaskQueueGetTaskStatusesResponse) Reset() {
+	*x = TaskQueueGetTaskStatusesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +473,13 @@ func (x *TaskQueueCompleteResponse) Reset() {
 	}
 }
 
-func (x *TaskQueueCompleteResponse) String() string {
+func (x *TaskQueueGetTaskStatusesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueCompleteResponse) ProtoMessage() {}
+func (*TaskQueueGetTaskStatusesResponse) ProtoMessage() {}
 
-func (x *TaskQueueCompleteResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueGetTaskStatusesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,30 +491,43 @@ func (x *TaskQueueCompleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueCompleteResponse.ProtoReflect.Descriptor instead.
-func (*TaskQueueCompleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueGetTaskStatusesResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetTaskStatusesResponse) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TaskQueueCompleteResponse) GetOk() bool {
+func (x *TaskQueueGetTaskStatusesResponse) GetOk() bool {
 	if x != nil {
 		return x.Ok
 	}
 	return false
 }
 
-type TaskQueueMonitorRequest struct {
+func (x *TaskQueueGetTaskStatusesResponse) GetStatuses() []*TaskQueueTaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TaskQueueGetTaskStatusesResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type TaskQueuePopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId      string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	StubId      string `protobuf:"bytes,2,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	ContainerId string `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	StubId      string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (x *TaskQueueMonitorRequest) Reset() {
-	*x = TaskQueueMonitorRequest{}
+func (x *TaskQueuePopRequest) Reset() {
+	*x = TaskQueuePopRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,13 +535,13 @@ func (x *TaskQueueMonitorRequest) Reset() {
 	}
 }
 
-func (x *TaskQueueMonitorRequest) String() string {
+func (x *TaskQueuePopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueMonitorRequest) ProtoMessage() {}
+func (*TaskQueuePopRequest) ProtoMessage() {}
 
-func (x *TaskQueueMonitorRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueuePopRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,45 +553,36 @@ func (x *TaskQueueMonitorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueMonitorRequest.ProtoReflect.Descriptor instead.
-func (*TaskQueueMonitorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueuePopRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueuePopRequest) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TaskQueueMonitorRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *TaskQueueMonitorRequest) GetStubId() string {
+func (x *TaskQueuePopRequest) GetStubId() string {
 	if x != nil {
 		return x.StubId
 	}
 	return ""
 }
 
-func (x *TaskQueueMonitorRequest) GetContainerId() string {
+func (x *TaskQueuePopRequest) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-type TaskQueueMonitorResponse struct {
+type TaskQueuePopResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok        bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
-	Complete  bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
-	TimedOut  bool `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	TaskMsg []byte `protobuf:"bytes,2,opt,name=task_msg,json=taskMsg,proto3" json:"task_msg,omitempty"`
 }
 
-func (x *TaskQueueMonitorResponse) Reset() {
-	*x = TaskQueueMonitorResponse{}
+func (x *TaskQueuePopResponse) Reset() {
+	*x = TaskQueuePopResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,13 +590,13 @@ func (x *TaskQueueMonitorResponse) Reset() {
 	}
 }
 
-func (x *TaskQueueMonitorResponse) String() string {
+func (x *TaskQueuePopResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueMonitorResponse) ProtoMessage() {}
+func (*TaskQueuePopResponse) ProtoMessage() {}
 
-func (x *TaskQueueMonitorResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueuePopResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,64 +608,49 @@ func (x *TaskQueueMonitorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueMonitorResponse.ProtoReflect.Descriptor instead.
-func (*TaskQueueMonitorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueuePopResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueuePopResponse) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TaskQueueMonitorResponse) GetOk() bool {
+func (x *TaskQueuePopResponse) GetOk() bool {
 	if x != nil {
 		return x.Ok
 	}
 	return false
 }
 
-func (x *TaskQueueMonitorResponse) GetCancelled() bool {
-	if x != nil {
-		return x.Cancelled
-	}
-	return false
-}
-
-func (x *TaskQueueMonitorResponse) GetComplete() bool {
-	if x != nil {
-		return x.Complete
-	}
-	return false
-}
-
-func (x *TaskQueueMonitorResponse) GetTimedOut() bool {
+func (x *TaskQueuePopResponse) GetTaskMsg() []byte {
 	if x != nil {
-		return x.TimedOut
+		return x.TaskMsg
 	}
-	return false
+	return nil
 }
 
-type StartTaskQueueServeRequest struct {
+type TaskQueueLengthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId  string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	Timeout int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
 }
 
-func (x *StartTaskQueueServeRequest) Reset() {
-	*x = StartTaskQueueServeRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *TaskQueueLengthRequest) Reset() {
+	*x = TaskQueueLengthRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartTaskQueueServeRequest) String() string {
+func (x *TaskQueueLengthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskQueueServeRequest) ProtoMessage() {}
+func (*TaskQueueLengthRequest) ProtoMessage() {}
 
-func (x *StartTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueLengthRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,37 +662,29 @@ func (x *StartTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskQueueServeRequest.ProtoReflect.Descriptor instead.
-func (*StartTaskQueueServeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueLengthRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueLengthRequest) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StartTaskQueueServeRequest) GetStubId() string {
+func (x *TaskQueueLengthRequest) GetStubId() string {
 	if x != nil {
 		return x.StubId
 	}
 	return ""
 }
 
-func (x *StartTaskQueueServeRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-type StartTaskQueueServeResponse struct {
+type TaskQueueLengthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output   string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
-	Done     bool   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
-	ExitCode int32  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Ok     bool  `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Length int64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (x *StartTaskQueueServeResponse) Reset() {
-	*x = StartTaskQueueServeResponse{}
+func (x *TaskQueueLengthResponse) Reset() {
+	*x = TaskQueueLengthResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +692,13 @@ func (x *StartTaskQueueServeResponse) Reset() {
 	}
 }
 
-func (x *StartTaskQueueServeResponse) String() string {
+func (x *TaskQueueLengthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskQueueServeResponse) ProtoMessage() {}
+func (*TaskQueueLengthResponse) ProtoMessage() {}
 
-func (x *StartTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueLengthResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,42 +710,44 @@ func (x *StartTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskQueueServeResponse.ProtoReflect.Descriptor instead.
-func (*StartTaskQueueServeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueLengthResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueLengthResponse) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StartTaskQueueServeResponse) GetOutput() string {
-	if x != nil {
-		return x.Output
-	}
-	return ""
-}
-
-func (x *StartTaskQueueServeResponse) GetDone() bool {
+func (x *TaskQueueLengthResponse) GetOk() bool {
 	if x != nil {
-		return x.Done
+		return x.Ok
 	}
 	return false
 }
 
-func (x *StartTaskQueueServeResponse) GetExitCode() int32 {
+func (x *TaskQueueLengthResponse) GetLength() int64 {
 	if x != nil {
-		return x.ExitCode
+		return x.Length
 	}
 	return 0
 }
 
-type StopTaskQueueServeRequest struct {
+type TaskQueueCompleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskId            string  `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StubId            string  `protobuf:"bytes,2,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskDuration      float32 `protobuf:"fixed32,3,opt,name=task_duration,json=taskDuration,proto3" json:"task_duration,omitempty"`
+	TaskStatus        string  `protobuf:"bytes,4,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
+	ContainerId       string  `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerHostname string  `protobuf:"bytes,6,opt,name=container_hostname,json=containerHostname,proto3" json:"container_hostname,omitempty"`
+	KeepWarmSeconds   float32 `protobuf:"fixed32,7,opt,name=keep_warm_seconds,json=keepWarmSeconds,proto3" json:"keep_warm_seconds,omitempty"`
+	ErrorKind         string  `protobuf:"bytes,8,opt,name=error_kind,json=errorKind,proto3" json:"error_kind,omitempty"`
+	ErrorMessage      string  `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Result            []byte  `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *StopTaskQueueServeRequest) Reset() {
-	*x = StopTaskQueueServeRequest{}
+func (x *TaskQueueCompleteRequest) Reset() {
+	*x = TaskQueueCompleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,13 +755,13 @@ func (x *StopTaskQueueServeRequest) Reset() {
 	}
 }
 
-func (x *StopTaskQueueServeRequest) String() string {
+func (x *TaskQueueCompleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopTaskQueueServeRequest) ProtoMessage() {}
+func (*TaskQueueCompleteRequest) ProtoMessage() {}
 
-func (x *StopTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueCompleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,19 +773,82 @@ func (x *StopTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopTaskQueueServeRequest.ProtoReflect.Descriptor instead.
-func (*StopTaskQueueServeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueCompleteRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueCompleteRequest) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StopTaskQueueServeRequest) GetStubId() string {
+func (x *TaskQueueCompleteRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetStubId() string {
 	if x != nil {
 		return x.StubId
 	}
 	return ""
 }
 
-type StopTaskQueueServeResponse struct {
+func (x *TaskQueueCompleteRequest) GetTaskDuration() float32 {
+	if x != nil {
+		return x.TaskDuration
+	}
+	return 0
+}
+
+func (x *TaskQueueCompleteRequest) GetTaskStatus() string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetContainerHostname() string {
+	if x != nil {
+		return x.ContainerHostname
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetKeepWarmSeconds() float32 {
+	if x != nil {
+		return x.KeepWarmSeconds
+	}
+	return 0
+}
+
+func (x *TaskQueueCompleteRequest) GetErrorKind() string {
+	if x != nil {
+		return x.ErrorKind
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TaskQueueCompleteRequest) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TaskQueueCompleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -791,8 +856,8 @@ type StopTaskQueueServeResponse struct {
 	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 }
 
-func (x *StopTaskQueueServeResponse) Reset() {
-	*x = StopTaskQueueServeResponse{}
+func (x *TaskQueueCompleteResponse) Reset() {
+	*x = TaskQueueCompleteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +865,13 @@ func (x *StopTaskQueueServeResponse) Reset() {
 	}
 }
 
-func (x *StopTaskQueueServeResponse) String() string {
+func (x *TaskQueueCompleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopTaskQueueServeResponse) ProtoMessage() {}
+func (*TaskQueueCompleteResponse) ProtoMessage() {}
 
-func (x *StopTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueCompleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,29 +883,30 @@ func (x *StopTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopTaskQueueServeResponse.ProtoReflect.Descriptor instead.
-func (*StopTaskQueueServeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueCompleteResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueCompleteResponse) Descriptor() ([]byte, []int) {
 	return file_taskqueue_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StopTaskQueueServeResponse) GetOk() bool {
+func (x *TaskQueueCompleteResponse) GetOk() bool {
 	if x != nil {
 		return x.Ok
 	}
 	return false
 }
 
-type TaskQueueServeKeepAliveRequest struct {
+type TaskQueueGetResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StubId  string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
-	Timeout int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	StubId      string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskId      string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WaitSeconds int32  `protobuf:"varint,3,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
 }
 
-func (x *TaskQueueServeKeepAliveRequest) Reset() {
-	*x = TaskQueueServeKeepAliveRequest{}
+func (x *TaskQueueGetResultRequest) Reset() {
+	*x = TaskQueueGetResultRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taskqueue_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,13 +914,13 @@ func (x *TaskQueueServeKeepAliveRequest) Reset() {
 	}
 }
 
-func (x *TaskQueueServeKeepAliveRequest) String() string {
+func (x *TaskQueueGetResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueServeKeepAliveRequest) ProtoMessage() {}
+func (*TaskQueueGetResultRequest) ProtoMessage() {}
 
-func (x *TaskQueueServeKeepAliveRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueGetResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taskqueue_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,50 +932,1098 @@ func (x *TaskQueueServeKeepAliveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueServeKeepAliveRequest.ProtoReflect.Descriptor instead.
-func (*TaskQueueServeKeepAliveRequest) Descriptor() ([]byte, []int) {
-	return file_taskqueue_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TaskQueueGetResultRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetResultRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TaskQueueGetResultRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueGetResultRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskQueueGetResultRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+type TaskQueueGetResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok     bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Done   bool   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Result []byte `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	ErrMsg string `protobuf:"bytes,5,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+}
+
+func (x *TaskQueueGetResultResponse) Reset() {
+	*x = TaskQueueGetResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueGetResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueGetResultResponse) ProtoMessage() {}
+
+func (x *TaskQueueGetResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueGetResultResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetResultResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TaskQueueGetResultResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueueGetResultResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *TaskQueueGetResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskQueueGetResultResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *TaskQueueGetResultResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type TaskQueueMonitorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId      string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StubId      string `protobuf:"bytes,2,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	ContainerId string `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (x *TaskQueueMonitorRequest) Reset() {
+	*x = TaskQueueMonitorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueMonitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueMonitorRequest) ProtoMessage() {}
+
+func (x *TaskQueueMonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueMonitorRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueMonitorRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TaskQueueMonitorRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskQueueMonitorRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueMonitorRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+type TaskQueueMonitorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok                       bool    `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Cancelled                bool    `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Complete                 bool    `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	TimedOut                 bool    `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	CancelGracePeriodSeconds float32 `protobuf:"fixed32,5,opt,name=cancel_grace_period_seconds,json=cancelGracePeriodSeconds,proto3" json:"cancel_grace_period_seconds,omitempty"`
+}
+
+func (x *TaskQueueMonitorResponse) Reset() {
+	*x = TaskQueueMonitorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueMonitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueMonitorResponse) ProtoMessage() {}
+
+func (x *TaskQueueMonitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueMonitorResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueMonitorResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TaskQueueMonitorResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueueMonitorResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *TaskQueueMonitorResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *TaskQueueMonitorResponse) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *TaskQueueMonitorResponse) GetCancelGracePeriodSeconds() float32 {
+	if x != nil {
+		return x.CancelGracePeriodSeconds
+	}
+	return 0
+}
+
+type StartTaskQueueServeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId  string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Timeout int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *StartTaskQueueServeRequest) Reset() {
+	*x = StartTaskQueueServeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTaskQueueServeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskQueueServeRequest) ProtoMessage() {}
+
+func (x *StartTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskQueueServeRequest.ProtoReflect.Descriptor instead.
+func (*StartTaskQueueServeRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StartTaskQueueServeRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *StartTaskQueueServeRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type StartTaskQueueServeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output   string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Done     bool   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	ExitCode int32  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *StartTaskQueueServeResponse) Reset() {
+	*x = StartTaskQueueServeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTaskQueueServeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskQueueServeResponse) ProtoMessage() {}
+
+func (x *StartTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskQueueServeResponse.ProtoReflect.Descriptor instead.
+func (*StartTaskQueueServeResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StartTaskQueueServeResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *StartTaskQueueServeResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *StartTaskQueueServeResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type StopTaskQueueServeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+}
+
+func (x *StopTaskQueueServeRequest) Reset() {
+	*x = StopTaskQueueServeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTaskQueueServeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskQueueServeRequest) ProtoMessage() {}
+
+func (x *StopTaskQueueServeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskQueueServeRequest.ProtoReflect.Descriptor instead.
+func (*StopTaskQueueServeRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StopTaskQueueServeRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+type StopTaskQueueServeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *StopTaskQueueServeResponse) Reset() {
+	*x = StopTaskQueueServeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTaskQueueServeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskQueueServeResponse) ProtoMessage() {}
+
+func (x *StopTaskQueueServeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskQueueServeResponse.ProtoReflect.Descriptor instead.
+func (*StopTaskQueueServeResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StopTaskQueueServeResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type TaskQueueServeKeepAliveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId  string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Timeout int32  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *TaskQueueServeKeepAliveRequest) Reset() {
+	*x = TaskQueueServeKeepAliveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueServeKeepAliveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueServeKeepAliveRequest) ProtoMessage() {}
+
+func (x *TaskQueueServeKeepAliveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueServeKeepAliveRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueServeKeepAliveRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TaskQueueServeKeepAliveRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueServeKeepAliveRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type TaskQueueServeKeepAliveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *TaskQueueServeKeepAliveResponse) Reset() {
+	*x = TaskQueueServeKeepAliveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueServeKeepAliveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueServeKeepAliveResponse) ProtoMessage() {}
+
+func (x *TaskQueueServeKeepAliveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueServeKeepAliveResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueServeKeepAliveResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskQueueServeKeepAliveResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type DeadLetterTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StubId         string                 `protobuf:"bytes,2,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskMsg        []byte                 `protobuf:"bytes,3,opt,name=task_msg,json=taskMsg,proto3" json:"task_msg,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Retries        uint32                 `protobuf:"varint,6,opt,name=retries,proto3" json:"retries,omitempty"`
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+}
+
+func (x *DeadLetterTask) Reset() {
+	*x = DeadLetterTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetterTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterTask) ProtoMessage() {}
+
+func (x *DeadLetterTask) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterTask.ProtoReflect.Descriptor instead.
+func (*DeadLetterTask) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeadLetterTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeadLetterTask) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *DeadLetterTask) GetTaskMsg() []byte {
+	if x != nil {
+		return x.TaskMsg
+	}
+	return nil
+}
+
+func (x *DeadLetterTask) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetterTask) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetterTask) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *DeadLetterTask) GetDeadLetteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return nil
+}
+
+type TaskQueueListDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *TaskQueueListDeadLettersRequest) Reset() {
+	*x = TaskQueueListDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueListDeadLettersRequest) ProtoMessage() {}
+
+func (x *TaskQueueListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TaskQueueListDeadLettersRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueListDeadLettersRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TaskQueueListDeadLettersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TaskQueueListDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok     bool              `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Tasks  []*DeadLetterTask `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total  uint32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	ErrMsg string            `protobuf:"bytes,4,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+}
+
+func (x *TaskQueueListDeadLettersResponse) Reset() {
+	*x = TaskQueueListDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueListDeadLettersResponse) ProtoMessage() {}
+
+func (x *TaskQueueListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TaskQueueListDeadLettersResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueueListDeadLettersResponse) GetTasks() []*DeadLetterTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *TaskQueueListDeadLettersResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TaskQueueListDeadLettersResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type TaskQueueGetDeadLetterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *TaskQueueGetDeadLetterRequest) Reset() {
+	*x = TaskQueueGetDeadLetterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueGetDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueGetDeadLetterRequest) ProtoMessage() {}
+
+func (x *TaskQueueGetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueGetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TaskQueueGetDeadLetterRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueGetDeadLetterRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type TaskQueueGetDeadLetterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok     bool            `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Task   *DeadLetterTask `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	ErrMsg string          `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+}
+
+func (x *TaskQueueGetDeadLetterResponse) Reset() {
+	*x = TaskQueueGetDeadLetterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueGetDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueGetDeadLetterResponse) ProtoMessage() {}
+
+func (x *TaskQueueGetDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueGetDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueGetDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TaskQueueGetDeadLetterResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueueGetDeadLetterResponse) GetTask() *DeadLetterTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskQueueGetDeadLetterResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type TaskQueueRedriveDeadLetterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *TaskQueueRedriveDeadLetterRequest) Reset() {
+	*x = TaskQueueRedriveDeadLetterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueRedriveDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueRedriveDeadLetterRequest) ProtoMessage() {}
+
+func (x *TaskQueueRedriveDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueRedriveDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueueRedriveDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TaskQueueRedriveDeadLetterRequest) GetStubId() string {
+	if x != nil {
+		return x.StubId
+	}
+	return ""
+}
+
+func (x *TaskQueueRedriveDeadLetterRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type TaskQueueRedriveDeadLetterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok     bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	ErrMsg string `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+}
+
+func (x *TaskQueueRedriveDeadLetterResponse) Reset() {
+	*x = TaskQueueRedriveDeadLetterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueueRedriveDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueueRedriveDeadLetterResponse) ProtoMessage() {}
+
+func (x *TaskQueueRedriveDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueueRedriveDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueueRedriveDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TaskQueueRedriveDeadLetterResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TaskQueueRedriveDeadLetterResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type TaskQueuePurgeDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StubId string `protobuf:"bytes,1,opt,name=stub_id,json=stubId,proto3" json:"stub_id,omitempty"`
+}
+
+func (x *TaskQueuePurgeDeadLettersRequest) Reset() {
+	*x = TaskQueuePurgeDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taskqueue_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskQueuePurgeDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePurgeDeadLettersRequest) ProtoMessage() {}
+
+func (x *TaskQueuePurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*TaskQueuePurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *TaskQueueServeKeepAliveRequest) GetStubId() string {
+func (x *TaskQueuePurgeDeadLettersRequest) GetStubId() string {
 	if x != nil {
 		return x.StubId
 	}
 	return ""
 }
 
-func (x *TaskQueueServeKeepAliveRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-type TaskQueueServeKeepAliveResponse struct {
+type TaskQueuePurgeDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Ok     bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Purged uint32 `protobuf:"varint,2,opt,name=purged,proto3" json:"purged,omitempty"`
+	ErrMsg string `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
 }
 
-func (x *TaskQueueServeKeepAliveResponse) Reset() {
-	*x = TaskQueueServeKeepAliveResponse{}
+func (x *TaskQueuePurgeDeadLettersResponse) Reset() {
+	*x = TaskQueuePurgeDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taskqueue_proto_msgTypes[15]
+		mi := &file_taskqueue_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskQueueServeKeepAliveResponse) String() string {
+func (x *TaskQueuePurgeDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskQueueServeKeepAliveResponse) ProtoMessage() {}
+func (*TaskQueuePurgeDeadLettersResponse) ProtoMessage() {}
 
-func (x *TaskQueueServeKeepAliveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskqueue_proto_msgTypes[15]
+func (x *TaskQueuePurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskqueue_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,163 +2034,369 @@ func (x *TaskQueueServeKeepAliveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskQueueServeKeepAliveResponse.ProtoReflect.Descriptor instead.
-func (*TaskQueueServeKeepAliveResponse) Descriptor() ([]byte, []int) {
-	return file_taskqueue_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TaskQueuePurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*TaskQueuePurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_taskqueue_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TaskQueueServeKeepAliveResponse) GetOk() bool {
+func (x *TaskQueuePurgeDeadLettersResponse) GetOk() bool {
 	if x != nil {
 		return x.Ok
 	}
 	return false
 }
 
+func (x *TaskQueuePurgeDeadLettersResponse) GetPurged() uint32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *TaskQueuePurgeDeadLettersResponse) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
 var File_taskqueue_proto protoreflect.FileDescriptor
 
 var file_taskqueue_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x48, 0x0a, 0x13,
-	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3f, 0x0a, 0x14, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x17,
-	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x54, 0x61,
-	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02,
-	0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4d, 0x73, 0x67, 0x22, 0x31, 0x0a,
-	0x16, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64,
-	0x22, 0x41, 0x0a, 0x17, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x22, 0x90, 0x02, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75,
-	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61,
-	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x65,
-	0x65, 0x70, 0x5f, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6b, 0x65, 0x65, 0x70, 0x57, 0x61, 0x72, 0x6d, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6f, 0x12, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x01,
+	0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x14, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72,
+	0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x68, 0x0a, 0x18,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5f, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x02, 0x6f, 0x6b, 0x22, 0x6e, 0x0a, 0x17, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x02, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x46, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
 	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x55, 0x0a, 0x1f, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x20, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x3a, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x22, 0x51, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64,
 	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x4f, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x4d, 0x73, 0x67, 0x22, 0x31, 0x0a, 0x16, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x17, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xec, 0x02, 0x0a,
+	0x18, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x77, 0x61, 0x72, 0x6d,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f,
+	0x6b, 0x65, 0x65, 0x70, 0x57, 0x61, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x70, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x66, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64,
-	0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0x34, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77,
+	0x61, 0x69, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x6e, 0x0a, 0x17, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74,
+	0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75,
+	0x62, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x6f, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x1a, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x66, 0x0a, 0x1b, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x53, 0x0a, 0x1e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x1f, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0xeb,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74,
+	0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75,
+	0x62, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4d, 0x73, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x68, 0x0a, 0x1f,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x20, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x51, 0x0a, 0x1d, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x78,
+	0x0a, 0x1e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x55, 0x0a, 0x21, 0x54, 0x61, 0x73, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x02, 0x6f, 0x6b, 0x22, 0x53, 0x0a, 0x1e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x1f, 0x54, 0x61, 0x73,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41,
-	0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x32, 0x98, 0x06, 0x0a,
-	0x10, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x51, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75,
-	0x74, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x50, 0x6f, 0x70, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x61,
+	0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22,
+	0x4d, 0x0a, 0x22, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x3b,
+	0x0a, 0x20, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x21, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x32, 0xb5, 0x0c, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x70, 0x12, 0x1e, 0x2e, 0x74, 0x61,
 	0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x74, 0x61,
+	0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61,
 	0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x2e, 0x74, 0x61,
+	0x65, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x60, 0x0a,
+	0x11, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x12, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61,
 	0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x18, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x12, 0x2a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21,
 	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x63,
-	0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x61, 0x73,
-	0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x29,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12, 0x25,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x29, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70,
+	0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x18, 0x54, 0x61,
+	0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6f, 0x0a, 0x16, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1a, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x2c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
 	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x65, 0x61, 0x6d, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2f, 0x62, 0x65, 0x74, 0x61, 0x39, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x78, 0x0a, 0x19, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x65, 0x61, 0x6d, 0x2d, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2f, 0x62, 0x65, 0x74, 0x61, 0x39, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1091,47 +2411,86 @@ func file_taskqueue_proto_rawDescGZIP() []byte {
 	return file_taskqueue_proto_rawDescData
 }
 
-var file_taskqueue_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_taskqueue_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_taskqueue_proto_goTypes = []interface{}{
-	(*TaskQueuePutRequest)(nil),             // 0: taskqueue.TaskQueuePutRequest
-	(*TaskQueuePutResponse)(nil),            // 1: taskqueue.TaskQueuePutResponse
-	(*TaskQueuePopRequest)(nil),             // 2: taskqueue.TaskQueuePopRequest
-	(*TaskQueuePopResponse)(nil),            // 3: taskqueue.TaskQueuePopResponse
-	(*TaskQueueLengthRequest)(nil),          // 4: taskqueue.TaskQueueLengthRequest
-	(*TaskQueueLengthResponse)(nil),         // 5: taskqueue.TaskQueueLengthResponse
-	(*TaskQueueCompleteRequest)(nil),        // 6: taskqueue.TaskQueueCompleteRequest
-	(*TaskQueueCompleteResponse)(nil),       // 7: taskqueue.TaskQueueCompleteResponse
-	(*TaskQueueMonitorRequest)(nil),         // 8: taskqueue.TaskQueueMonitorRequest
-	(*TaskQueueMonitorResponse)(nil),        // 9: taskqueue.TaskQueueMonitorResponse
-	(*StartTaskQueueServeRequest)(nil),      // 10: taskqueue.StartTaskQueueServeRequest
-	(*StartTaskQueueServeResponse)(nil),     // 11: taskqueue.StartTaskQueueServeResponse
-	(*StopTaskQueueServeRequest)(nil),       // 12: taskqueue.StopTaskQueueServeRequest
-	(*StopTaskQueueServeResponse)(nil),      // 13: taskqueue.StopTaskQueueServeResponse
-	(*TaskQueueServeKeepAliveRequest)(nil),  // 14: taskqueue.TaskQueueServeKeepAliveRequest
-	(*TaskQueueServeKeepAliveResponse)(nil), // 15: taskqueue.TaskQueueServeKeepAliveResponse
+	(*TaskQueuePutRequest)(nil),                // 0: taskqueue.TaskQueuePutRequest
+	(*TaskQueuePutResponse)(nil),               // 1: taskqueue.TaskQueuePutResponse
+	(*TaskQueueBatchItem)(nil),                 // 2: taskqueue.TaskQueueBatchItem
+	(*TaskQueuePutBatchRequest)(nil),           // 3: taskqueue.TaskQueuePutBatchRequest
+	(*TaskQueuePutBatchResponse)(nil),          // 4: taskqueue.TaskQueuePutBatchResponse
+	(*TaskQueueTaskStatus)(nil),                // 5: taskqueue.TaskQueueTaskStatus
+	(*TaskQueueGetTaskStatusesRequest)(nil),    // 6: taskqueue.TaskQueueGetTaskStatusesRequest
+	(*TaskQueueGetTaskStatusesResponse)(nil),   // 7: taskqueue.TaskQueueGetTaskStatusesResponse
+	(*TaskQueuePopRequest)(nil),                // 8: taskqueue.TaskQueuePopRequest
+	(*TaskQueuePopResponse)(nil),               // 9: taskqueue.TaskQueuePopResponse
+	(*TaskQueueLengthRequest)(nil),             // 10: taskqueue.TaskQueueLengthRequest
+	(*TaskQueueLengthResponse)(nil),            // 11: taskqueue.TaskQueueLengthResponse
+	(*TaskQueueCompleteRequest)(nil),           // 12: taskqueue.TaskQueueCompleteRequest
+	(*TaskQueueCompleteResponse)(nil),          // 13: taskqueue.TaskQueueCompleteResponse
+	(*TaskQueueGetResultRequest)(nil),          // 14: taskqueue.TaskQueueGetResultRequest
+	(*TaskQueueGetResultResponse)(nil),         // 15: taskqueue.TaskQueueGetResultResponse
+	(*TaskQueueMonitorRequest)(nil),            // 16: taskqueue.TaskQueueMonitorRequest
+	(*TaskQueueMonitorResponse)(nil),           // 17: taskqueue.TaskQueueMonitorResponse
+	(*StartTaskQueueServeRequest)(nil),         // 18: taskqueue.StartTaskQueueServeRequest
+	(*StartTaskQueueServeResponse)(nil),        // 19: taskqueue.StartTaskQueueServeResponse
+	(*StopTaskQueueServeRequest)(nil),          // 20: taskqueue.StopTaskQueueServeRequest
+	(*StopTaskQueueServeResponse)(nil),         // 21: taskqueue.StopTaskQueueServeResponse
+	(*TaskQueueServeKeepAliveRequest)(nil),     // 22: taskqueue.TaskQueueServeKeepAliveRequest
+	(*TaskQueueServeKeepAliveResponse)(nil),    // 23: taskqueue.TaskQueueServeKeepAliveResponse
+	(*DeadLetterTask)(nil),                     // 24: taskqueue.DeadLetterTask
+	(*TaskQueueListDeadLettersRequest)(nil),    // 25: taskqueue.TaskQueueListDeadLettersRequest
+	(*TaskQueueListDeadLettersResponse)(nil),   // 26: taskqueue.TaskQueueListDeadLettersResponse
+	(*TaskQueueGetDeadLetterRequest)(nil),      // 27: taskqueue.TaskQueueGetDeadLetterRequest
+	(*TaskQueueGetDeadLetterResponse)(nil),     // 28: taskqueue.TaskQueueGetDeadLetterResponse
+	(*TaskQueueRedriveDeadLetterRequest)(nil),  // 29: taskqueue.TaskQueueRedriveDeadLetterRequest
+	(*TaskQueueRedriveDeadLetterResponse)(nil), // 30: taskqueue.TaskQueueRedriveDeadLetterResponse
+	(*TaskQueuePurgeDeadLettersRequest)(nil),   // 31: taskqueue.TaskQueuePurgeDeadLettersRequest
+	(*TaskQueuePurgeDeadLettersResponse)(nil),  // 32: taskqueue.TaskQueuePurgeDeadLettersResponse
+	(*timestamppb.Timestamp)(nil),              // 33: google.protobuf.Timestamp
 }
 var file_taskqueue_proto_depIdxs = []int32{
-	0,  // 0: taskqueue.TaskQueueService.TaskQueuePut:input_type -> taskqueue.TaskQueuePutRequest
-	2,  // 1: taskqueue.TaskQueueService.TaskQueuePop:input_type -> taskqueue.TaskQueuePopRequest
-	8,  // 2: taskqueue.TaskQueueService.TaskQueueMonitor:input_type -> taskqueue.TaskQueueMonitorRequest
-	6,  // 3: taskqueue.TaskQueueService.TaskQueueComplete:input_type -> taskqueue.TaskQueueCompleteRequest
-	4,  // 4: taskqueue.TaskQueueService.TaskQueueLength:input_type -> taskqueue.TaskQueueLengthRequest
-	10, // 5: taskqueue.TaskQueueService.StartTaskQueueServe:input_type -> taskqueue.StartTaskQueueServeRequest
-	12, // 6: taskqueue.TaskQueueService.StopTaskQueueServe:input_type -> taskqueue.StopTaskQueueServeRequest
-	14, // 7: taskqueue.TaskQueueService.TaskQueueServeKeepAlive:input_type -> taskqueue.TaskQueueServeKeepAliveRequest
-	1,  // 8: taskqueue.TaskQueueService.TaskQueuePut:output_type -> taskqueue.TaskQueuePutResponse
-	3,  // 9: taskqueue.TaskQueueService.TaskQueuePop:output_type -> taskqueue.TaskQueuePopResponse
-	9,  // 10: taskqueue.TaskQueueService.TaskQueueMonitor:output_type -> taskqueue.TaskQueueMonitorResponse
-	7,  // 11: taskqueue.TaskQueueService.TaskQueueComplete:output_type -> taskqueue.TaskQueueCompleteResponse
-	5,  // 12: taskqueue.TaskQueueService.TaskQueueLength:output_type -> taskqueue.TaskQueueLengthResponse
-	11, // 13: taskqueue.TaskQueueService.StartTaskQueueServe:output_type -> taskqueue.StartTaskQueueServeResponse
-	13, // 14: taskqueue.TaskQueueService.StopTaskQueueServe:output_type -> taskqueue.StopTaskQueueServeResponse
-	15, // 15: taskqueue.TaskQueueService.TaskQueueServeKeepAlive:output_type -> taskqueue.TaskQueueServeKeepAliveResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	33, // 0: taskqueue.TaskQueuePutRequest.run_at:type_name -> google.protobuf.Timestamp
+	33, // 1: taskqueue.TaskQueueBatchItem.run_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: taskqueue.TaskQueuePutBatchRequest.items:type_name -> taskqueue.TaskQueueBatchItem
+	5,  // 3: taskqueue.TaskQueueGetTaskStatusesResponse.statuses:type_name -> taskqueue.TaskQueueTaskStatus
+	33, // 4: taskqueue.DeadLetterTask.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	24, // 5: taskqueue.TaskQueueListDeadLettersResponse.tasks:type_name -> taskqueue.DeadLetterTask
+	24, // 6: taskqueue.TaskQueueGetDeadLetterResponse.task:type_name -> taskqueue.DeadLetterTask
+	0,  // 7: taskqueue.TaskQueueService.TaskQueuePut:input_type -> taskqueue.TaskQueuePutRequest
+	3,  // 8: taskqueue.TaskQueueService.TaskQueuePutBatch:input_type -> taskqueue.TaskQueuePutBatchRequest
+	8,  // 9: taskqueue.TaskQueueService.TaskQueuePop:input_type -> taskqueue.TaskQueuePopRequest
+	16, // 10: taskqueue.TaskQueueService.TaskQueueMonitor:input_type -> taskqueue.TaskQueueMonitorRequest
+	12, // 11: taskqueue.TaskQueueService.TaskQueueComplete:input_type -> taskqueue.TaskQueueCompleteRequest
+	14, // 12: taskqueue.TaskQueueService.TaskQueueGetResult:input_type -> taskqueue.TaskQueueGetResultRequest
+	6,  // 13: taskqueue.TaskQueueService.TaskQueueGetTaskStatuses:input_type -> taskqueue.TaskQueueGetTaskStatusesRequest
+	10, // 14: taskqueue.TaskQueueService.TaskQueueLength:input_type -> taskqueue.TaskQueueLengthRequest
+	18, // 15: taskqueue.TaskQueueService.StartTaskQueueServe:input_type -> taskqueue.StartTaskQueueServeRequest
+	20, // 16: taskqueue.TaskQueueService.StopTaskQueueServe:input_type -> taskqueue.StopTaskQueueServeRequest
+	22, // 17: taskqueue.TaskQueueService.TaskQueueServeKeepAlive:input_type -> taskqueue.TaskQueueServeKeepAliveRequest
+	25, // 18: taskqueue.TaskQueueService.TaskQueueListDeadLetters:input_type -> taskqueue.TaskQueueListDeadLettersRequest
+	27, // 19: taskqueue.TaskQueueService.TaskQueueGetDeadLetter:input_type -> taskqueue.TaskQueueGetDeadLetterRequest
+	29, // 20: taskqueue.TaskQueueService.TaskQueueRedriveDeadLetter:input_type -> taskqueue.TaskQueueRedriveDeadLetterRequest
+	31, // 21: taskqueue.TaskQueueService.TaskQueuePurgeDeadLetters:input_type -> taskqueue.TaskQueuePurgeDeadLettersRequest
+	1,  // 22: taskqueue.TaskQueueService.TaskQueuePut:output_type -> taskqueue.TaskQueuePutResponse
+	4,  // 23: taskqueue.TaskQueueService.TaskQueuePutBatch:output_type -> taskqueue.TaskQueuePutBatchResponse
+	9,  // 24: taskqueue.TaskQueueService.TaskQueuePop:output_type -> taskqueue.TaskQueuePopResponse
+	17, // 25: taskqueue.TaskQueueService.TaskQueueMonitor:output_type -> taskqueue.TaskQueueMonitorResponse
+	13, // 26: taskqueue.TaskQueueService.TaskQueueComplete:output_type -> taskqueue.TaskQueueCompleteResponse
+	15, // 27: taskqueue.TaskQueueService.TaskQueueGetResult:output_type -> taskqueue.TaskQueueGetResultResponse
+	7,  // 28: taskqueue.TaskQueueService.TaskQueueGetTaskStatuses:output_type -> taskqueue.TaskQueueGetTaskStatusesResponse
+	11, // 29: taskqueue.TaskQueueService.TaskQueueLength:output_type -> taskqueue.TaskQueueLengthResponse
+	19, // 30: taskqueue.TaskQueueService.StartTaskQueueServe:output_type -> taskqueue.StartTaskQueueServeResponse
+	21, // 31: taskqueue.TaskQueueService.StopTaskQueueServe:output_type -> taskqueue.StopTaskQueueServeResponse
+	23, // 32: taskqueue.TaskQueueService.TaskQueueServeKeepAlive:output_type -> taskqueue.TaskQueueServeKeepAliveResponse
+	26, // 33: taskqueue.TaskQueueService.TaskQueueListDeadLetters:output_type -> taskqueue.TaskQueueListDeadLettersResponse
+	28, // 34: taskqueue.TaskQueueService.TaskQueueGetDeadLetter:output_type -> taskqueue.TaskQueueGetDeadLetterResponse
+	30, // 35: taskqueue.TaskQueueService.TaskQueueRedriveDeadLetter:output_type -> taskqueue.TaskQueueRedriveDeadLetterResponse
+	32, // 36: taskqueue.TaskQueueService.TaskQueuePurgeDeadLetters:output_type -> taskqueue.TaskQueuePurgeDeadLettersResponse
+	22, // [22:37] is the sub-list for method output_type
+	7,  // [7:22] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_taskqueue_proto_init() }
@@ -1165,7 +2524,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueuePopRequest); i {
+			switch v := v.(*TaskQueueBatchItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1177,7 +2536,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueuePopResponse); i {
+			switch v := v.(*TaskQueuePutBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1189,7 +2548,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueLengthRequest); i {
+			switch v := v.(*TaskQueuePutBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1201,7 +2560,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueLengthResponse); i {
+			switch v := v.(*TaskQueueTaskStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1213,7 +2572,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueCompleteRequest); i {
+			switch v := v.(*TaskQueueGetTaskStatusesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1225,7 +2584,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueCompleteResponse); i {
+			switch v := v.(*TaskQueueGetTaskStatusesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1237,7 +2596,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueMonitorRequest); i {
+			switch v := v.(*TaskQueuePopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1249,7 +2608,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueMonitorResponse); i {
+			switch v := v.(*TaskQueuePopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1261,7 +2620,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartTaskQueueServeRequest); i {
+			switch v := v.(*TaskQueueLengthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1273,7 +2632,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartTaskQueueServeResponse); i {
+			switch v := v.(*TaskQueueLengthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1285,7 +2644,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopTaskQueueServeRequest); i {
+			switch v := v.(*TaskQueueCompleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1297,7 +2656,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopTaskQueueServeResponse); i {
+			switch v := v.(*TaskQueueCompleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1309,7 +2668,7 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskQueueServeKeepAliveRequest); i {
+			switch v := v.(*TaskQueueGetResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1321,6 +2680,102 @@ func file_taskqueue_proto_init() {
 			}
 		}
 		file_taskqueue_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueGetResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueMonitorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueMonitorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTaskQueueServeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTaskQueueServeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTaskQueueServeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTaskQueueServeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueServeKeepAliveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskQueueServeKeepAliveResponse); i {
 			case 0:
 				return &v.state
@@ -1332,6 +2787,114 @@ func file_taskqueue_proto_init() {
 				return nil
 			}
 		}
+		file_taskqueue_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetterTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueListDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueListDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueGetDeadLetterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueGetDeadLetterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueRedriveDeadLetterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueueRedriveDeadLetterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueuePurgeDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taskqueue_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskQueuePurgeDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1339,7 +2902,7 @@ func file_taskqueue_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_taskqueue_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
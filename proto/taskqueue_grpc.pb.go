@@ -19,14 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	TaskQueueService_TaskQueuePut_FullMethodName            = "/taskqueue.TaskQueueService/TaskQueuePut"
-	TaskQueueService_TaskQueuePop_FullMethodName            = "/taskqueue.TaskQueueService/TaskQueuePop"
-	TaskQueueService_TaskQueueMonitor_FullMethodName        = "/taskqueue.TaskQueueService/TaskQueueMonitor"
-	TaskQueueService_TaskQueueComplete_FullMethodName       = "/taskqueue.TaskQueueService/TaskQueueComplete"
-	TaskQueueService_TaskQueueLength_FullMethodName         = "/taskqueue.TaskQueueService/TaskQueueLength"
-	TaskQueueService_StartTaskQueueServe_FullMethodName     = "/taskqueue.TaskQueueService/StartTaskQueueServe"
-	TaskQueueService_StopTaskQueueServe_FullMethodName      = "/taskqueue.TaskQueueService/StopTaskQueueServe"
-	TaskQueueService_TaskQueueServeKeepAlive_FullMethodName = "/taskqueue.TaskQueueService/TaskQueueServeKeepAlive"
+	TaskQueueService_TaskQueuePut_FullMethodName               = "/taskqueue.TaskQueueService/TaskQueuePut"
+	TaskQueueService_TaskQueuePutBatch_FullMethodName          = "/taskqueue.TaskQueueService/TaskQueuePutBatch"
+	TaskQueueService_TaskQueuePop_FullMethodName               = "/taskqueue.TaskQueueService/TaskQueuePop"
+	TaskQueueService_TaskQueueMonitor_FullMethodName           = "/taskqueue.TaskQueueService/TaskQueueMonitor"
+	TaskQueueService_TaskQueueComplete_FullMethodName          = "/taskqueue.TaskQueueService/TaskQueueComplete"
+	TaskQueueService_TaskQueueGetResult_FullMethodName         = "/taskqueue.TaskQueueService/TaskQueueGetResult"
+	TaskQueueService_TaskQueueGetTaskStatuses_FullMethodName   = "/taskqueue.TaskQueueService/TaskQueueGetTaskStatuses"
+	TaskQueueService_TaskQueueLength_FullMethodName            = "/taskqueue.TaskQueueService/TaskQueueLength"
+	TaskQueueService_StartTaskQueueServe_FullMethodName        = "/taskqueue.TaskQueueService/StartTaskQueueServe"
+	TaskQueueService_StopTaskQueueServe_FullMethodName         = "/taskqueue.TaskQueueService/StopTaskQueueServe"
+	TaskQueueService_TaskQueueServeKeepAlive_FullMethodName    = "/taskqueue.TaskQueueService/TaskQueueServeKeepAlive"
+	TaskQueueService_TaskQueueListDeadLetters_FullMethodName   = "/taskqueue.TaskQueueService/TaskQueueListDeadLetters"
+	TaskQueueService_TaskQueueGetDeadLetter_FullMethodName     = "/taskqueue.TaskQueueService/TaskQueueGetDeadLetter"
+	TaskQueueService_TaskQueueRedriveDeadLetter_FullMethodName = "/taskqueue.TaskQueueService/TaskQueueRedriveDeadLetter"
+	TaskQueueService_TaskQueuePurgeDeadLetters_FullMethodName  = "/taskqueue.TaskQueueService/TaskQueuePurgeDeadLetters"
 )
 
 // TaskQueueServiceClient is the client API for TaskQueueService service.
@@ -34,13 +41,20 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TaskQueueServiceClient interface {
 	TaskQueuePut(ctx context.Context, in *TaskQueuePutRequest, opts ...grpc.CallOption) (*TaskQueuePutResponse, error)
+	TaskQueuePutBatch(ctx context.Context, in *TaskQueuePutBatchRequest, opts ...grpc.CallOption) (*TaskQueuePutBatchResponse, error)
 	TaskQueuePop(ctx context.Context, in *TaskQueuePopRequest, opts ...grpc.CallOption) (*TaskQueuePopResponse, error)
 	TaskQueueMonitor(ctx context.Context, in *TaskQueueMonitorRequest, opts ...grpc.CallOption) (TaskQueueService_TaskQueueMonitorClient, error)
 	TaskQueueComplete(ctx context.Context, in *TaskQueueCompleteRequest, opts ...grpc.CallOption) (*TaskQueueCompleteResponse, error)
+	TaskQueueGetResult(ctx context.Context, in *TaskQueueGetResultRequest, opts ...grpc.CallOption) (*TaskQueueGetResultResponse, error)
+	TaskQueueGetTaskStatuses(ctx context.Context, in *TaskQueueGetTaskStatusesRequest, opts ...grpc.CallOption) (*TaskQueueGetTaskStatusesResponse, error)
 	TaskQueueLength(ctx context.Context, in *TaskQueueLengthRequest, opts ...grpc.CallOption) (*TaskQueueLengthResponse, error)
 	StartTaskQueueServe(ctx context.Context, in *StartTaskQueueServeRequest, opts ...grpc.CallOption) (TaskQueueService_StartTaskQueueServeClient, error)
 	StopTaskQueueServe(ctx context.Context, in *StopTaskQueueServeRequest, opts ...grpc.CallOption) (*StopTaskQueueServeResponse, error)
 	TaskQueueServeKeepAlive(ctx context.Context, in *TaskQueueServeKeepAliveRequest, opts ...grpc.CallOption) (*TaskQueueServeKeepAliveResponse, error)
+	TaskQueueListDeadLetters(ctx context.Context, in *TaskQueueListDeadLettersRequest, opts ...grpc.CallOption) (*TaskQueueListDeadLettersResponse, error)
+	TaskQueueGetDeadLetter(ctx context.Context, in *TaskQueueGetDeadLetterRequest, opts ...grpc.CallOption) (*TaskQueueGetDeadLetterResponse, error)
+	TaskQueueRedriveDeadLetter(ctx context.Context, in *TaskQueueRedriveDeadLetterRequest, opts ...grpc.CallOption) (*TaskQueueRedriveDeadLetterResponse, error)
+	TaskQueuePurgeDeadLetters(ctx context.Context, in *TaskQueuePurgeDeadLettersRequest, opts ...grpc.CallOption) (*TaskQueuePurgeDeadLettersResponse, error)
 }
 
 type taskQueueServiceClient struct {
@@ -60,6 +74,15 @@ func (c *taskQueueServiceClient) TaskQueuePut(ctx context.Context, in *TaskQueue
 	return out, nil
 }
 
+func (c *taskQueueServiceClient) TaskQueuePutBatch(ctx context.Context, in *TaskQueuePutBatchRequest, opts ...grpc.CallOption) (*TaskQueuePutBatchResponse, error) {
+	out := new(TaskQueuePutBatchResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueuePutBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskQueueServiceClient) TaskQueuePop(ctx context.Context, in *TaskQueuePopRequest, opts ...grpc.CallOption) (*TaskQueuePopResponse, error) {
 	out := new(TaskQueuePopResponse)
 	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueuePop_FullMethodName, in, out, opts...)
@@ -110,6 +133,24 @@ func (c *taskQueueServiceClient) TaskQueueComplete(ctx context.Context, in *Task
 	return out, nil
 }
 
+func (c *taskQueueServiceClient) TaskQueueGetResult(ctx context.Context, in *TaskQueueGetResultRequest, opts ...grpc.CallOption) (*TaskQueueGetResultResponse, error) {
+	out := new(TaskQueueGetResultResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueGetResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueServiceClient) TaskQueueGetTaskStatuses(ctx context.Context, in *TaskQueueGetTaskStatusesRequest, opts ...grpc.CallOption) (*TaskQueueGetTaskStatusesResponse, error) {
+	out := new(TaskQueueGetTaskStatusesResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueGetTaskStatuses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskQueueServiceClient) TaskQueueLength(ctx context.Context, in *TaskQueueLengthRequest, opts ...grpc.CallOption) (*TaskQueueLengthResponse, error) {
 	out := new(TaskQueueLengthResponse)
 	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueLength_FullMethodName, in, out, opts...)
@@ -169,18 +210,61 @@ func (c *taskQueueServiceClient) TaskQueueServeKeepAlive(ctx context.Context, in
 	return out, nil
 }
 
+func (c *taskQueueServiceClient) TaskQueueListDeadLetters(ctx context.Context, in *TaskQueueListDeadLettersRequest, opts ...grpc.CallOption) (*TaskQueueListDeadLettersResponse, error) {
+	out := new(TaskQueueListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueServiceClient) TaskQueueGetDeadLetter(ctx context.Context, in *TaskQueueGetDeadLetterRequest, opts ...grpc.CallOption) (*TaskQueueGetDeadLetterResponse, error) {
+	out := new(TaskQueueGetDeadLetterResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueGetDeadLetter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueServiceClient) TaskQueueRedriveDeadLetter(ctx context.Context, in *TaskQueueRedriveDeadLetterRequest, opts ...grpc.CallOption) (*TaskQueueRedriveDeadLetterResponse, error) {
+	out := new(TaskQueueRedriveDeadLetterResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueueRedriveDeadLetter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskQueueServiceClient) TaskQueuePurgeDeadLetters(ctx context.Context, in *TaskQueuePurgeDeadLettersRequest, opts ...grpc.CallOption) (*TaskQueuePurgeDeadLettersResponse, error) {
+	out := new(TaskQueuePurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TaskQueueService_TaskQueuePurgeDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskQueueServiceServer is the server API for TaskQueueService service.
 // All implementations must embed UnimplementedTaskQueueServiceServer
 // for forward compatibility
 type TaskQueueServiceServer interface {
 	TaskQueuePut(context.Context, *TaskQueuePutRequest) (*TaskQueuePutResponse, error)
+	TaskQueuePutBatch(context.Context, *TaskQueuePutBatchRequest) (*TaskQueuePutBatchResponse, error)
 	TaskQueuePop(context.Context, *TaskQueuePopRequest) (*TaskQueuePopResponse, error)
 	TaskQueueMonitor(*TaskQueueMonitorRequest, TaskQueueService_TaskQueueMonitorServer) error
 	TaskQueueComplete(context.Context, *TaskQueueCompleteRequest) (*TaskQueueCompleteResponse, error)
+	TaskQueueGetResult(context.Context, *TaskQueueGetResultRequest) (*TaskQueueGetResultResponse, error)
+	TaskQueueGetTaskStatuses(context.Context, *TaskQueueGetTaskStatusesRequest) (*TaskQueueGetTaskStatusesResponse, error)
 	TaskQueueLength(context.Context, *TaskQueueLengthRequest) (*TaskQueueLengthResponse, error)
 	StartTaskQueueServe(*StartTaskQueueServeRequest, TaskQueueService_StartTaskQueueServeServer) error
 	StopTaskQueueServe(context.Context, *StopTaskQueueServeRequest) (*StopTaskQueueServeResponse, error)
 	TaskQueueServeKeepAlive(context.Context, *TaskQueueServeKeepAliveRequest) (*TaskQueueServeKeepAliveResponse, error)
+	TaskQueueListDeadLetters(context.Context, *TaskQueueListDeadLettersRequest) (*TaskQueueListDeadLettersResponse, error)
+	TaskQueueGetDeadLetter(context.Context, *TaskQueueGetDeadLetterRequest) (*TaskQueueGetDeadLetterResponse, error)
+	TaskQueueRedriveDeadLetter(context.Context, *TaskQueueRedriveDeadLetterRequest) (*TaskQueueRedriveDeadLetterResponse, error)
+	TaskQueuePurgeDeadLetters(context.Context, *TaskQueuePurgeDeadLettersRequest) (*TaskQueuePurgeDeadLettersResponse, error)
 	mustEmbedUnimplementedTaskQueueServiceServer()
 }
 
@@ -191,6 +275,9 @@ type UnimplementedTaskQueueServiceServer struct {
 func (UnimplementedTaskQueueServiceServer) TaskQueuePut(context.Context, *TaskQueuePutRequest) (*TaskQueuePutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskQueuePut not implemented")
 }
+func (UnimplementedTaskQueueServiceServer) TaskQueuePutBatch(context.Context, *TaskQueuePutBatchRequest) (*TaskQueuePutBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueuePutBatch not implemented")
+}
 func (UnimplementedTaskQueueServiceServer) TaskQueuePop(context.Context, *TaskQueuePopRequest) (*TaskQueuePopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskQueuePop not implemented")
 }
@@ -200,6 +287,12 @@ func (UnimplementedTaskQueueServiceServer) TaskQueueMonitor(*TaskQueueMonitorReq
 func (UnimplementedTaskQueueServiceServer) TaskQueueComplete(context.Context, *TaskQueueCompleteRequest) (*TaskQueueCompleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueComplete not implemented")
 }
+func (UnimplementedTaskQueueServiceServer) TaskQueueGetResult(context.Context, *TaskQueueGetResultRequest) (*TaskQueueGetResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueGetResult not implemented")
+}
+func (UnimplementedTaskQueueServiceServer) TaskQueueGetTaskStatuses(context.Context, *TaskQueueGetTaskStatusesRequest) (*TaskQueueGetTaskStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueGetTaskStatuses not implemented")
+}
 func (UnimplementedTaskQueueServiceServer) TaskQueueLength(context.Context, *TaskQueueLengthRequest) (*TaskQueueLengthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueLength not implemented")
 }
@@ -212,6 +305,18 @@ func (UnimplementedTaskQueueServiceServer) StopTaskQueueServe(context.Context, *
 func (UnimplementedTaskQueueServiceServer) TaskQueueServeKeepAlive(context.Context, *TaskQueueServeKeepAliveRequest) (*TaskQueueServeKeepAliveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueServeKeepAlive not implemented")
 }
+func (UnimplementedTaskQueueServiceServer) TaskQueueListDeadLetters(context.Context, *TaskQueueListDeadLettersRequest) (*TaskQueueListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueListDeadLetters not implemented")
+}
+func (UnimplementedTaskQueueServiceServer) TaskQueueGetDeadLetter(context.Context, *TaskQueueGetDeadLetterRequest) (*TaskQueueGetDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueGetDeadLetter not implemented")
+}
+func (UnimplementedTaskQueueServiceServer) TaskQueueRedriveDeadLetter(context.Context, *TaskQueueRedriveDeadLetterRequest) (*TaskQueueRedriveDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueueRedriveDeadLetter not implemented")
+}
+func (UnimplementedTaskQueueServiceServer) TaskQueuePurgeDeadLetters(context.Context, *TaskQueuePurgeDeadLettersRequest) (*TaskQueuePurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskQueuePurgeDeadLetters not implemented")
+}
 func (UnimplementedTaskQueueServiceServer) mustEmbedUnimplementedTaskQueueServiceServer() {}
 
 // UnsafeTaskQueueServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -243,6 +348,24 @@ func _TaskQueueService_TaskQueuePut_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskQueueService_TaskQueuePutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueuePutBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueuePutBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueuePutBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueuePutBatch(ctx, req.(*TaskQueuePutBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskQueueService_TaskQueuePop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskQueuePopRequest)
 	if err := dec(in); err != nil {
@@ -300,6 +423,42 @@ func _TaskQueueService_TaskQueueComplete_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskQueueService_TaskQueueGetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueueGetResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueueGetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueueGetResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueueGetResult(ctx, req.(*TaskQueueGetResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueueService_TaskQueueGetTaskStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueueGetTaskStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueueGetTaskStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueueGetTaskStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueueGetTaskStatuses(ctx, req.(*TaskQueueGetTaskStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskQueueService_TaskQueueLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskQueueLengthRequest)
 	if err := dec(in); err != nil {
@@ -375,6 +534,78 @@ func _TaskQueueService_TaskQueueServeKeepAlive_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskQueueService_TaskQueueListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueueListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueueListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueueListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueueListDeadLetters(ctx, req.(*TaskQueueListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueueService_TaskQueueGetDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueueGetDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueueGetDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueueGetDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueueGetDeadLetter(ctx, req.(*TaskQueueGetDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueueService_TaskQueueRedriveDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueueRedriveDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueueRedriveDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueueRedriveDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueueRedriveDeadLetter(ctx, req.(*TaskQueueRedriveDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskQueueService_TaskQueuePurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskQueuePurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskQueueServiceServer).TaskQueuePurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskQueueService_TaskQueuePurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskQueueServiceServer).TaskQueuePurgeDeadLetters(ctx, req.(*TaskQueuePurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskQueueService_ServiceDesc is the grpc.ServiceDesc for TaskQueueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -386,6 +617,10 @@ var TaskQueueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TaskQueuePut",
 			Handler:    _TaskQueueService_TaskQueuePut_Handler,
 		},
+		{
+			MethodName: "TaskQueuePutBatch",
+			Handler:    _TaskQueueService_TaskQueuePutBatch_Handler,
+		},
 		{
 			MethodName: "TaskQueuePop",
 			Handler:    _TaskQueueService_TaskQueuePop_Handler,
@@ -394,6 +629,14 @@ var TaskQueueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TaskQueueComplete",
 			Handler:    _TaskQueueService_TaskQueueComplete_Handler,
 		},
+		{
+			MethodName: "TaskQueueGetResult",
+			Handler:    _TaskQueueService_TaskQueueGetResult_Handler,
+		},
+		{
+			MethodName: "TaskQueueGetTaskStatuses",
+			Handler:    _TaskQueueService_TaskQueueGetTaskStatuses_Handler,
+		},
 		{
 			MethodName: "TaskQueueLength",
 			Handler:    _TaskQueueService_TaskQueueLength_Handler,
@@ -406,6 +649,22 @@ var TaskQueueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TaskQueueServeKeepAlive",
 			Handler:    _TaskQueueService_TaskQueueServeKeepAlive_Handler,
 		},
+		{
+			MethodName: "TaskQueueListDeadLetters",
+			Handler:    _TaskQueueService_TaskQueueListDeadLetters_Handler,
+		},
+		{
+			MethodName: "TaskQueueGetDeadLetter",
+			Handler:    _TaskQueueService_TaskQueueGetDeadLetter_Handler,
+		},
+		{
+			MethodName: "TaskQueueRedriveDeadLetter",
+			Handler:    _TaskQueueService_TaskQueueRedriveDeadLetter_Handler,
+		},
+		{
+			MethodName: "TaskQueuePurgeDeadLetters",
+			Handler:    _TaskQueueService_TaskQueuePurgeDeadLetters_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
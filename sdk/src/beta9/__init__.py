@@ -9,7 +9,17 @@ from .abstractions.output import Output
 from .abstractions.queue import SimpleQueue as Queue
 from .abstractions.taskqueue import TaskQueue as task_queue
 from .abstractions.volume import Volume
-from .type import GpuType, PythonVersion, QueueDepthAutoscaler
+from .type import (
+    ConcurrencyAutoscaler,
+    GpuType,
+    LoadBalancer,
+    LoadBalancerPolicy,
+    P95LatencyAutoscaler,
+    PythonVersion,
+    QueueDepthAutoscaler,
+    RetryPolicy,
+    RPSAutoscaler,
+)
 
 __all__ = [
     "Map",
@@ -25,5 +35,11 @@ __all__ = [
     "PythonVersion",
     "Output",
     "QueueDepthAutoscaler",
+    "ConcurrencyAutoscaler",
+    "P95LatencyAutoscaler",
+    "RPSAutoscaler",
+    "RetryPolicy",
+    "LoadBalancer",
+    "LoadBalancerPolicy",
     "experimental",
 ]
@@ -21,10 +21,22 @@ from ...clients.gateway import (
     ReplaceObjectContentResponse,
     SecretVar,
 )
+from ...clients.gateway import LoadBalancer as LoadBalancerProto
+from ...clients.gateway import RetryPolicy as RetryPolicyProto
 from ...config import ConfigContext, SDKSettings, get_config_context, get_settings
 from ...env import called_on_import
 from ...sync import FileSyncer, SyncEventHandler
-from ...type import _AUTOSCALER_TYPES, Autoscaler, GpuType, GpuTypeAlias, QueueDepthAutoscaler
+from ...type import (
+    _AUTOSCALER_TYPES,
+    _ENDPOINT_AUTOSCALER_TYPES,
+    Autoscaler,
+    GpuType,
+    GpuTypeAlias,
+    LoadBalancer,
+    LoadBalancerPolicy,
+    QueueDepthAutoscaler,
+    RetryPolicy,
+)
 
 CONTAINER_STUB_TYPE = "container"
 FUNCTION_STUB_TYPE = "function"
@@ -37,6 +49,8 @@ TASKQUEUE_SERVE_STUB_TYPE = "taskqueue/serve"
 ENDPOINT_SERVE_STUB_TYPE = "endpoint/serve"
 FUNCTION_SERVE_STUB_TYPE = "function/serve"
 
+ASGI_ENDPOINT_MODE = "asgi"
+
 
 class RunnerAbstraction(BaseAbstraction):
     def __init__(
@@ -57,6 +71,10 @@ class RunnerAbstraction(BaseAbstraction):
         authorized: bool = True,
         name: Optional[str] = None,
         autoscaler: Autoscaler = QueueDepthAutoscaler(),
+        retry_policy: Optional[RetryPolicy] = None,
+        load_balancer: Optional[LoadBalancer] = None,
+        endpoint_mode: str = "",
+        priority: int = 0,
     ) -> None:
         super().__init__()
 
@@ -87,6 +105,10 @@ class RunnerAbstraction(BaseAbstraction):
         self.retries = retries
         self.timeout = timeout
         self.autoscaler = autoscaler
+        self.retry_policy = retry_policy or RetryPolicy()
+        self.load_balancer = load_balancer
+        self.endpoint_mode = endpoint_mode
+        self.priority = priority
 
         if on_start is not None:
             self._map_callable_to_attr(attr="on_start", func=on_start)
@@ -306,6 +328,23 @@ class RunnerAbstraction(BaseAbstraction):
             )
             return False
 
+        if autoscaler_type in _ENDPOINT_AUTOSCALER_TYPES and not stub_type.startswith(
+            ENDPOINT_STUB_TYPE
+        ):
+            terminal.error(
+                f"{type(self.autoscaler).__name__} can only be used with endpoints",
+                exit=False,
+            )
+            return False
+
+        if not -100 <= self.priority <= 100:
+            terminal.error("Priority must be between -100 and 100", exit=False)
+            return False
+
+        if self.autoscaler.min_containers > self.autoscaler.max_containers:
+            terminal.error("Autoscaler min_containers can't be more than max_containers", exit=False)
+            return False
+
         if not self.stub_created:
             stub_response: GetOrCreateStubResponse = self.gateway_stub.get_or_create_stub(
                 GetOrCreateStubRequest(
@@ -333,7 +372,25 @@ class RunnerAbstraction(BaseAbstraction):
                         type=autoscaler_type,
                         max_containers=self.autoscaler.max_containers,
                         tasks_per_container=self.autoscaler.tasks_per_container,
+                        min_containers=self.autoscaler.min_containers,
+                        target_concurrency=getattr(self.autoscaler, "target_concurrency", 0),
+                        target_latency_ms=getattr(self.autoscaler, "target_latency_ms", 0),
+                        target_rps=getattr(self.autoscaler, "target_rps", 0),
+                    ),
+                    retry_policy=RetryPolicyProto(
+                        backoff_base=self.retry_policy.backoff_base,
+                        backoff_max=self.retry_policy.backoff_max,
+                        jitter=self.retry_policy.jitter,
+                        retry_on=self.retry_policy.error_kinds(),
                     ),
+                    load_balancer=LoadBalancerProto(
+                        policy=LoadBalancerPolicy(self.load_balancer.policy).value,
+                        hash_header=self.load_balancer.hash_header,
+                    )
+                    if self.load_balancer
+                    else None,
+                    endpoint_mode=self.endpoint_mode,
+                    priority=self.priority,
                 )
             )
 
@@ -4,6 +4,7 @@ from typing import Any, Callable, List, Optional, Union
 
 from .. import terminal
 from ..abstractions.base.runner import (
+    ASGI_ENDPOINT_MODE,
     ENDPOINT_DEPLOYMENT_STUB_TYPE,
     ENDPOINT_SERVE_STUB_TYPE,
     RunnerAbstraction,
@@ -20,7 +21,7 @@ from ..clients.endpoint import (
 )
 from ..clients.gateway import DeployStubRequest, DeployStubResponse
 from ..env import is_local
-from ..type import Autoscaler, GpuType, GpuTypeAlias, QueueDepthAutoscaler
+from ..type import Autoscaler, GpuType, GpuTypeAlias, LoadBalancer, QueueDepthAutoscaler
 
 
 class Endpoint(RunnerAbstraction):
@@ -73,6 +74,17 @@ class Endpoint(RunnerAbstraction):
             various autoscaling strategies (Defaults to QueueDepthAutoscaler())
         callback_url (Optional[str]):
             An optional URL to send a callback to when a task is completed, timed out, or cancelled.
+        load_balancer (Optional[LoadBalancer]):
+            Configure how requests are spread across containers. Default is None, which sends each request
+            to the container handling the fewest requests.
+        asgi (bool):
+            If true, the decorated function returns an ASGI app (e.g. FastAPI) that requests are
+            passed through to untouched, along with the path after the endpoint. This allows any
+            method, headers, query strings, streaming bodies and WebSockets. Default is False.
+        priority (int):
+            How the containers are ordered against others waiting to be scheduled, from -100 to 100.
+            Higher priorities are scheduled first and may preempt lower ones. Default is 0,
+            which uses the workspace's priority.
     Example:
         ```python
         from beta9 import endpoint, Image
@@ -108,6 +120,9 @@ class Endpoint(RunnerAbstraction):
         authorized: Optional[bool] = True,
         autoscaler: Optional[Autoscaler] = QueueDepthAutoscaler(),
         callback_url: Optional[str] = None,
+        load_balancer: Optional[LoadBalancer] = None,
+        asgi: bool = False,
+        priority: int = 0,
     ):
         super().__init__(
             cpu=cpu,
@@ -126,6 +141,9 @@ class Endpoint(RunnerAbstraction):
             authorized=authorized,
             autoscaler=autoscaler,
             callback_url=callback_url,
+            load_balancer=load_balancer,
+            endpoint_mode=ASGI_ENDPOINT_MODE if asgi else "",
+            priority=priority,
         )
 
         self._endpoint_stub: Optional[EndpointServiceStub] = None
@@ -1,5 +1,6 @@
 import concurrent.futures
-from typing import Any, Callable, Iterator, List, Optional, Sequence, Union
+from datetime import datetime
+from typing import Any, Callable, Dict, Iterator, List, Optional, Sequence, Union
 
 import cloudpickle
 
@@ -20,7 +21,7 @@ from ..clients.function import (
 from ..clients.gateway import DeployStubRequest, DeployStubResponse
 from ..env import is_local
 from ..sync import FileSyncer
-from ..type import GpuType, GpuTypeAlias
+from ..type import GpuType, GpuTypeAlias, RetryPolicy
 
 
 class Function(RunnerAbstraction):
@@ -52,6 +53,13 @@ class Function(RunnerAbstraction):
         name (Optional[str]):
             An optional name for this function, used during deployment. If not specified, you must specify the name
             at deploy time with the --name argument
+        retry_policy (Optional[RetryPolicy]):
+            Configure how failed tasks are retried - the backoff between retries, and which exceptions raised by
+            the task are retried. By default, tasks are only retried if their container crashes.
+        priority (int):
+            How the containers are ordered against others waiting to be scheduled, from -100 to 100.
+            Higher priorities are scheduled first and may preempt lower ones. Default is 0,
+            which uses the workspace's priority.
     Example:
         ```python
         from beta9 import function, Image
@@ -83,6 +91,8 @@ class Function(RunnerAbstraction):
         volumes: Optional[List[Volume]] = None,
         secrets: Optional[List[str]] = None,
         name: Optional[str] = None,
+        retry_policy: Optional[RetryPolicy] = None,
+        priority: int = 0,
     ) -> None:
         super().__init__(
             cpu=cpu,
@@ -95,6 +105,8 @@ class Function(RunnerAbstraction):
             volumes=volumes,
             secrets=secrets,
             name=name,
+            retry_policy=retry_policy,
+            priority=priority,
         )
 
         self._function_stub: Optional[FunctionServiceStub] = None
@@ -154,10 +166,14 @@ class _CallableWrapper:
             if r.output != "":
                 terminal.detail(r.output, end="")
 
-            if r.done or r.exit_code != 0:
+            if r.done or r.exit_code != 0 or r.scheduled:
                 last_response = r
                 break
 
+        if last_response is not None and last_response.scheduled:
+            terminal.header(f"Function scheduled <{last_response.task_id}>")
+            return
+
         if last_response is None or not last_response.done or last_response.exit_code != 0:
             terminal.error(f"Function failed <{last_response.task_id}> ❌", exit=False)
             return
@@ -171,6 +187,62 @@ class _CallableWrapper:
     def remote(self, *args, **kwargs) -> Any:
         return self(*args, **kwargs)
 
+    @with_grpc_error_handling
+    def schedule(
+        self,
+        args: Sequence[Any] = (),
+        kwargs: Optional[Dict[str, Any]] = None,
+        *,
+        run_at: Optional[datetime] = None,
+        delay_seconds: int = 0,
+        idempotency_key: str = "",
+    ) -> Optional[str]:
+        """
+        Run the function later, and return its task id without waiting for it to run.
+
+        Parameters:
+            args (Sequence[Any]):
+                The positional arguments passed to the function.
+            kwargs (Optional[Dict[str, Any]]):
+                The keyword arguments passed to the function.
+            run_at (Optional[datetime]):
+                When the function runs. Naive datetimes are treated as UTC.
+            delay_seconds (int):
+                How long to wait before the function runs, if run_at isn't set.
+            idempotency_key (str):
+                Scheduling with a key that was already used returns the task that used it. Once that
+                task has started, its output is streamed here until it's done.
+        """
+        if not self.parent.prepare_runtime(
+            func=self.func,
+            stub_type=FUNCTION_STUB_TYPE,
+        ):
+            return None
+
+        payload = cloudpickle.dumps({"args": tuple(args), "kwargs": kwargs or {}})
+
+        task_id = None
+        for r in self.parent.function_stub.function_invoke(
+            FunctionInvokeRequest(
+                stub_id=self.parent.stub_id,
+                args=payload,
+                run_at=run_at,
+                delay_seconds=delay_seconds,
+                idempotency_key=idempotency_key,
+            )
+        ):
+            task_id = r.task_id
+            if r.output != "":
+                terminal.detail(r.output, end="")
+
+            if r.done or r.scheduled:
+                break
+
+        if task_id:
+            terminal.detail(f"Scheduled function: {task_id}")
+
+        return task_id
+
     def serve(self, **kwargs):
         terminal.error("Serve has not yet been implemented for functions.")
 
@@ -1,7 +1,8 @@
 import json
 import os
 import threading
-from typing import Any, Callable, List, Optional, Union
+from datetime import datetime
+from typing import Any, Callable, Dict, List, Optional, Sequence, Union
 
 from .. import terminal
 from ..abstractions.base.runner import (
@@ -18,13 +19,16 @@ from ..clients.taskqueue import (
     StartTaskQueueServeRequest,
     StartTaskQueueServeResponse,
     StopTaskQueueServeRequest,
+    TaskQueueBatchItem,
+    TaskQueuePutBatchRequest,
+    TaskQueuePutBatchResponse,
     TaskQueuePutRequest,
     TaskQueuePutResponse,
     TaskQueueServeKeepAliveRequest,
     TaskQueueServiceStub,
 )
 from ..env import is_local
-from ..type import Autoscaler, GpuType, GpuTypeAlias, QueueDepthAutoscaler
+from ..type import Autoscaler, GpuType, GpuTypeAlias, QueueDepthAutoscaler, RetryPolicy
 
 
 class TaskQueue(RunnerAbstraction):
@@ -78,6 +82,13 @@ class TaskQueue(RunnerAbstraction):
         autoscaler (Optional[Autoscaler]):
             Configure a deployment autoscaler - if specified, you can use scale your function horizontally using
             various autoscaling strategies (Defaults to QueueDepthAutoscaler())
+        retry_policy (Optional[RetryPolicy]):
+            Configure how failed tasks are retried - the backoff between retries, and which exceptions raised by
+            the task are retried. By default, tasks are only retried if their container crashes.
+        priority (int):
+            How the containers are ordered against others waiting to be scheduled, from -100 to 100.
+            Higher priorities are scheduled first and may preempt lower ones. Default is 0,
+            which uses the workspace's priority.
     Example:
         ```python
         from beta9 import task_queue, Image
@@ -109,6 +120,8 @@ class TaskQueue(RunnerAbstraction):
         secrets: Optional[List[str]] = None,
         name: Optional[str] = None,
         autoscaler: Optional[Autoscaler] = QueueDepthAutoscaler(),
+        retry_policy: Optional[RetryPolicy] = None,
+        priority: int = 0,
     ) -> None:
         super().__init__(
             cpu=cpu,
@@ -126,6 +139,8 @@ class TaskQueue(RunnerAbstraction):
             secrets=secrets,
             name=name,
             autoscaler=autoscaler,
+            retry_policy=retry_policy,
+            priority=priority,
         )
         self._taskqueue_stub: Optional[TaskQueueServiceStub] = None
 
@@ -285,3 +300,93 @@ class _CallableWrapper:
 
         terminal.detail(f"Enqueued task: {r.task_id}")
         return True
+
+    def schedule(
+        self,
+        args: Sequence[Any] = (),
+        kwargs: Optional[Dict[str, Any]] = None,
+        *,
+        run_at: Optional[datetime] = None,
+        delay_seconds: int = 0,
+        idempotency_key: str = "",
+    ) -> Optional[str]:
+        """
+        Enqueue a task that runs later, and return its task id. Its result can be fetched with the
+        task queue's result API once it's done.
+
+        Parameters:
+            args (Sequence[Any]):
+                The positional arguments passed to the task.
+            kwargs (Optional[Dict[str, Any]]):
+                The keyword arguments passed to the task.
+            run_at (Optional[datetime]):
+                When the task runs. Naive datetimes are treated as UTC.
+            delay_seconds (int):
+                How long to wait before the task runs, if run_at isn't set.
+            idempotency_key (str):
+                Scheduling with a key that was already used returns the id of the task that used it.
+        """
+        if not self.parent.prepare_runtime(
+            func=self.func,
+            stub_type=TASKQUEUE_STUB_TYPE,
+        ):
+            return None
+
+        payload = {"args": tuple(args), "kwargs": kwargs or {}}
+        json_payload = json.dumps(payload)
+
+        r: TaskQueuePutResponse = self.parent.taskqueue_stub.task_queue_put(
+            TaskQueuePutRequest(
+                stub_id=self.parent.stub_id,
+                payload=json_payload.encode("utf-8"),
+                run_at=run_at,
+                delay_seconds=delay_seconds,
+                idempotency_key=idempotency_key,
+            )
+        )
+
+        if not r.ok:
+            terminal.error("Failed to schedule task", exit=False)
+            return None
+
+        terminal.detail(f"Scheduled task: {r.task_id}")
+        return r.task_id
+
+    def put_batch(self, tasks: Sequence[Dict[str, Any]], batch_size: int = 1000) -> List[str]:
+        """
+        Enqueue several tasks with one request per batch, and return their task ids.
+
+        Parameters:
+            tasks (Sequence[Dict[str, Any]]):
+                The tasks to enqueue. Each task is a dict with optional "args" and "kwargs" keys.
+            batch_size (int):
+                The number of tasks sent per request. Default is 1000, and the maximum is 10000.
+        """
+        if not self.parent.prepare_runtime(
+            func=self.func,
+            stub_type=TASKQUEUE_STUB_TYPE,
+        ):
+            return []
+
+        task_ids: List[str] = []
+        for i in range(0, len(tasks), batch_size):
+            items = [
+                TaskQueueBatchItem(
+                    payload=json.dumps(
+                        {"args": task.get("args", ()), "kwargs": task.get("kwargs", {})}
+                    ).encode("utf-8")
+                )
+                for task in tasks[i : i + batch_size]
+            ]
+
+            r: TaskQueuePutBatchResponse = self.parent.taskqueue_stub.task_queue_put_batch(
+                TaskQueuePutBatchRequest(stub_id=self.parent.stub_id, items=items)
+            )
+            task_ids.extend(r.task_ids)
+
+            if not r.ok:
+                terminal.error(f"Failed to enqueue tasks: {r.err_msg}")
+                break
+
+        terminal.detail(f"Enqueued {len(task_ids)} tasks")
+        return task_ids
@@ -83,6 +83,7 @@ def list_pools(
         Column("Minimim Free CPU"),
         Column("Minimum Free Memory"),
         Column("Default GPU Count (per worker)"),
+        Column("Active Schedules"),
         box=box.SIMPLE,
     )
 
@@ -94,6 +95,7 @@ def list_pools(
             pool.min_free_cpu or "0",
             pool.min_free_memory or "0",
             pool.default_worker_gpu_count or "0",
+            ", ".join(pool.active_sizing_schedules) or "-",
         )
 
     table.add_section()
@@ -4,6 +4,7 @@
 # This file has been @generated
 
 from dataclasses import dataclass
+from datetime import datetime
 from typing import (
     TYPE_CHECKING,
     AsyncIterator,
@@ -28,6 +29,9 @@ if TYPE_CHECKING:
 class FunctionInvokeRequest(betterproto.Message):
     stub_id: str = betterproto.string_field(1)
     args: bytes = betterproto.bytes_field(2)
+    run_at: datetime = betterproto.message_field(3)
+    delay_seconds: int = betterproto.int64_field(4)
+    idempotency_key: str = betterproto.string_field(5)
 
 
 @dataclass(eq=False, repr=False)
@@ -37,6 +41,7 @@ class FunctionInvokeResponse(betterproto.Message):
     done: bool = betterproto.bool_field(3)
     exit_code: int = betterproto.int32_field(4)
     result: bytes = betterproto.bytes_field(5)
+    scheduled: bool = betterproto.bool_field(6)
 
 
 @dataclass(eq=False, repr=False)
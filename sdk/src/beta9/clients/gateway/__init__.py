@@ -164,6 +164,8 @@ class EndTaskRequest(betterproto.Message):
     container_id: str = betterproto.string_field(4)
     container_hostname: str = betterproto.string_field(5)
     keep_warm_seconds: float = betterproto.float_field(6)
+    error_kind: str = betterproto.string_field(7)
+    error_message: str = betterproto.string_field(8)
 
 
 @dataclass(eq=False, repr=False)
@@ -218,6 +220,32 @@ class StopTasksResponse(betterproto.Message):
     err_msg: str = betterproto.string_field(2)
 
 
+@dataclass(eq=False, repr=False)
+class WatchTaskCancellationRequest(betterproto.Message):
+    task_id: str = betterproto.string_field(1)
+    container_id: str = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class WatchTaskCancellationResponse(betterproto.Message):
+    cancelled: bool = betterproto.bool_field(1)
+    reason: str = betterproto.string_field(2)
+    grace_period_seconds: float = betterproto.float_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class ResolveTaskCancellationRequest(betterproto.Message):
+    task_id: str = betterproto.string_field(1)
+    container_id: str = betterproto.string_field(2)
+    outcome: str = betterproto.string_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class ResolveTaskCancellationResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    err_msg: str = betterproto.string_field(2)
+
+
 @dataclass(eq=False, repr=False)
 class Volume(betterproto.Message):
     id: str = betterproto.string_field(1)
@@ -234,6 +262,24 @@ class Autoscaler(betterproto.Message):
     type: str = betterproto.string_field(1)
     max_containers: int = betterproto.uint32_field(2)
     tasks_per_container: int = betterproto.uint32_field(3)
+    target_concurrency: float = betterproto.float_field(4)
+    target_latency_ms: float = betterproto.float_field(5)
+    target_rps: float = betterproto.float_field(6)
+    min_containers: int = betterproto.uint32_field(7)
+
+
+@dataclass(eq=False, repr=False)
+class RetryPolicy(betterproto.Message):
+    backoff_base: float = betterproto.float_field(1)
+    backoff_max: float = betterproto.float_field(2)
+    jitter: float = betterproto.float_field(3)
+    retry_on: List[str] = betterproto.string_field(4)
+
+
+@dataclass(eq=False, repr=False)
+class LoadBalancer(betterproto.Message):
+    policy: str = betterproto.string_field(1)
+    hash_header: str = betterproto.string_field(2)
 
 
 @dataclass(eq=False, repr=False)
@@ -259,6 +305,11 @@ class GetOrCreateStubRequest(betterproto.Message):
     authorized: bool = betterproto.bool_field(20)
     secrets: List["SecretVar"] = betterproto.message_field(21)
     autoscaler: "Autoscaler" = betterproto.message_field(22)
+    priority: int = betterproto.int32_field(23)
+    gpu_memory: int = betterproto.int64_field(24)
+    retry_policy: "RetryPolicy" = betterproto.message_field(25)
+    load_balancer: "LoadBalancer" = betterproto.message_field(26)
+    endpoint_mode: str = betterproto.string_field(27)
 
 
 @dataclass(eq=False, repr=False)
@@ -343,6 +394,7 @@ class Pool(betterproto.Message):
     default_worker_cpu: str = betterproto.string_field(8)
     default_worker_memory: str = betterproto.string_field(9)
     default_worker_gpu_count: str = betterproto.string_field(10)
+    active_sizing_schedules: List[str] = betterproto.string_field(11)
 
 
 @dataclass(eq=False, repr=False)
@@ -434,6 +486,47 @@ class DeleteMachineResponse(betterproto.Message):
     err_msg: str = betterproto.string_field(2)
 
 
+@dataclass(eq=False, repr=False)
+class ExplainSchedulingRequest(betterproto.Message):
+    container_id: str = betterproto.string_field(1)
+    """
+    Explain an existing container, or the request described by the fields below
+    """
+
+    workspace_id: str = betterproto.string_field(2)
+    cpu: int = betterproto.int64_field(3)
+    memory: int = betterproto.int64_field(4)
+    gpu: str = betterproto.string_field(5)
+    gpu_count: int = betterproto.uint32_field(6)
+    pool_selector: str = betterproto.string_field(7)
+    priority: int = betterproto.int32_field(8)
+
+
+@dataclass(eq=False, repr=False)
+class WorkerCandidate(betterproto.Message):
+    worker_id: str = betterproto.string_field(1)
+    pool_name: str = betterproto.string_field(2)
+    status: str = betterproto.string_field(3)
+    free_cpu: int = betterproto.int64_field(4)
+    free_memory: int = betterproto.int64_field(5)
+    gpu: str = betterproto.string_field(6)
+    free_gpu_count: int = betterproto.uint32_field(7)
+    requires_pool_selector: bool = betterproto.bool_field(8)
+    rejections: List[str] = betterproto.string_field(9)
+
+
+@dataclass(eq=False, repr=False)
+class ExplainSchedulingResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    err_msg: str = betterproto.string_field(2)
+    workers: List["WorkerCandidate"] = betterproto.message_field(3)
+    selected_worker_id: str = betterproto.string_field(4)
+    pool_name: str = betterproto.string_field(5)
+    controller_name: str = betterproto.string_field(6)
+    controller_error: str = betterproto.string_field(7)
+    throttled_reason: str = betterproto.string_field(8)
+
+
 class GatewayServiceStub(SyncServiceStub):
     def authorize(self, authorize_request: "AuthorizeRequest") -> "AuthorizeResponse":
         return self._unary_unary(
@@ -528,6 +621,25 @@ class GatewayServiceStub(SyncServiceStub):
             StopTasksResponse,
         )(stop_tasks_request)
 
+    def watch_task_cancellation(
+        self, watch_task_cancellation_request: "WatchTaskCancellationRequest"
+    ) -> Iterator["WatchTaskCancellationResponse"]:
+        for response in self._unary_stream(
+            "/gateway.GatewayService/WatchTaskCancellation",
+            WatchTaskCancellationRequest,
+            WatchTaskCancellationResponse,
+        )(watch_task_cancellation_request):
+            yield response
+
+    def resolve_task_cancellation(
+        self, resolve_task_cancellation_request: "ResolveTaskCancellationRequest"
+    ) -> "ResolveTaskCancellationResponse":
+        return self._unary_unary(
+            "/gateway.GatewayService/ResolveTaskCancellation",
+            ResolveTaskCancellationRequest,
+            ResolveTaskCancellationResponse,
+        )(resolve_task_cancellation_request)
+
     def list_tasks(self, list_tasks_request: "ListTasksRequest") -> "ListTasksResponse":
         return self._unary_unary(
             "/gateway.GatewayService/ListTasks",
@@ -613,3 +725,12 @@ class GatewayServiceStub(SyncServiceStub):
             DeleteMachineRequest,
             DeleteMachineResponse,
         )(delete_machine_request)
+
+    def explain_scheduling(
+        self, explain_scheduling_request: "ExplainSchedulingRequest"
+    ) -> "ExplainSchedulingResponse":
+        return self._unary_unary(
+            "/gateway.GatewayService/ExplainScheduling",
+            ExplainSchedulingRequest,
+            ExplainSchedulingResponse,
+        )(explain_scheduling_request)
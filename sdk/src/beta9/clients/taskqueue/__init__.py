@@ -4,11 +4,13 @@
 # This file has been @generated
 
 from dataclasses import dataclass
+from datetime import datetime
 from typing import (
     TYPE_CHECKING,
     AsyncIterator,
     Dict,
     Iterator,
+    List,
     Optional,
 )
 
@@ -28,6 +30,9 @@ if TYPE_CHECKING:
 class TaskQueuePutRequest(betterproto.Message):
     stub_id: str = betterproto.string_field(1)
     payload: bytes = betterproto.bytes_field(2)
+    run_at: datetime = betterproto.message_field(3)
+    delay_seconds: int = betterproto.int64_field(4)
+    idempotency_key: str = betterproto.string_field(5)
 
 
 @dataclass(eq=False, repr=False)
@@ -36,6 +41,46 @@ class TaskQueuePutResponse(betterproto.Message):
     task_id: str = betterproto.string_field(2)
 
 
+@dataclass(eq=False, repr=False)
+class TaskQueueBatchItem(betterproto.Message):
+    payload: bytes = betterproto.bytes_field(1)
+    run_at: datetime = betterproto.message_field(2)
+    delay_seconds: int = betterproto.int64_field(3)
+    idempotency_key: str = betterproto.string_field(4)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueuePutBatchRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    items: List["TaskQueueBatchItem"] = betterproto.message_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueuePutBatchResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    task_ids: List[str] = betterproto.string_field(2)
+    err_msg: str = betterproto.string_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueTaskStatus(betterproto.Message):
+    task_id: str = betterproto.string_field(1)
+    status: str = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueGetTaskStatusesRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    task_ids: List[str] = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueGetTaskStatusesResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    statuses: List["TaskQueueTaskStatus"] = betterproto.message_field(2)
+    err_msg: str = betterproto.string_field(3)
+
+
 @dataclass(eq=False, repr=False)
 class TaskQueuePopRequest(betterproto.Message):
     stub_id: str = betterproto.string_field(1)
@@ -68,6 +113,9 @@ class TaskQueueCompleteRequest(betterproto.Message):
     container_id: str = betterproto.string_field(5)
     container_hostname: str = betterproto.string_field(6)
     keep_warm_seconds: float = betterproto.float_field(7)
+    error_kind: str = betterproto.string_field(8)
+    error_message: str = betterproto.string_field(9)
+    result: bytes = betterproto.bytes_field(10)
 
 
 @dataclass(eq=False, repr=False)
@@ -75,6 +123,22 @@ class TaskQueueCompleteResponse(betterproto.Message):
     ok: bool = betterproto.bool_field(1)
 
 
+@dataclass(eq=False, repr=False)
+class TaskQueueGetResultRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    task_id: str = betterproto.string_field(2)
+    wait_seconds: int = betterproto.int32_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueGetResultResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    done: bool = betterproto.bool_field(2)
+    status: str = betterproto.string_field(3)
+    result: bytes = betterproto.bytes_field(4)
+    err_msg: str = betterproto.string_field(5)
+
+
 @dataclass(eq=False, repr=False)
 class TaskQueueMonitorRequest(betterproto.Message):
     task_id: str = betterproto.string_field(1)
@@ -88,6 +152,7 @@ class TaskQueueMonitorResponse(betterproto.Message):
     cancelled: bool = betterproto.bool_field(2)
     complete: bool = betterproto.bool_field(3)
     timed_out: bool = betterproto.bool_field(4)
+    cancel_grace_period_seconds: float = betterproto.float_field(5)
 
 
 @dataclass(eq=False, repr=False)
@@ -124,6 +189,69 @@ class TaskQueueServeKeepAliveResponse(betterproto.Message):
     ok: bool = betterproto.bool_field(1)
 
 
+@dataclass(eq=False, repr=False)
+class DeadLetterTask(betterproto.Message):
+    task_id: str = betterproto.string_field(1)
+    stub_id: str = betterproto.string_field(2)
+    task_msg: bytes = betterproto.bytes_field(3)
+    reason: str = betterproto.string_field(4)
+    error: str = betterproto.string_field(5)
+    retries: int = betterproto.uint32_field(6)
+    dead_lettered_at: datetime = betterproto.message_field(7)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueListDeadLettersRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    offset: int = betterproto.uint32_field(2)
+    limit: int = betterproto.uint32_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueListDeadLettersResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    tasks: List["DeadLetterTask"] = betterproto.message_field(2)
+    total: int = betterproto.uint32_field(3)
+    err_msg: str = betterproto.string_field(4)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueGetDeadLetterRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    task_id: str = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueGetDeadLetterResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    task: "DeadLetterTask" = betterproto.message_field(2)
+    err_msg: str = betterproto.string_field(3)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueRedriveDeadLetterRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+    task_id: str = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueueRedriveDeadLetterResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    err_msg: str = betterproto.string_field(2)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueuePurgeDeadLettersRequest(betterproto.Message):
+    stub_id: str = betterproto.string_field(1)
+
+
+@dataclass(eq=False, repr=False)
+class TaskQueuePurgeDeadLettersResponse(betterproto.Message):
+    ok: bool = betterproto.bool_field(1)
+    purged: int = betterproto.uint32_field(2)
+    err_msg: str = betterproto.string_field(3)
+
+
 class TaskQueueServiceStub(SyncServiceStub):
     def task_queue_put(
         self, task_queue_put_request: "TaskQueuePutRequest"
@@ -134,6 +262,15 @@ class TaskQueueServiceStub(SyncServiceStub):
             TaskQueuePutResponse,
         )(task_queue_put_request)
 
+    def task_queue_put_batch(
+        self, task_queue_put_batch_request: "TaskQueuePutBatchRequest"
+    ) -> "TaskQueuePutBatchResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueuePutBatch",
+            TaskQueuePutBatchRequest,
+            TaskQueuePutBatchResponse,
+        )(task_queue_put_batch_request)
+
     def task_queue_pop(
         self, task_queue_pop_request: "TaskQueuePopRequest"
     ) -> "TaskQueuePopResponse":
@@ -162,6 +299,24 @@ class TaskQueueServiceStub(SyncServiceStub):
             TaskQueueCompleteResponse,
         )(task_queue_complete_request)
 
+    def task_queue_get_result(
+        self, task_queue_get_result_request: "TaskQueueGetResultRequest"
+    ) -> "TaskQueueGetResultResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueueGetResult",
+            TaskQueueGetResultRequest,
+            TaskQueueGetResultResponse,
+        )(task_queue_get_result_request)
+
+    def task_queue_get_task_statuses(
+        self, task_queue_get_task_statuses_request: "TaskQueueGetTaskStatusesRequest"
+    ) -> "TaskQueueGetTaskStatusesResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueueGetTaskStatuses",
+            TaskQueueGetTaskStatusesRequest,
+            TaskQueueGetTaskStatusesResponse,
+        )(task_queue_get_task_statuses_request)
+
     def task_queue_length(
         self, task_queue_length_request: "TaskQueueLengthRequest"
     ) -> "TaskQueueLengthResponse":
@@ -198,3 +353,39 @@ class TaskQueueServiceStub(SyncServiceStub):
             TaskQueueServeKeepAliveRequest,
             TaskQueueServeKeepAliveResponse,
         )(task_queue_serve_keep_alive_request)
+
+    def task_queue_list_dead_letters(
+        self, task_queue_list_dead_letters_request: "TaskQueueListDeadLettersRequest"
+    ) -> "TaskQueueListDeadLettersResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueueListDeadLetters",
+            TaskQueueListDeadLettersRequest,
+            TaskQueueListDeadLettersResponse,
+        )(task_queue_list_dead_letters_request)
+
+    def task_queue_get_dead_letter(
+        self, task_queue_get_dead_letter_request: "TaskQueueGetDeadLetterRequest"
+    ) -> "TaskQueueGetDeadLetterResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueueGetDeadLetter",
+            TaskQueueGetDeadLetterRequest,
+            TaskQueueGetDeadLetterResponse,
+        )(task_queue_get_dead_letter_request)
+
+    def task_queue_redrive_dead_letter(
+        self, task_queue_redrive_dead_letter_request: "TaskQueueRedriveDeadLetterRequest"
+    ) -> "TaskQueueRedriveDeadLetterResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueueRedriveDeadLetter",
+            TaskQueueRedriveDeadLetterRequest,
+            TaskQueueRedriveDeadLetterResponse,
+        )(task_queue_redrive_dead_letter_request)
+
+    def task_queue_purge_dead_letters(
+        self, task_queue_purge_dead_letters_request: "TaskQueuePurgeDeadLettersRequest"
+    ) -> "TaskQueuePurgeDeadLettersResponse":
+        return self._unary_unary(
+            "/taskqueue.TaskQueueService/TaskQueuePurgeDeadLetters",
+            TaskQueuePurgeDeadLettersRequest,
+            TaskQueuePurgeDeadLettersResponse,
+        )(task_queue_purge_dead_letters_request)
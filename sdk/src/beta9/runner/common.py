@@ -4,10 +4,11 @@ import inspect
 import json
 import os
 import sys
+import threading
 import time
 import traceback
 from contextlib import contextmanager
-from dataclasses import dataclass
+from dataclasses import dataclass, field
 from typing import Any, Callable, Optional, Union
 
 import requests
@@ -16,6 +17,7 @@ from starlette.responses import Response
 from ..clients.gateway import (
     EndTaskRequest,
     GatewayServiceStub,
+    ResolveTaskCancellationRequest,
     SignPayloadRequest,
     SignPayloadResponse,
 )
@@ -39,6 +41,7 @@ class Config:
     callback_url: Optional[str]
     task_id: Optional[str]
     bind_port: int
+    endpoint_mode: str
 
     @classmethod
     def load_from_env(cls) -> "Config":
@@ -55,6 +58,7 @@ class Config:
         task_id = os.getenv("TASK_ID")
         bind_port = int(os.getenv("BIND_PORT"))
         timeout = int(os.getenv("TIMEOUT", 180))
+        endpoint_mode = os.getenv("ENDPOINT_MODE", "")
 
         if workers <= 0:
             workers = 1
@@ -76,6 +80,7 @@ class Config:
             task_id=task_id,
             bind_port=bind_port,
             timeout=timeout,
+            endpoint_mode=endpoint_mode,
         )
 
 
@@ -97,6 +102,14 @@ class FunctionContext:
     on_start_value: Optional[Any] = None
     bind_port: int = 0
     python_version: str = ""
+    cancel_event: threading.Event = field(default_factory=threading.Event, repr=False)
+
+    def is_cancelled(self) -> bool:
+        """
+        Whether the task was cancelled. Long running handlers can check this to stop early,
+        before their worker process is restarted at the end of the cancellation grace period.
+        """
+        return self.cancel_event.is_set()
 
     @classmethod
     def new(
@@ -217,6 +230,27 @@ def end_task_and_send_callback(
     return resp
 
 
+def resolve_task_cancellation(
+    *,
+    gateway_stub: GatewayServiceStub,
+    task_id: str,
+    outcome: str,
+) -> None:
+    """
+    Record how a cancelled task was stopped
+    """
+    try:
+        gateway_stub.resolve_task_cancellation(
+            ResolveTaskCancellationRequest(
+                task_id=task_id,
+                container_id=config.container_id,
+                outcome=outcome,
+            )
+        )
+    except BaseException:
+        print(f"Unable to record cancellation of task <{task_id}>")
+
+
 def send_callback(
     *,
     gateway_stub: GatewayServiceStub,
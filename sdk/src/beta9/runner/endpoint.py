@@ -1,21 +1,26 @@
 import asyncio
+import inspect
+import json
 import logging
 import os
 import signal
+import threading
 import traceback
-from contextlib import asynccontextmanager
+from contextlib import ExitStack, asynccontextmanager
 from dataclasses import dataclass
 from http import HTTPStatus
-from typing import Any, Dict, Optional, Tuple
+from typing import Any, AsyncIterator, Dict, Optional, Tuple
 
 from fastapi import Depends, FastAPI, HTTPException, Request
-from fastapi.responses import JSONResponse, Response
+from fastapi.responses import JSONResponse, Response, StreamingResponse
 from gunicorn.app.base import Arbiter, BaseApplication
 from starlette.applications import Starlette
-from starlette.types import ASGIApp
+from starlette.concurrency import iterate_in_threadpool
+from starlette.types import ASGIApp, Message, Receive, Scope, Send
 from uvicorn.workers import UvicornWorker
 
 from ..abstractions.base.runner import (
+    ASGI_ENDPOINT_MODE,
     ENDPOINT_SERVE_STUB_TYPE,
 )
 from ..channel import runner_context
@@ -23,12 +28,13 @@ from ..clients.gateway import (
     EndTaskRequest,
     GatewayServiceStub,
     StartTaskRequest,
+    WatchTaskCancellationRequest,
 )
 from ..logging import StdoutJsonInterceptor
 from ..runner.common import FunctionContext, FunctionHandler, execute_lifecycle_method
 from ..runner.common import config as cfg
-from ..type import LifeCycleMethod, TaskStatus
-from .common import end_task_and_send_callback
+from ..type import LifeCycleMethod, TaskCancellationOutcome, TaskExitCode, TaskStatus
+from .common import end_task_and_send_callback, resolve_task_cancellation
 
 
 class EndpointFilter(logging.Filter):
@@ -103,6 +109,7 @@ class TaskLifecycleData:
     status: TaskStatus
     result: Any
     override_callback_url: Optional[str] = None
+    streaming: bool = False
 
 
 async def task_lifecycle(request: Request):
@@ -124,19 +131,254 @@ async def task_lifecycle(request: Request):
     )
     try:
         yield task_lifecycle_data
-        print(f"Task <{task_id}> finished")
     finally:
-        end_task_and_send_callback(
-            gateway_stub=request.app.state.gateway_stub,
-            payload=task_lifecycle_data.result,
-            end_task_request=EndTaskRequest(
+        # Streamed responses are still being sent here, so their task ends once the stream does
+        if not task_lifecycle_data.streaming:
+            end_task(
+                gateway_stub=request.app.state.gateway_stub,
                 task_id=task_id,
-                container_id=cfg.container_id,
-                keep_warm_seconds=cfg.keep_warm_seconds,
-                task_status=task_lifecycle_data.status,
-            ),
-            override_callback_url=task_lifecycle_data.override_callback_url,
+                task_lifecycle_data=task_lifecycle_data,
+            )
+
+
+def end_task(
+    *,
+    gateway_stub: GatewayServiceStub,
+    task_id: str,
+    task_lifecycle_data: TaskLifecycleData,
+) -> None:
+    print(f"Task <{task_id}> finished")
+    end_task_and_send_callback(
+        gateway_stub=gateway_stub,
+        payload=task_lifecycle_data.result,
+        end_task_request=EndTaskRequest(
+            task_id=task_id,
+            container_id=cfg.container_id,
+            keep_warm_seconds=cfg.keep_warm_seconds,
+            task_status=task_lifecycle_data.status,
+        ),
+        override_callback_url=task_lifecycle_data.override_callback_url,
+    )
+
+
+async def stream_until_done(
+    body_iterator: AsyncIterator[Any],
+    *,
+    gateway_stub: GatewayServiceStub,
+    task_id: str,
+    task_lifecycle_data: TaskLifecycleData,
+) -> AsyncIterator[Any]:
+    """
+    Passes on the chunks of a streamed response, and ends its task once the stream is done. The
+    stream is cancelled when the client disconnects, which stops the handler producing it.
+    """
+    try:
+        async for chunk in body_iterator:
+            yield chunk
+    except asyncio.CancelledError:
+        print(f"Client disconnected from task <{task_id}>")
+        task_lifecycle_data.status = TaskStatus.Cancelled
+        raise
+    except BaseException:
+        print(traceback.format_exc())
+        task_lifecycle_data.status = TaskStatus.Error
+        raise
+    finally:
+        end_task(
+            gateway_stub=gateway_stub,
+            task_id=task_id,
+            task_lifecycle_data=task_lifecycle_data,
+        )
+
+
+async def server_sent_events(result: Any) -> AsyncIterator[str]:
+    """
+    Formats the items yielded by a handler as server-sent events. Strings are sent as they are,
+    and anything else is sent as JSON.
+    """
+    # Sync generators may block, so they're run off the event loop
+    if not inspect.isasyncgen(result):
+        result = iterate_in_threadpool(result)
+
+    async for item in result:
+        yield format_server_sent_event(item)
+
+
+def format_server_sent_event(item: Any) -> str:
+    data = item if isinstance(item, str) else json.dumps(item)
+    return "".join(f"data: {line}\n" for line in data.split("\n")) + "\n"
+
+
+class TaskCancellationWatcher:
+    """
+    Watches for the cancellation of a task in a background thread. Async handlers are cancelled
+    right away, and handlers still running at the end of the grace period are stopped by
+    restarting the worker process.
+    """
+
+    def __init__(
+        self,
+        *,
+        gateway_stub: GatewayServiceStub,
+        context: FunctionContext,
+        loop: asyncio.AbstractEventLoop,
+    ) -> None:
+        self.gateway_stub = gateway_stub
+        self.context = context
+        self.loop = loop
+        self.handler_task: Optional[asyncio.Future] = None
+        self.done_event = threading.Event()
+        self.thread = threading.Thread(target=self._watch, daemon=True)
+
+    def __enter__(self) -> "TaskCancellationWatcher":
+        self.thread.start()
+        return self
+
+    def __exit__(self, *_) -> None:
+        self.done_event.set()
+
+    def _watch(self) -> None:
+        try:
+            for response in self.gateway_stub.watch_task_cancellation(
+                WatchTaskCancellationRequest(
+                    task_id=self.context.task_id,
+                    container_id=cfg.container_id,
+                )
+            ):
+                if response.cancelled:
+                    self._stop(response.grace_period_seconds)
+                return
+        except BaseException:
+            # Without a connection to the gateway, the task is left to finish
+            return
+
+    def _stop(self, grace_period: float) -> None:
+        print(f"Task cancelled: {self.context.task_id}")
+
+        self.context.cancel_event.set()
+        if self.handler_task is not None:
+            self.loop.call_soon_threadsafe(self.handler_task.cancel)
+
+        if self.done_event.wait(timeout=grace_period):
+            resolve_task_cancellation(
+                gateway_stub=self.gateway_stub,
+                task_id=self.context.task_id,
+                outcome=TaskCancellationOutcome.Stopped,
+            )
+            return
+
+        print(
+            f"Task <{self.context.task_id}> didn't stop within {grace_period}s, restarting worker"
         )
+        resolve_task_cancellation(
+            gateway_stub=self.gateway_stub,
+            task_id=self.context.task_id,
+            outcome=TaskCancellationOutcome.Killed,
+        )
+        os._exit(TaskExitCode.Cancelled)
+
+
+class ASGIPassthroughApp:
+    """
+    Wraps the ASGI app of an endpoint in the asgi mode. Requests passed through by the gateway
+    go to the app untouched, and each HTTP request or WebSocket is run as a task.
+    """
+
+    def __init__(self, *, app: ASGIApp, on_start_value: Any = None) -> None:
+        self.app = app
+        self.on_start_value = on_start_value
+        self._exit_stack = ExitStack()
+        self._gateway_stub: Optional[GatewayServiceStub] = None
+
+    @property
+    def gateway_stub(self) -> GatewayServiceStub:
+        # Lifespan events belong to the app, so the connection to the gateway is opened on first use
+        if not self._gateway_stub:
+            channel = self._exit_stack.enter_context(runner_context())
+            self._gateway_stub = GatewayServiceStub(channel)
+        return self._gateway_stub
+
+    async def __call__(self, scope: Scope, receive: Receive, send: Send) -> None:
+        if scope["type"] == "lifespan":
+            return await self.app(scope, receive, send)
+
+        if scope["type"] == "http" and scope["path"] == "/health":
+            return await self._send_status(send, HTTPStatus.OK)
+
+        headers = {k.decode("latin-1").lower(): v.decode("latin-1") for k, v in scope["headers"]}
+        task_id = headers.get("x-task-id")
+        if not task_id:
+            return await self._reject(scope, send, HTTPStatus.BAD_REQUEST)
+
+        print(f"Received task <{task_id}>")
+        start_response = self.gateway_stub.start_task(
+            StartTaskRequest(task_id=task_id, container_id=cfg.container_id)
+        )
+        if not start_response.ok:
+            return await self._reject(scope, send, HTTPStatus.INTERNAL_SERVER_ERROR)
+
+        context = FunctionContext.new(
+            config=cfg,
+            task_id=task_id,
+            on_start_value=self.on_start_value,
+        )
+        watcher = TaskCancellationWatcher(
+            gateway_stub=self.gateway_stub,
+            context=context,
+            loop=asyncio.get_running_loop(),
+        )
+
+        status = TaskStatus.Complete
+        response_status: Optional[int] = None
+
+        async def send_and_capture_status(message: Message) -> None:
+            nonlocal response_status
+            if message["type"] == "http.response.start":
+                response_status = message["status"]
+            await send(message)
+
+        try:
+            with StdoutJsonInterceptor(task_id=task_id), watcher:
+                watcher.handler_task = asyncio.ensure_future(
+                    self.app(scope, receive, send_and_capture_status)
+                )
+                await watcher.handler_task
+        except asyncio.CancelledError:
+            if not context.is_cancelled():
+                raise
+        except BaseException:
+            print(traceback.format_exc())
+            status = TaskStatus.Error
+            if scope["type"] == "http" and response_status is None:
+                await self._send_status(send, HTTPStatus.INTERNAL_SERVER_ERROR)
+        finally:
+            if response_status is not None and response_status >= HTTPStatus.INTERNAL_SERVER_ERROR:
+                status = TaskStatus.Error
+
+            if context.is_cancelled():
+                status = TaskStatus.Cancelled
+
+            print(f"Task <{task_id}> finished")
+            end_task_and_send_callback(
+                gateway_stub=self.gateway_stub,
+                payload=None,
+                end_task_request=EndTaskRequest(
+                    task_id=task_id,
+                    container_id=cfg.container_id,
+                    keep_warm_seconds=cfg.keep_warm_seconds,
+                    task_status=status,
+                ),
+            )
+
+    async def _reject(self, scope: Scope, send: Send, status: int) -> None:
+        if scope["type"] == "websocket":
+            return await send({"type": "websocket.close", "code": 1011})
+        return await self._send_status(send, status)
+
+    @staticmethod
+    async def _send_status(send: Send, status: int) -> None:
+        await send({"type": "http.response.start", "status": status, "headers": []})
+        await send({"type": "http.response.body", "body": b""})
 
 
 class OnStartMethodHandler:
@@ -178,6 +420,16 @@ class EndpointManager:
         self.handler: FunctionHandler = FunctionHandler()
         self.on_start_value = asyncio.run(OnStartMethodHandler(worker).start())
 
+        # In the asgi mode, the handler returns an app that requests are passed through to
+        if cfg.endpoint_mode == ASGI_ENDPOINT_MODE:
+            context = FunctionContext.new(
+                config=cfg, task_id="", on_start_value=self.on_start_value
+            )
+            self.app = ASGIPassthroughApp(
+                app=self.handler(context), on_start_value=self.on_start_value
+            )
+            return
+
         @self.app.get("/health")
         async def health():
             return Response(status_code=HTTPStatus.OK)
@@ -191,14 +443,29 @@ class EndpointManager:
             task_id = request.headers.get("X-TASK-ID")
             payload = await request.json()
 
+            context = FunctionContext.new(
+                config=cfg,
+                task_id=task_id,
+                on_start_value=self.on_start_value,
+            )
+            watcher = TaskCancellationWatcher(
+                gateway_stub=request.app.state.gateway_stub,
+                context=context,
+                loop=asyncio.get_running_loop(),
+            )
+
             status_code = HTTPStatus.OK
-            with StdoutJsonInterceptor(task_id=task_id):
-                task_lifecycle_data.result, err = await self._call_function(
-                    task_id=task_id, payload=payload
+            with StdoutJsonInterceptor(task_id=task_id), watcher:
+                watcher.handler_task = asyncio.ensure_future(
+                    self._call_function(context=context, payload=payload)
                 )
+                task_lifecycle_data.result, err = await watcher.handler_task
                 if err:
                     task_lifecycle_data.status = TaskStatus.Error
 
+            if context.is_cancelled():
+                task_lifecycle_data.status = TaskStatus.Cancelled
+
             if task_lifecycle_data.status == TaskStatus.Error:
                 status_code = HTTPStatus.INTERNAL_SERVER_ERROR
 
@@ -206,7 +473,30 @@ class EndpointManager:
                 "callback_url"
             )
 
-            return self._create_response(body=task_lifecycle_data.result, status_code=status_code)
+            # Handlers that yield results have them streamed as server-sent events
+            if inspect.isgenerator(task_lifecycle_data.result) or inspect.isasyncgen(
+                task_lifecycle_data.result
+            ):
+                task_lifecycle_data.result = StreamingResponse(
+                    server_sent_events(task_lifecycle_data.result),
+                    media_type="text/event-stream",
+                )
+
+            response = self._create_response(
+                body=task_lifecycle_data.result, status_code=status_code
+            )
+            if isinstance(response, StreamingResponse):
+                # A stream's contents aren't kept, so its callback is sent without them
+                task_lifecycle_data.streaming = True
+                task_lifecycle_data.result = None
+                response.body_iterator = stream_until_done(
+                    response.body_iterator,
+                    gateway_stub=request.app.state.gateway_stub,
+                    task_id=task_id,
+                    task_lifecycle_data=task_lifecycle_data,
+                )
+
+            return response
 
     def _create_response(self, *, body: Any, status_code: int = HTTPStatus.OK) -> Response:
         if isinstance(body, Response):
@@ -221,7 +511,9 @@ class EndpointManager:
                 status_code=HTTPStatus.INTERNAL_SERVER_ERROR,
             )
 
-    async def _call_function(self, task_id: str, payload: dict) -> Tuple[Response, Any]:
+    async def _call_function(
+        self, context: FunctionContext, payload: dict
+    ) -> Tuple[Response, Any]:
         error = None
         response_body = {}
 
@@ -233,12 +525,6 @@ class EndpointManager:
         if kwargs is None:
             kwargs = {}
 
-        context = FunctionContext.new(
-            config=cfg,
-            task_id=task_id,
-            on_start_value=self.on_start_value,
-        )
-
         try:
             if self.handler.is_async:
                 response_body = await self.handler(
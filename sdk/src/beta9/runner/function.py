@@ -199,6 +199,8 @@ def main(channel: Channel):
                 container_id=container_id,
                 container_hostname=container_hostname,
                 keep_warm_seconds=0,
+                error_kind=type(error).__name__ if error else "",
+                error_message=str(error) if error else "",
             ),
             override_callback_url=kwargs.get("callback_url"),
         )
@@ -8,7 +8,7 @@ import traceback
 from concurrent.futures import CancelledError, ThreadPoolExecutor
 from multiprocessing import Event, Process, set_start_method
 from multiprocessing.synchronize import Event as TEvent
-from typing import Any, List, NamedTuple, Union
+from typing import Any, List, NamedTuple, Optional, Union
 
 import grpc
 
@@ -30,9 +30,10 @@ from ..runner.common import (
     FunctionHandler,
     config,
     execute_lifecycle_method,
+    resolve_task_cancellation,
     send_callback,
 )
-from ..type import LifeCycleMethod, TaskExitCode, TaskStatus
+from ..type import LifeCycleMethod, TaskCancellationOutcome, TaskExitCode, TaskStatus
 
 TASK_PROCESS_WATCHDOG_INTERVAL = 0.01
 TASK_POLLING_INTERVAL = 0.01
@@ -190,6 +191,7 @@ class TaskQueueWorker:
         stub_id: str,
         container_id: str,
         task: Task,
+        task_done_event: threading.Event,
         taskqueue_stub: TaskQueueServiceStub,
         gateway_stub: GatewayServiceStub,
     ) -> None:
@@ -211,13 +213,14 @@ class TaskQueueWorker:
                     if response.cancelled:
                         print(f"Task cancelled: {task.id}")
 
-                        send_callback(
-                            gateway_stub=gateway_stub,
+                        self._stop_cancelled_task(
                             context=context,
-                            payload={},
-                            task_status=TaskStatus.Cancelled,
+                            task=task,
+                            task_done_event=task_done_event,
+                            grace_period=response.cancel_grace_period_seconds,
+                            gateway_stub=gateway_stub,
                         )
-                        os._exit(TaskExitCode.Cancelled)
+                        return
 
                     if response.complete:
                         return
@@ -259,6 +262,39 @@ class TaskQueueWorker:
                 print("Unexpected error occurred in task monitor")
                 os._exit(0)
 
+    def _stop_cancelled_task(
+        self,
+        *,
+        context: FunctionContext,
+        task: Task,
+        task_done_event: threading.Event,
+        grace_period: float,
+        gateway_stub: GatewayServiceStub,
+    ) -> None:
+        # Give the handler a chance to stop by itself, then restart the worker process to stop it
+        context.cancel_event.set()
+        if task_done_event.wait(timeout=grace_period):
+            resolve_task_cancellation(
+                gateway_stub=gateway_stub,
+                task_id=task.id,
+                outcome=TaskCancellationOutcome.Stopped,
+            )
+            return
+
+        print(f"Task <{task.id}> didn't stop within {grace_period}s, restarting worker")
+        resolve_task_cancellation(
+            gateway_stub=gateway_stub,
+            task_id=task.id,
+            outcome=TaskCancellationOutcome.Killed,
+        )
+        send_callback(
+            gateway_stub=gateway_stub,
+            context=context,
+            payload={},
+            task_status=TaskStatus.Cancelled,
+        )
+        os._exit(TaskExitCode.Cancelled)
+
     @with_runner_context
     def process_tasks(self, channel: Channel) -> None:
         self.worker_startup_event.set()
@@ -285,12 +321,14 @@ class TaskQueueWorker:
                     on_start_value=on_start_value,
                 )
 
+                task_done_event = threading.Event()
                 monitor_task = thread_pool.submit(
                     self._monitor_task,
                     context=context,
                     stub_id=config.stub_id,
                     container_id=config.container_id,
                     task=task,
+                    task_done_event=task_done_event,
                     taskqueue_stub=taskqueue_stub,
                     gateway_stub=gateway_stub,
                 )
@@ -299,17 +337,24 @@ class TaskQueueWorker:
                 task_status = TaskStatus.Complete
                 result = None
                 duration = None
+                error: Optional[BaseException] = None
 
                 try:
                     args = task.args or []
                     kwargs = task.kwargs or {}
 
                     result = handler(context, *args, **kwargs)
-                except BaseException:
+                except BaseException as exc:
                     print(traceback.format_exc())
+                    error = exc
                     task_status = TaskStatus.Error
                 finally:
                     duration = time.time() - start_time
+
+                    # Handlers that stop within the cancellation grace period complete as cancelled
+                    task_done_event.set()
+                    if context.is_cancelled():
+                        task_status = TaskStatus.Cancelled
                     complete_task_response: TaskQueueCompleteResponse = (
                         taskqueue_stub.task_queue_complete(
                             TaskQueueCompleteRequest(
@@ -320,6 +365,9 @@ class TaskQueueWorker:
                                 container_id=config.container_id,
                                 container_hostname=config.container_hostname,
                                 keep_warm_seconds=config.keep_warm_seconds,
+                                error_kind=type(error).__name__ if error else "",
+                                error_message=str(error) if error else "",
+                                result=_serialize_result(result),
                             )
                         )
                     )
@@ -339,6 +387,17 @@ class TaskQueueWorker:
                     )  # Send callback to callback_url, if defined
 
 
+def _serialize_result(result: Any) -> bytes:
+    if result is None:
+        return b""
+
+    try:
+        return json.dumps(result).encode("utf-8")
+    except (TypeError, ValueError):
+        print("Task result isn't JSON serializable, it won't be stored")
+        return b""
+
+
 if __name__ == "__main__":
     tq = TaskQueueManager()
     tq.run()
@@ -1,6 +1,6 @@
-from dataclasses import dataclass
+from dataclasses import dataclass, field
 from enum import Enum
-from typing import Dict, Literal, Type, Union
+from typing import Dict, List, Literal, Type, Union
 
 
 class LifeCycleMethod(str, Enum):
@@ -20,6 +20,14 @@ class TaskStatus(str, Enum):
         return self.value
 
 
+class TaskCancellationOutcome(str, Enum):
+    Stopped = "stopped"
+    Killed = "killed"
+
+    def __str__(self) -> str:
+        return self.value
+
+
 class TaskExitCode:
     SigTerm = -15
     SigKill = -9
@@ -102,14 +110,32 @@ GpuTypeAlias = Union[GpuType, GpuTypeLiteral]
 
 
 QUEUE_DEPTH_AUTOSCALER_TYPE = "queue_depth"
+CONCURRENCY_AUTOSCALER_TYPE = "concurrency"
+P95_LATENCY_AUTOSCALER_TYPE = "p95_latency"
+RPS_AUTOSCALER_TYPE = "rps"
 DEFAULT_AUTOSCALER_MAX_CONTAINERS = 1
+DEFAULT_AUTOSCALER_MIN_CONTAINERS = 0
 DEFAULT_AUTOSCALER_TASKS_PER_CONTAINER = 1
 
 
 @dataclass
 class Autoscaler:
+    """
+    Configures how a deployment scales its containers.
+
+    Parameters:
+        max_containers (int):
+            The most containers to scale to. The gateway or your workspace may set a lower limit. Default is 1.
+        tasks_per_container (int):
+            The number of tasks each container should be handling. Default is 1.
+        min_containers (int):
+            The fewest containers a deployment keeps running, even without traffic. Default is 0, which scales
+            to zero.
+    """
+
     max_containers: int = DEFAULT_AUTOSCALER_MAX_CONTAINERS
     tasks_per_container: int = DEFAULT_AUTOSCALER_TASKS_PER_CONTAINER
+    min_containers: int = DEFAULT_AUTOSCALER_MIN_CONTAINERS
 
 
 @dataclass
@@ -117,6 +143,110 @@ class QueueDepthAutoscaler(Autoscaler):
     pass
 
 
+@dataclass
+class ConcurrencyAutoscaler(Autoscaler):
+    """
+    Scales an endpoint to keep the number of in-flight requests per container near a target.
+
+    Parameters:
+        target_concurrency (float):
+            The number of requests each container should be handling at once. Default is 0, which uses
+            tasks_per_container.
+    """
+
+    target_concurrency: float = 0
+
+
+@dataclass
+class P95LatencyAutoscaler(Autoscaler):
+    """
+    Scales an endpoint to keep the 95th percentile duration of its requests near a target.
+    Containers are added one at a time while requests are over the target and waiting for a
+    container, and removed one at a time while requests are well under it.
+
+    Parameters:
+        target_latency_ms (float):
+            The 95th percentile request duration to aim for, in milliseconds.
+    """
+
+    target_latency_ms: float = 1000
+
+
+@dataclass
+class RPSAutoscaler(Autoscaler):
+    """
+    Scales an endpoint to keep the rate of requests per container near a target.
+
+    Parameters:
+        target_rps (float):
+            The number of requests per second each container should be receiving.
+    """
+
+    target_rps: float = 1
+
+
 _AUTOSCALER_TYPES: Dict[Type[Autoscaler], str] = {
     QueueDepthAutoscaler: QUEUE_DEPTH_AUTOSCALER_TYPE,
+    ConcurrencyAutoscaler: CONCURRENCY_AUTOSCALER_TYPE,
+    P95LatencyAutoscaler: P95_LATENCY_AUTOSCALER_TYPE,
+    RPSAutoscaler: RPS_AUTOSCALER_TYPE,
 }
+
+# Autoscalers driven by request samples, which only endpoints record
+_ENDPOINT_AUTOSCALER_TYPES = {
+    CONCURRENCY_AUTOSCALER_TYPE,
+    P95_LATENCY_AUTOSCALER_TYPE,
+    RPS_AUTOSCALER_TYPE,
+}
+
+
+class LoadBalancerPolicy(str, Enum):
+    LeastInFlight = "least_in_flight"
+    PowerOfTwo = "power_of_two"
+    ConsistentHash = "consistent_hash"
+
+
+@dataclass
+class LoadBalancer:
+    """
+    Configures how an endpoint picks the container that handles each request.
+
+    Parameters:
+        policy (LoadBalancerPolicy):
+            LeastInFlight sends requests to the container handling the fewest requests. PowerOfTwo sends
+            them to the less busy of two random containers. ConsistentHash sends requests with the same
+            hash_header value to the same container, for cache affinity. Default is LeastInFlight.
+        hash_header (str):
+            The request header hashed by the ConsistentHash policy. Requests without it are sent to the
+            container handling the fewest requests. Default is "".
+    """
+
+    policy: LoadBalancerPolicy = LoadBalancerPolicy.LeastInFlight
+    hash_header: str = ""
+
+
+@dataclass
+class RetryPolicy:
+    """
+    Configures how failed tasks are retried.
+
+    Parameters:
+        backoff_base (float):
+            Seconds to wait before the first retry. The wait doubles with each retry after it. Default is 0,
+            which retries immediately.
+        backoff_max (float):
+            The maximum number of seconds to wait between retries. Default is 0, which doesn't limit the wait.
+        jitter (float):
+            The fraction of the wait, between 0 and 1, that is randomized to spread out retries. Default is 0.
+        retry_on (List[Union[Type[BaseException], str]]):
+            Exceptions, or their class names, that cause a failed task to be retried. Tasks are always
+            retried if their container is lost. Default is [].
+    """
+
+    backoff_base: float = 0
+    backoff_max: float = 0
+    jitter: float = 0
+    retry_on: List[Union[Type[BaseException], str]] = field(default_factory=list)
+
+    def error_kinds(self) -> List[str]:
+        return [e if isinstance(e, str) else e.__name__ for e in self.retry_on]